@@ -31,11 +31,17 @@ const (
 	ManagementService_RenewServiceAccount_FullMethodName        = "/management.ManagementService/RenewServiceAccount"
 	ManagementService_ListServiceAccounts_FullMethodName        = "/management.ManagementService/ListServiceAccounts"
 	ManagementService_DestroyServiceAccount_FullMethodName      = "/management.ManagementService/DestroyServiceAccount"
+	ManagementService_RevokeKubeconfig_FullMethodName           = "/management.ManagementService/RevokeKubeconfig"
 	ManagementService_KubernetesUpgradePreChecks_FullMethodName = "/management.ManagementService/KubernetesUpgradePreChecks"
 	ManagementService_KubernetesSyncManifests_FullMethodName    = "/management.ManagementService/KubernetesSyncManifests"
 	ManagementService_CreateSchematic_FullMethodName            = "/management.ManagementService/CreateSchematic"
 	ManagementService_GetSupportBundle_FullMethodName           = "/management.ManagementService/GetSupportBundle"
 	ManagementService_ReadAuditLog_FullMethodName               = "/management.ManagementService/ReadAuditLog"
+	ManagementService_WorkloadProxyTunnel_FullMethodName        = "/management.ManagementService/WorkloadProxyTunnel"
+	ManagementService_ListSessionRecordings_FullMethodName      = "/management.ManagementService/ListSessionRecordings"
+	ManagementService_ReadSessionRecording_FullMethodName       = "/management.ManagementService/ReadSessionRecording"
+	ManagementService_ListControllerStatuses_FullMethodName     = "/management.ManagementService/ListControllerStatuses"
+	ManagementService_PreviewMachineClassMatches_FullMethodName = "/management.ManagementService/PreviewMachineClassMatches"
 )
 
 // ManagementServiceClient is the client API for ManagementService service.
@@ -51,11 +57,17 @@ type ManagementServiceClient interface {
 	RenewServiceAccount(ctx context.Context, in *RenewServiceAccountRequest, opts ...grpc.CallOption) (*RenewServiceAccountResponse, error)
 	ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
 	DestroyServiceAccount(ctx context.Context, in *DestroyServiceAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RevokeKubeconfig(ctx context.Context, in *RevokeKubeconfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	KubernetesUpgradePreChecks(ctx context.Context, in *KubernetesUpgradePreChecksRequest, opts ...grpc.CallOption) (*KubernetesUpgradePreChecksResponse, error)
 	KubernetesSyncManifests(ctx context.Context, in *KubernetesSyncManifestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KubernetesSyncManifestResponse], error)
 	CreateSchematic(ctx context.Context, in *CreateSchematicRequest, opts ...grpc.CallOption) (*CreateSchematicResponse, error)
 	GetSupportBundle(ctx context.Context, in *GetSupportBundleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetSupportBundleResponse], error)
 	ReadAuditLog(ctx context.Context, in *ReadAuditLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadAuditLogResponse], error)
+	WorkloadProxyTunnel(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[WorkloadProxyTunnelRequest, WorkloadProxyTunnelResponse], error)
+	ListSessionRecordings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionRecordingsResponse, error)
+	ReadSessionRecording(ctx context.Context, in *ReadSessionRecordingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadSessionRecordingResponse], error)
+	ListControllerStatuses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListControllerStatusesResponse, error)
+	PreviewMachineClassMatches(ctx context.Context, in *PreviewMachineClassMatchesRequest, opts ...grpc.CallOption) (*PreviewMachineClassMatchesResponse, error)
 }
 
 type managementServiceClient struct {
@@ -165,6 +177,16 @@ func (c *managementServiceClient) DestroyServiceAccount(ctx context.Context, in
 	return out, nil
 }
 
+func (c *managementServiceClient) RevokeKubeconfig(ctx context.Context, in *RevokeKubeconfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_RevokeKubeconfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managementServiceClient) KubernetesUpgradePreChecks(ctx context.Context, in *KubernetesUpgradePreChecksRequest, opts ...grpc.CallOption) (*KubernetesUpgradePreChecksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(KubernetesUpgradePreChecksResponse)
@@ -242,6 +264,68 @@ func (c *managementServiceClient) ReadAuditLog(ctx context.Context, in *ReadAudi
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ManagementService_ReadAuditLogClient = grpc.ServerStreamingClient[ReadAuditLogResponse]
 
+func (c *managementServiceClient) WorkloadProxyTunnel(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[WorkloadProxyTunnelRequest, WorkloadProxyTunnelResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[4], ManagementService_WorkloadProxyTunnel_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WorkloadProxyTunnelRequest, WorkloadProxyTunnelResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_WorkloadProxyTunnelClient = grpc.BidiStreamingClient[WorkloadProxyTunnelRequest, WorkloadProxyTunnelResponse]
+
+func (c *managementServiceClient) ListSessionRecordings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionRecordingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionRecordingsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListSessionRecordings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ReadSessionRecording(ctx context.Context, in *ReadSessionRecordingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadSessionRecordingResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[5], ManagementService_ReadSessionRecording_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReadSessionRecordingRequest, ReadSessionRecordingResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_ReadSessionRecordingClient = grpc.ServerStreamingClient[ReadSessionRecordingResponse]
+
+func (c *managementServiceClient) ListControllerStatuses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListControllerStatusesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListControllerStatusesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListControllerStatuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) PreviewMachineClassMatches(ctx context.Context, in *PreviewMachineClassMatchesRequest, opts ...grpc.CallOption) (*PreviewMachineClassMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewMachineClassMatchesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_PreviewMachineClassMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagementServiceServer is the server API for ManagementService service.
 // All implementations must embed UnimplementedManagementServiceServer
 // for forward compatibility.
@@ -255,11 +339,17 @@ type ManagementServiceServer interface {
 	RenewServiceAccount(context.Context, *RenewServiceAccountRequest) (*RenewServiceAccountResponse, error)
 	ListServiceAccounts(context.Context, *emptypb.Empty) (*ListServiceAccountsResponse, error)
 	DestroyServiceAccount(context.Context, *DestroyServiceAccountRequest) (*emptypb.Empty, error)
+	RevokeKubeconfig(context.Context, *RevokeKubeconfigRequest) (*emptypb.Empty, error)
 	KubernetesUpgradePreChecks(context.Context, *KubernetesUpgradePreChecksRequest) (*KubernetesUpgradePreChecksResponse, error)
 	KubernetesSyncManifests(*KubernetesSyncManifestRequest, grpc.ServerStreamingServer[KubernetesSyncManifestResponse]) error
 	CreateSchematic(context.Context, *CreateSchematicRequest) (*CreateSchematicResponse, error)
 	GetSupportBundle(*GetSupportBundleRequest, grpc.ServerStreamingServer[GetSupportBundleResponse]) error
 	ReadAuditLog(*ReadAuditLogRequest, grpc.ServerStreamingServer[ReadAuditLogResponse]) error
+	WorkloadProxyTunnel(grpc.BidiStreamingServer[WorkloadProxyTunnelRequest, WorkloadProxyTunnelResponse]) error
+	ListSessionRecordings(context.Context, *emptypb.Empty) (*ListSessionRecordingsResponse, error)
+	ReadSessionRecording(*ReadSessionRecordingRequest, grpc.ServerStreamingServer[ReadSessionRecordingResponse]) error
+	ListControllerStatuses(context.Context, *emptypb.Empty) (*ListControllerStatusesResponse, error)
+	PreviewMachineClassMatches(context.Context, *PreviewMachineClassMatchesRequest) (*PreviewMachineClassMatchesResponse, error)
 	mustEmbedUnimplementedManagementServiceServer()
 }
 
@@ -297,6 +387,9 @@ func (UnimplementedManagementServiceServer) ListServiceAccounts(context.Context,
 func (UnimplementedManagementServiceServer) DestroyServiceAccount(context.Context, *DestroyServiceAccountRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyServiceAccount not implemented")
 }
+func (UnimplementedManagementServiceServer) RevokeKubeconfig(context.Context, *RevokeKubeconfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeKubeconfig not implemented")
+}
 func (UnimplementedManagementServiceServer) KubernetesUpgradePreChecks(context.Context, *KubernetesUpgradePreChecksRequest) (*KubernetesUpgradePreChecksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KubernetesUpgradePreChecks not implemented")
 }
@@ -312,6 +405,21 @@ func (UnimplementedManagementServiceServer) GetSupportBundle(*GetSupportBundleRe
 func (UnimplementedManagementServiceServer) ReadAuditLog(*ReadAuditLogRequest, grpc.ServerStreamingServer[ReadAuditLogResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ReadAuditLog not implemented")
 }
+func (UnimplementedManagementServiceServer) WorkloadProxyTunnel(grpc.BidiStreamingServer[WorkloadProxyTunnelRequest, WorkloadProxyTunnelResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WorkloadProxyTunnel not implemented")
+}
+func (UnimplementedManagementServiceServer) ListSessionRecordings(context.Context, *emptypb.Empty) (*ListSessionRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionRecordings not implemented")
+}
+func (UnimplementedManagementServiceServer) ReadSessionRecording(*ReadSessionRecordingRequest, grpc.ServerStreamingServer[ReadSessionRecordingResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReadSessionRecording not implemented")
+}
+func (UnimplementedManagementServiceServer) ListControllerStatuses(context.Context, *emptypb.Empty) (*ListControllerStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListControllerStatuses not implemented")
+}
+func (UnimplementedManagementServiceServer) PreviewMachineClassMatches(context.Context, *PreviewMachineClassMatchesRequest) (*PreviewMachineClassMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewMachineClassMatches not implemented")
+}
 func (UnimplementedManagementServiceServer) mustEmbedUnimplementedManagementServiceServer() {}
 func (UnimplementedManagementServiceServer) testEmbeddedByValue()                           {}
 
@@ -488,6 +596,24 @@ func _ManagementService_DestroyServiceAccount_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManagementService_RevokeKubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeKubeconfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RevokeKubeconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RevokeKubeconfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RevokeKubeconfig(ctx, req.(*RevokeKubeconfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ManagementService_KubernetesUpgradePreChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KubernetesUpgradePreChecksRequest)
 	if err := dec(in); err != nil {
@@ -557,6 +683,78 @@ func _ManagementService_ReadAuditLog_Handler(srv interface{}, stream grpc.Server
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ManagementService_ReadAuditLogServer = grpc.ServerStreamingServer[ReadAuditLogResponse]
 
+func _ManagementService_WorkloadProxyTunnel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ManagementServiceServer).WorkloadProxyTunnel(&grpc.GenericServerStream[WorkloadProxyTunnelRequest, WorkloadProxyTunnelResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_WorkloadProxyTunnelServer = grpc.BidiStreamingServer[WorkloadProxyTunnelRequest, WorkloadProxyTunnelResponse]
+
+func _ManagementService_ListSessionRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListSessionRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListSessionRecordings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListSessionRecordings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ReadSessionRecording_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadSessionRecordingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).ReadSessionRecording(m, &grpc.GenericServerStream[ReadSessionRecordingRequest, ReadSessionRecordingResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_ReadSessionRecordingServer = grpc.ServerStreamingServer[ReadSessionRecordingResponse]
+
+func _ManagementService_ListControllerStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListControllerStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListControllerStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListControllerStatuses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_PreviewMachineClassMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewMachineClassMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).PreviewMachineClassMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_PreviewMachineClassMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).PreviewMachineClassMatches(ctx, req.(*PreviewMachineClassMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ManagementService_ServiceDesc is the grpc.ServiceDesc for ManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -596,6 +794,10 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyServiceAccount",
 			Handler:    _ManagementService_DestroyServiceAccount_Handler,
 		},
+		{
+			MethodName: "RevokeKubeconfig",
+			Handler:    _ManagementService_RevokeKubeconfig_Handler,
+		},
 		{
 			MethodName: "KubernetesUpgradePreChecks",
 			Handler:    _ManagementService_KubernetesUpgradePreChecks_Handler,
@@ -604,6 +806,18 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSchematic",
 			Handler:    _ManagementService_CreateSchematic_Handler,
 		},
+		{
+			MethodName: "ListSessionRecordings",
+			Handler:    _ManagementService_ListSessionRecordings_Handler,
+		},
+		{
+			MethodName: "ListControllerStatuses",
+			Handler:    _ManagementService_ListControllerStatuses_Handler,
+		},
+		{
+			MethodName: "PreviewMachineClassMatches",
+			Handler:    _ManagementService_PreviewMachineClassMatches_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -626,6 +840,17 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ManagementService_ReadAuditLog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WorkloadProxyTunnel",
+			Handler:       _ManagementService_WorkloadProxyTunnel_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReadSessionRecording",
+			Handler:       _ManagementService_ReadSessionRecording_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "omni/management/management.proto",
 }
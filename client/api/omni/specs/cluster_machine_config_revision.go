@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl,revive
+package specs
+
+import (
+	"errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalJSON implements json.Marshaler interface.
+func (x *ClusterMachineConfigRevisionSpec) MarshalJSON() ([]byte, error) {
+	obj := x.CloneVT()
+
+	buffer, err := obj.GetUncompressedData()
+	if err != nil {
+		return nil, err
+	}
+
+	defer buffer.Free()
+
+	obj.Data = string(buffer.Data())
+	obj.CompressedData = nil
+
+	return jsonMarshaler.Marshal(obj)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (x *ClusterMachineConfigRevisionSpec) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// MarshalYAML implements yaml.Marshaler interface.
+func (x *ClusterMachineConfigRevisionSpec) MarshalYAML() (any, error) {
+	obj := x.CloneVT()
+
+	buffer, err := obj.GetUncompressedData()
+	if err != nil {
+		return nil, err
+	}
+
+	defer buffer.Free()
+
+	obj.Data = string(buffer.Data())
+	obj.CompressedData = nil
+
+	type alias *ClusterMachineConfigRevisionSpec // prevent recursion
+
+	return alias(obj), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler interface.
+func (x *ClusterMachineConfigRevisionSpec) UnmarshalYAML(node *yaml.Node) error {
+	type alias ClusterMachineConfigRevisionSpec // prevent recursion
+
+	aux := (*alias)(x)
+
+	return unmarshalYAML(x, aux, node)
+}
+
+// GetUncompressedData returns the config data from the ClusterMachineConfigRevisionSpec, decompressing it if necessary.
+func (x *ClusterMachineConfigRevisionSpec) GetUncompressedData(opts ...CompressionOption) (Buffer, error) {
+	if x == nil {
+		return newNoOpBuffer(nil), nil
+	}
+
+	if x.CompressedData == nil {
+		return newNoOpBuffer([]byte(x.Data)), nil
+	}
+
+	config := getCompressionConfig(opts)
+
+	return doDecompress(x.CompressedData, config)
+}
+
+// SetUncompressedData sets the config data in the ClusterMachineConfigRevisionSpec, compressing it if requested.
+func (x *ClusterMachineConfigRevisionSpec) SetUncompressedData(data []byte, opts ...CompressionOption) error {
+	if x == nil {
+		return errors.New("ClusterMachineConfigRevisionSpec is nil")
+	}
+
+	config := getCompressionConfig(opts)
+	compress := config.Enabled
+
+	if !compress {
+		x.Data = string(data)
+		x.CompressedData = nil
+
+		return nil
+	}
+
+	compressed := doCompress(data, config)
+
+	x.Data = ""
+	x.CompressedData = compressed
+
+	return nil
+}
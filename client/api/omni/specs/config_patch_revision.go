@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl,revive
+package specs
+
+import (
+	"errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalJSON implements json.Marshaler interface.
+func (x *ConfigPatchRevisionSpec) MarshalJSON() ([]byte, error) {
+	obj := x.CloneVT()
+
+	buffer, err := obj.GetUncompressedData()
+	if err != nil {
+		return nil, err
+	}
+
+	defer buffer.Free()
+
+	obj.Data = string(buffer.Data())
+	obj.CompressedData = nil
+
+	return jsonMarshaler.Marshal(obj)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (x *ConfigPatchRevisionSpec) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// MarshalYAML implements yaml.Marshaler interface.
+func (x *ConfigPatchRevisionSpec) MarshalYAML() (any, error) {
+	obj := x.CloneVT()
+
+	buffer, err := obj.GetUncompressedData()
+	if err != nil {
+		return nil, err
+	}
+
+	defer buffer.Free()
+
+	obj.Data = string(buffer.Data())
+	obj.CompressedData = nil
+
+	type alias *ConfigPatchRevisionSpec // prevent recursion
+
+	return alias(obj), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler interface.
+func (x *ConfigPatchRevisionSpec) UnmarshalYAML(node *yaml.Node) error {
+	type alias ConfigPatchRevisionSpec // prevent recursion
+
+	aux := (*alias)(x)
+
+	return unmarshalYAML(x, aux, node)
+}
+
+// GetUncompressedData returns the config patch data from the ConfigPatchRevisionSpec, decompressing it if necessary.
+func (x *ConfigPatchRevisionSpec) GetUncompressedData(opts ...CompressionOption) (Buffer, error) {
+	if x == nil {
+		return newNoOpBuffer(nil), nil
+	}
+
+	if x.CompressedData == nil {
+		return newNoOpBuffer([]byte(x.Data)), nil
+	}
+
+	config := getCompressionConfig(opts)
+
+	return doDecompress(x.CompressedData, config)
+}
+
+// SetUncompressedData sets the config patch data in the ConfigPatchRevisionSpec, compressing it if requested.
+func (x *ConfigPatchRevisionSpec) SetUncompressedData(data []byte, opts ...CompressionOption) error {
+	if x == nil {
+		return errors.New("ConfigPatchRevisionSpec is nil")
+	}
+
+	config := getCompressionConfig(opts)
+	compress := config.Enabled
+
+	if !compress {
+		x.Data = string(data)
+		x.CompressedData = nil
+
+		return nil
+	}
+
+	compressed := doCompress(data, config)
+
+	x.Data = ""
+	x.CompressedData = compressed
+
+	return nil
+}
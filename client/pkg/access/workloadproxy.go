@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package access
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/siderolabs/go-api-signature/pkg/pgp"
+)
+
+// workloadProxyBearerTokenSeparator separates the public key ID from its signature in the workload proxy bearer token.
+const workloadProxyBearerTokenSeparator = ":"
+
+// NewWorkloadProxyBearerToken builds a bearer token to authenticate the requests to the exposed services using the given key.
+//
+// The token has the format: <public key ID>:<base64'd signature of the public key ID>.
+func NewWorkloadProxyBearerToken(key *pgp.Key) (string, error) {
+	publicKeyID := key.Fingerprint()
+
+	signature, err := key.Sign([]byte(publicKeyID))
+	if err != nil {
+		return "", err
+	}
+
+	return publicKeyID + workloadProxyBearerTokenSeparator + base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// ParseWorkloadProxyBearerToken parses the bearer token built by NewWorkloadProxyBearerToken.
+//
+// The public key ID must be a hex-encoded key fingerprint and the signature must be base64-encoded, so that the bearer tokens
+// of the exposed services themselves are not mistaken for the workload proxy tokens.
+func ParseWorkloadProxyBearerToken(token string) (publicKeyID, publicKeyIDSignatureBase64 string, err error) {
+	publicKeyID, publicKeyIDSignatureBase64, ok := strings.Cut(token, workloadProxyBearerTokenSeparator)
+	if !ok || publicKeyID == "" || publicKeyIDSignatureBase64 == "" {
+		return "", "", errors.New("invalid workload proxy bearer token")
+	}
+
+	if _, err = hex.DecodeString(publicKeyID); err != nil {
+		return "", "", errors.New("invalid workload proxy bearer token: public key ID is not a fingerprint")
+	}
+
+	if _, err = base64.StdEncoding.DecodeString(publicKeyIDSignatureBase64); err != nil {
+		return "", "", errors.New("invalid workload proxy bearer token: signature is not base64-encoded")
+	}
+
+	return publicKeyID, publicKeyIDSignatureBase64, nil
+}
@@ -0,0 +1,250 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package archive implements the export of the user-managed Omni resources to the archive and their import to another Omni instance.
+//
+// The archive is a gzipped tar, the first entry is the manifest describing the archive, it is followed by an entry per resource type
+// with the resources in the same YAML representation as 'omnictl get -o yaml' prints.
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+const (
+	// FormatVersion is the version of the archive format.
+	FormatVersion = 1
+
+	manifestEntry  = "manifest.yaml"
+	resourcesEntry = "resources"
+)
+
+// Types are the exported resource types in the order they are imported, so that the resources are created after the resources they reference.
+//
+// The admission policies are imported last, so that they don't reject the imported resources.
+var Types = []resource.Type{
+	auth.UserType,
+	auth.IdentityType,
+	auth.AccessPolicyType,
+	auth.SAMLLabelRuleType,
+	omni.EtcdBackupS3ConfType,
+	omni.MachineClassType,
+	omni.MachineRequestSetType,
+	omni.InfraMachineConfigType,
+	omni.MachineLabelsType,
+	omni.ClusterType,
+	omni.MachineSetType,
+	omni.MachineSetNodeType,
+	omni.ConfigPatchType,
+	omni.ExtensionsConfigurationType,
+	omni.AddonType,
+	omni.AdmissionPolicyType,
+}
+
+// ExcludedTypes are the user-managed resource types which are not exported, as they describe one-off actions.
+var ExcludedTypes = []resource.Type{
+	omni.EtcdManualBackupType,
+}
+
+// Manifest describes the archive.
+type Manifest struct {
+	CreatedAt time.Time `yaml:"createdAt"`
+	// Resources is the number of the resources in the archive by type.
+	Resources map[resource.Type]int `yaml:"resources"`
+	// Source is the endpoint of the Omni instance the resources were exported from.
+	Source  string `yaml:"source,omitempty"`
+	Version int    `yaml:"version"`
+}
+
+// ExportOptions configures the export.
+type ExportOptions struct {
+	// Source is recorded in the manifest.
+	Source string
+}
+
+// Export writes the user-managed resources to the archive.
+//
+// The resources managed by the controllers and the service accounts are not exported, as the service account keys can't be exported.
+func Export(ctx context.Context, st state.State, w io.Writer, opts ExportOptions) (*Manifest, error) {
+	manifest := &Manifest{
+		Version:   FormatVersion,
+		CreatedAt: time.Now().UTC(),
+		Source:    opts.Source,
+		Resources: map[resource.Type]int{},
+	}
+
+	identities, err := st.List(ctx, resource.NewMetadata(resources.DefaultNamespace, auth.IdentityType, "", resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+
+	serviceAccountUsers := map[resource.ID]struct{}{}
+
+	for _, identity := range identities.Items {
+		if _, ok := identity.Metadata().Labels().Get(auth.LabelIdentityTypeServiceAccount); !ok {
+			continue
+		}
+
+		if userID, ok := identity.Metadata().Labels().Get(auth.LabelIdentityUserID); ok {
+			serviceAccountUsers[userID] = struct{}{}
+		}
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	entries := make(map[resource.Type][]byte, len(Types))
+
+	for _, resourceType := range Types {
+		list, err := st.List(ctx, resource.NewMetadata(resources.DefaultNamespace, resourceType, "", resource.VersionUndefined))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", resourceType, err)
+		}
+
+		var (
+			data  []byte
+			count int
+		)
+
+		for _, res := range list.Items {
+			if !exported(res, serviceAccountUsers) {
+				continue
+			}
+
+			out, err := marshalResource(res)
+			if err != nil {
+				return nil, err
+			}
+
+			data = append(data, "---\n"...)
+			data = append(data, out...)
+			count++
+		}
+
+		if count == 0 {
+			continue
+		}
+
+		manifest.Resources[resourceType] = count
+		entries[resourceType] = data
+	}
+
+	manifestData, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = writeEntry(tw, manifestEntry, manifestData, manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	for _, resourceType := range Types {
+		data, ok := entries[resourceType]
+		if !ok {
+			continue
+		}
+
+		if err = writeEntry(tw, path.Join(resourcesEntry, resourceType+".yaml"), data, manifest.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, gz.Close()
+}
+
+func exported(res resource.Resource, serviceAccountUsers map[resource.ID]struct{}) bool {
+	if res.Metadata().Owner() != "" || res.Metadata().Phase() == resource.PhaseTearingDown {
+		return false
+	}
+
+	switch res.Metadata().Type() {
+	case auth.IdentityType:
+		_, serviceAccount := res.Metadata().Labels().Get(auth.LabelIdentityTypeServiceAccount)
+
+		return !serviceAccount
+	case auth.UserType:
+		_, serviceAccount := serviceAccountUsers[res.Metadata().ID()]
+
+		return !serviceAccount
+	}
+
+	return true
+}
+
+func marshalResource(res resource.Resource) ([]byte, error) {
+	yamlRes, err := resource.MarshalYAML(res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", resource.String(res), err)
+	}
+
+	out, err := yaml.Marshal(yamlRes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", resource.String(res), err)
+	}
+
+	return out, nil
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(len(data)),
+		Mode:     0o644,
+		ModTime:  modTime,
+	}); err != nil {
+		return err
+	}
+
+	_, err := tw.Write(data)
+
+	return err
+}
+
+// readManifest reads the manifest which is the first entry of the archive.
+func readManifest(tr *tar.Reader) (*Manifest, error) {
+	header, err := tr.Next()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("archive is empty")
+		}
+
+		return nil, fmt.Errorf("failed to read the archive manifest: %w", err)
+	}
+
+	if header.Name != manifestEntry {
+		return nil, fmt.Errorf("unexpected first archive entry %q", header.Name)
+	}
+
+	var manifest Manifest
+
+	if err = yaml.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode the archive manifest: %w", err)
+	}
+
+	if manifest.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", manifest.Version)
+	}
+
+	return &manifest, nil
+}
@@ -0,0 +1,268 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package archive_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/archive"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/common"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestTypes(t *testing.T) {
+	t.Parallel()
+
+	assert.ElementsMatch(t, common.UserManagedResourceTypes, append(append([]resource.Type{}, archive.Types...), archive.ExcludedTypes...))
+}
+
+func buildSource(ctx context.Context, t *testing.T) state.State {
+	t.Helper()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	user := auth.NewUser(resources.DefaultNamespace, "user-1")
+	user.TypedSpec().Value.Role = "Admin"
+
+	identity := auth.NewIdentity(resources.DefaultNamespace, "admin@example.com")
+	identity.TypedSpec().Value.UserId = "user-1"
+	identity.Metadata().Labels().Set(auth.LabelIdentityUserID, "user-1")
+
+	serviceAccountUser := auth.NewUser(resources.DefaultNamespace, "user-2")
+	serviceAccountUser.TypedSpec().Value.Role = "Reader"
+
+	serviceAccount := auth.NewIdentity(resources.DefaultNamespace, "automation@serviceaccount.omni.sidero.dev")
+	serviceAccount.TypedSpec().Value.UserId = "user-2"
+	serviceAccount.Metadata().Labels().Set(auth.LabelIdentityUserID, "user-2")
+	serviceAccount.Metadata().Labels().Set(auth.LabelIdentityTypeServiceAccount, "")
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "prod")
+	cluster.TypedSpec().Value.KubernetesVersion = "1.31.1"
+	cluster.TypedSpec().Value.TalosVersion = "1.8.1"
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, omni.ControlPlanesResourceID("prod"))
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, "prod")
+	machineSet.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+
+	machineSetNode := omni.NewMachineSetNode(resources.DefaultNamespace, "machine-1", machineSet)
+
+	configPatch := omni.NewConfigPatch(resources.DefaultNamespace, "400-prod-patch")
+	configPatch.Metadata().Labels().Set(omni.LabelCluster, "prod")
+	require.NoError(t, configPatch.TypedSpec().Value.SetUncompressedData([]byte("machine: {}")))
+
+	for _, res := range []resource.Resource{
+		user, identity, serviceAccountUser, serviceAccount, cluster, machineSet, machineSetNode, configPatch,
+		omni.NewEtcdManualBackup("prod"),
+	} {
+		require.NoError(t, st.Create(ctx, res))
+	}
+
+	// managed by the controller
+	require.NoError(t, st.Create(ctx, omni.NewConfigPatch(resources.DefaultNamespace, "generated"), state.WithCreateOwner("SomeController")))
+
+	return st
+}
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	source := buildSource(ctx, t)
+
+	var buf bytes.Buffer
+
+	manifest, err := archive.Export(ctx, source, &buf, archive.ExportOptions{Source: "https://omni.example.com"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[resource.Type]int{
+		auth.UserType:           1,
+		auth.IdentityType:       1,
+		omni.ClusterType:        1,
+		omni.MachineSetType:     1,
+		omni.MachineSetNodeType: 1,
+		omni.ConfigPatchType:    1,
+	}, manifest.Resources)
+
+	data := buf.Bytes()
+
+	target := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	opts := archive.ImportOptions{
+		IDMap: map[resource.ID]resource.ID{
+			"prod":      "production",
+			"machine-1": "machine-2",
+		},
+	}
+
+	// dry run doesn't create anything
+	report, err := archive.Import(ctx, target, bytes.NewReader(data), archive.ImportOptions{IDMap: opts.IDMap, DryRun: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, report.Count(archive.ActionCreated))
+	assert.Equal(t, "https://omni.example.com", report.Manifest.Source)
+
+	clusters, err := safe.StateListAll[*omni.Cluster](ctx, target)
+	require.NoError(t, err)
+	assert.Zero(t, clusters.Len())
+
+	report, err = archive.Import(ctx, target, bytes.NewReader(data), opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, report.Count(archive.ActionCreated))
+
+	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, target, "production")
+	require.NoError(t, err)
+	assert.Equal(t, "1.31.1", cluster.TypedSpec().Value.KubernetesVersion)
+
+	machineSet, err := safe.StateGetByID[*omni.MachineSet](ctx, target, omni.ControlPlanesResourceID("production"))
+	require.NoError(t, err)
+
+	clusterLabel, _ := machineSet.Metadata().Labels().Get(omni.LabelCluster)
+	assert.Equal(t, "production", clusterLabel)
+
+	machineSetNode, err := safe.StateGetByID[*omni.MachineSetNode](ctx, target, "machine-2")
+	require.NoError(t, err)
+
+	machineSetLabel, _ := machineSetNode.Metadata().Labels().Get(omni.LabelMachineSet)
+	assert.Equal(t, omni.ControlPlanesResourceID("production"), machineSetLabel)
+
+	_, err = safe.StateGetByID[*auth.Identity](ctx, target, "automation@serviceaccount.omni.sidero.dev")
+	assert.True(t, state.IsNotFoundError(err))
+
+	// the repeated import doesn't change anything
+	report, err = archive.Import(ctx, target, bytes.NewReader(data), opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, report.Count(archive.ActionUnchanged))
+
+	// the changed resources are updated, the resources managed by the controllers are skipped
+	_, err = safe.StateUpdateWithConflicts(ctx, target, cluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.KubernetesVersion = "1.30.0"
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, target.Destroy(ctx, omni.NewConfigPatch(resources.DefaultNamespace, "400-prod-patch").Metadata()))
+	require.NoError(t, target.Create(ctx, omni.NewConfigPatch(resources.DefaultNamespace, "400-prod-patch"), state.WithCreateOwner("SomeController")))
+
+	report, err = archive.Import(ctx, target, bytes.NewReader(data), opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.Count(archive.ActionUpdated))
+	assert.Equal(t, 1, report.Count(archive.ActionSkipped))
+
+	cluster, err = safe.StateGetByID[*omni.Cluster](ctx, target, "production")
+	require.NoError(t, err)
+	assert.Equal(t, "1.31.1", cluster.TypedSpec().Value.KubernetesVersion)
+
+	var out strings.Builder
+
+	require.NoError(t, report.Write(&out))
+	assert.Contains(t, out.String(), "mapped from prod")
+	assert.Contains(t, out.String(), "managed by SomeController")
+	assert.Contains(t, out.String(), "0 created, 1 updated, 4 unchanged, 1 skipped")
+}
+
+func TestImportIDMapScope(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	source := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "prod")
+	cluster.Metadata().Labels().Set("env", "prod")
+
+	// the config patch ID equal to the cluster ID is not mapped
+	configPatch := omni.NewConfigPatch(resources.DefaultNamespace, "prod")
+	configPatch.Metadata().Labels().Set(omni.LabelCluster, "prod")
+	configPatch.Metadata().Labels().Set("env", "prod")
+
+	require.NoError(t, source.Create(ctx, cluster))
+	require.NoError(t, source.Create(ctx, configPatch))
+
+	var buf bytes.Buffer
+
+	_, err := archive.Export(ctx, source, &buf, archive.ExportOptions{})
+	require.NoError(t, err)
+
+	target := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	_, err = archive.Import(ctx, target, &buf, archive.ImportOptions{IDMap: map[resource.ID]resource.ID{"prod": "staging"}})
+	require.NoError(t, err)
+
+	importedCluster, err := safe.StateGetByID[*omni.Cluster](ctx, target, "staging")
+	require.NoError(t, err)
+
+	env, _ := importedCluster.Metadata().Labels().Get("env")
+	assert.Equal(t, "prod", env)
+
+	importedPatch, err := safe.StateGetByID[*omni.ConfigPatch](ctx, target, "prod")
+	require.NoError(t, err)
+
+	clusterLabel, _ := importedPatch.Metadata().Labels().Get(omni.LabelCluster)
+	assert.Equal(t, "staging", clusterLabel)
+
+	env, _ = importedPatch.Metadata().Labels().Get("env")
+	assert.Equal(t, "prod", env)
+}
+
+// failingState fails to create the resources of the type.
+type failingState struct {
+	state.State
+
+	failType resource.Type
+}
+
+func (st *failingState) Create(ctx context.Context, res resource.Resource, opts ...state.CreateOption) error {
+	if res.Metadata().Type() == st.failType {
+		return errors.New("create failed")
+	}
+
+	return st.State.Create(ctx, res, opts...)
+}
+
+func TestImportPartialReport(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	var buf bytes.Buffer
+
+	_, err := archive.Export(ctx, buildSource(ctx, t), &buf, archive.ExportOptions{})
+	require.NoError(t, err)
+
+	target := &failingState{
+		State:    state.WrapCore(namespaced.NewState(inmem.Build)),
+		failType: omni.MachineSetType,
+	}
+
+	report, err := archive.Import(ctx, target, &buf, archive.ImportOptions{})
+	require.ErrorContains(t, err, "create failed")
+
+	// the resources imported before the failure are reported
+	require.NotNil(t, report)
+	assert.Equal(t, 3, report.Count(archive.ActionCreated))
+}
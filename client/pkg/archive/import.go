@@ -0,0 +1,333 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/state"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// Action is the result of the import of a single resource.
+type Action string
+
+// Action values.
+const (
+	ActionCreated   Action = "created"
+	ActionUpdated   Action = "updated"
+	ActionUnchanged Action = "unchanged"
+	ActionSkipped   Action = "skipped"
+)
+
+// ImportOptions configures the import.
+type ImportOptions struct {
+	// IDMap maps the cluster and the machine IDs in the archive to the IDs in the target instance.
+	//
+	// The mapping is applied to the IDs of the clusters, the machine sets and the resources identified by the machine ID,
+	// and to the values of the labels referencing them, so that both the clusters and the machines can be renamed.
+	// The IDs of the machine sets are prefixed with the cluster ID, so the cluster ID mapping is applied to their prefix.
+	// The IDs of the other resources and the values of the other labels are imported as they are.
+	IDMap map[resource.ID]resource.ID
+
+	// DryRun reports the changes without applying them.
+	DryRun bool
+}
+
+// Result describes the import of a single resource.
+type Result struct {
+	Type       resource.Type
+	ID         resource.ID
+	OriginalID resource.ID
+	Action     Action
+	Reason     string
+}
+
+// Report describes the import.
+type Report struct {
+	Manifest Manifest
+	Results  []Result
+	DryRun   bool
+}
+
+// Count returns the number of the resources imported with the action.
+func (r *Report) Count(action Action) int {
+	count := 0
+
+	for _, result := range r.Results {
+		if result.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Write the human-readable summary of the report.
+func (r *Report) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(tw, "ACTION\tTYPE\tID\tDETAILS") //nolint:errcheck
+
+	for _, result := range r.Results {
+		details := result.Reason
+
+		if result.OriginalID != result.ID {
+			if details != "" {
+				details += ", "
+			}
+
+			details += "mapped from " + result.OriginalID
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.Action, result.Type, result.ID, details) //nolint:errcheck
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	summary := fmt.Sprintf("\n%d created, %d updated, %d unchanged, %d skipped",
+		r.Count(ActionCreated), r.Count(ActionUpdated), r.Count(ActionUnchanged), r.Count(ActionSkipped))
+
+	if r.DryRun {
+		summary += " (dry run, no changes were made)"
+	}
+
+	_, err := fmt.Fprintln(w, summary)
+
+	return err
+}
+
+// mappedIDTypes are the resource types identified by the cluster, the machine set or the machine ID, their IDs are mapped on import.
+var mappedIDTypes = []resource.Type{
+	omni.ClusterType,
+	omni.MachineSetType,
+	omni.MachineSetNodeType,
+	omni.MachineLabelsType,
+	omni.InfraMachineConfigType,
+}
+
+// mappedLabels are the labels referencing the clusters, the machine sets and the machines, their values are mapped on import.
+var mappedLabels = []string{
+	omni.LabelCluster,
+	omni.LabelMachineSet,
+	omni.LabelClusterMachine,
+	omni.LabelMachine,
+}
+
+// Import applies the resources from the archive to the state.
+//
+// The import is idempotent: the missing resources are created, the changed resources are updated, and the resources
+// managed by the controllers in the target instance are skipped.
+//
+// If a resource fails to import, the import stops, and the report of the resources processed before it is returned together with the error.
+func Import(ctx context.Context, st state.State, r io.Reader, opts ImportOptions) (*Report, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the archive: %w", err)
+	}
+
+	tr := tar.NewReader(gz)
+
+	manifest, err := readManifest(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	resourcesByType := map[resource.Type][]resource.Resource{}
+
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fmt.Errorf("failed to read the archive: %w", err)
+		}
+
+		list, err := decodeResources(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %q: %w", header.Name, err)
+		}
+
+		for _, res := range list {
+			if !slices.Contains(Types, res.Metadata().Type()) {
+				return nil, fmt.Errorf("unsupported resource type %q in %q", res.Metadata().Type(), header.Name)
+			}
+
+			resourcesByType[res.Metadata().Type()] = append(resourcesByType[res.Metadata().Type()], res)
+		}
+	}
+
+	report := &Report{
+		Manifest: *manifest,
+		DryRun:   opts.DryRun,
+	}
+
+	for _, resourceType := range Types {
+		for _, res := range resourcesByType[resourceType] {
+			originalID := res.Metadata().ID()
+
+			remap(res, opts.IDMap)
+
+			result := Result{
+				Type:       resourceType,
+				ID:         res.Metadata().ID(),
+				OriginalID: originalID,
+			}
+
+			if result.Action, result.Reason, err = importResource(ctx, st, res, opts.DryRun); err != nil {
+				return report, fmt.Errorf("failed to import %s: %w", resource.String(res), err)
+			}
+
+			report.Results = append(report.Results, result)
+		}
+	}
+
+	return report, nil
+}
+
+func decodeResources(r io.Reader) ([]resource.Resource, error) {
+	var list []resource.Resource
+
+	dec := yaml.NewDecoder(r)
+
+	for {
+		var res protobuf.YAMLResource
+
+		if err := dec.Decode(&res); err != nil {
+			if errors.Is(err, io.EOF) {
+				return list, nil
+			}
+
+			return nil, err
+		}
+
+		list = append(list, res.Resource())
+	}
+}
+
+// remap replaces the resource metadata with the metadata which has the mapped ID and labels, and doesn't have the fields set by the source instance.
+func remap(res resource.Resource, idMap map[resource.ID]resource.ID) {
+	id := res.Metadata().ID()
+
+	if slices.Contains(mappedIDTypes, res.Metadata().Type()) {
+		id = mapID(id, idMap, res.Metadata().Type() == omni.MachineSetType)
+	}
+
+	md := resource.NewMetadata(res.Metadata().Namespace(), res.Metadata().Type(), id, resource.VersionUndefined)
+
+	for key, value := range res.Metadata().Labels().Raw() {
+		if slices.Contains(mappedLabels, key) {
+			value = mapID(value, idMap, key == omni.LabelMachineSet)
+		}
+
+		md.Labels().Set(key, value)
+	}
+
+	for key, value := range res.Metadata().Annotations().Raw() {
+		md.Annotations().Set(key, value)
+	}
+
+	*res.Metadata() = md
+}
+
+// mapID returns the mapped ID, the machine set IDs are prefixed with the cluster ID, so the cluster ID mapping is applied to their prefix.
+func mapID(id resource.ID, idMap map[resource.ID]resource.ID, machineSet bool) resource.ID {
+	if mapped, ok := idMap[id]; ok {
+		return mapped
+	}
+
+	if !machineSet {
+		return id
+	}
+
+	// iterate in the reverse order, so that the longest of the matching cluster IDs is applied
+	for _, oldID := range slices.Backward(slices.Sorted(maps.Keys(idMap))) {
+		if suffix, ok := strings.CutPrefix(id, oldID+"-"); ok {
+			return idMap[oldID] + "-" + suffix
+		}
+	}
+
+	return id
+}
+
+func importResource(ctx context.Context, st state.State, res resource.Resource, dryRun bool) (Action, string, error) {
+	existing, err := st.Get(ctx, res.Metadata())
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return "", "", err
+		}
+
+		if !dryRun {
+			if err = st.Create(ctx, res); err != nil {
+				return "", "", err
+			}
+		}
+
+		return ActionCreated, "", nil
+	}
+
+	if existing.Metadata().Owner() != "" {
+		return ActionSkipped, "managed by " + existing.Metadata().Owner(), nil
+	}
+
+	if existing.Metadata().Phase() == resource.PhaseTearingDown {
+		return ActionSkipped, "being torn down", nil
+	}
+
+	equal, err := sameContents(existing, res)
+	if err != nil {
+		return "", "", err
+	}
+
+	if equal {
+		return ActionUnchanged, "", nil
+	}
+
+	if !dryRun {
+		res.Metadata().SetVersion(existing.Metadata().Version())
+		res.Metadata().Finalizers().Set(*existing.Metadata().Finalizers())
+
+		if err = st.Update(ctx, res); err != nil {
+			return "", "", err
+		}
+	}
+
+	return ActionUpdated, "", nil
+}
+
+func sameContents(existing, res resource.Resource) (bool, error) {
+	if !existing.Metadata().Labels().Equal(*res.Metadata().Labels()) || !existing.Metadata().Annotations().Equal(*res.Metadata().Annotations()) {
+		return false, nil
+	}
+
+	existingSpec, err := yaml.Marshal(existing.Spec())
+	if err != nil {
+		return false, err
+	}
+
+	spec, err := yaml.Marshal(res.Spec())
+	if err != nil {
+		return false, err
+	}
+
+	return string(existingSpec) == string(spec), nil
+}
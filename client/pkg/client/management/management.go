@@ -54,6 +54,13 @@ func WithServiceAccount(ttl time.Duration, user string, groups ...string) Kubeco
 	}
 }
 
+// WithServiceAccountNamespaces limits the service account kubeconfig to the namespaces.
+func WithServiceAccountNamespaces(namespaces ...string) KubeconfigOption {
+	return func(request *management.KubeconfigRequest) {
+		request.ServiceAccountNamespaces = namespaces
+	}
+}
+
 // WithGrantType sets --grant-type in the generated kubeconfig.
 func WithGrantType(grantType string) KubeconfigOption {
 	return func(request *management.KubeconfigRequest) {
@@ -68,6 +75,16 @@ func WithBreakGlassKubeconfig(value bool) KubeconfigOption {
 	}
 }
 
+// KubernetesUpgradePreChecksOption is a functional option for KubernetesUpgradePreChecks.
+type KubernetesUpgradePreChecksOption func(request *management.KubernetesUpgradePreChecksRequest)
+
+// WithIgnoreDeprecatedAPIs sets whether the usage of the API versions removed in the new version should not fail the pre-checks.
+func WithIgnoreDeprecatedAPIs(value bool) KubernetesUpgradePreChecksOption {
+	return func(request *management.KubernetesUpgradePreChecksRequest) {
+		request.IgnoreDeprecatedApis = value
+	}
+}
+
 // Client for Management API .
 type Client struct {
 	conn management.ManagementServiceClient
@@ -185,6 +202,15 @@ func (client *Client) DestroyServiceAccount(ctx context.Context, name string) er
 	return err
 }
 
+// RevokeKubeconfig revokes the service account kubeconfig.
+func (client *Client) RevokeKubeconfig(ctx context.Context, id string) error {
+	_, err := client.conn.RevokeKubeconfig(ctx, &management.RevokeKubeconfigRequest{
+		Id: id,
+	})
+
+	return err
+}
+
 // GetSupportBundle generates support bundle on Omni server and returns it to the client.
 func (client *Client) GetSupportBundle(ctx context.Context, cluster string, progress chan *management.GetSupportBundleResponse_Progress) ([]byte, error) {
 	if progress != nil {
@@ -248,6 +274,152 @@ func (client *Client) ReadAuditLog(ctx context.Context, start, end string) iter.
 	}
 }
 
+// ListSessionRecordings lists the recorded Talos and Kubernetes sessions.
+func (client *Client) ListSessionRecordings(ctx context.Context) ([]*management.SessionRecording, error) {
+	response, err := client.conn.ListSessionRecordings(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetRecordings(), nil
+}
+
+// ReadSessionRecording reads the recorded session from the backend.
+//
+// It returns the metadata of the recording and the reader of its events encrypted for the session recording recipient,
+// they can be decrypted with the matching identity by sessionrecording.NewReader.
+func (client *Client) ReadSessionRecording(ctx context.Context, id string) (*management.SessionRecording, io.Reader, error) {
+	stream, err := client.conn.ReadSessionRecording(ctx, &management.ReadSessionRecordingRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, err := stream.Recv()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if response.GetRecording() == nil {
+		return nil, nil, fmt.Errorf("session recording %q is empty", id)
+	}
+
+	return response.GetRecording(), &SessionRecordingReader{
+		stream: stream,
+	}, nil
+}
+
+// SessionRecordingReader reads the encrypted session recording from the stream.
+type SessionRecordingReader struct {
+	stream management.ManagementService_ReadSessionRecordingClient
+
+	buf bytes.Buffer
+}
+
+// Read reads the encrypted session recording.
+func (r *SessionRecordingReader) Read(p []byte) (int, error) {
+	if r.buf.Len() > 0 {
+		return r.buf.Read(p)
+	}
+
+	resp, err := r.stream.Recv()
+	if err != nil {
+		return 0, err
+	}
+
+	n := copy(p, resp.GetData())
+
+	r.buf.Write(resp.GetData()[n:])
+
+	return n, nil
+}
+
+// ListControllerStatuses returns the reconcile statuses of the Omni controllers.
+func (client *Client) ListControllerStatuses(ctx context.Context) ([]*management.ControllerStatus, error) {
+	response, err := client.conn.ListControllerStatuses(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetControllers(), nil
+}
+
+// PreviewMachineClassMatches returns the IDs of the machines matching the machine class selectors.
+func (client *Client) PreviewMachineClassMatches(ctx context.Context, matchLabels []string, matchExpression string) ([]string, error) {
+	response, err := client.conn.PreviewMachineClassMatches(ctx, &management.PreviewMachineClassMatchesRequest{
+		MatchLabels:     matchLabels,
+		MatchExpression: matchExpression,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetMachineIds(), nil
+}
+
+// WorkloadProxyTunnel opens a raw TCP tunnel to the exposed service of the workload cluster.
+//
+// The service is either in the <name>.<namespace> format or the alias of the exposed service.
+func (client *Client) WorkloadProxyTunnel(ctx context.Context, cluster, service string) (io.ReadWriteCloser, error) {
+	stream, err := client.conn.WorkloadProxyTunnel(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = stream.Send(&management.WorkloadProxyTunnelRequest{
+		Init: &management.WorkloadProxyTunnelRequest_Init{
+			Cluster: cluster,
+			Service: service,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return &TunnelConn{
+		stream: stream,
+	}, nil
+}
+
+// TunnelConn is a workload proxy tunnel which implements io.ReadWriteCloser.
+type TunnelConn struct {
+	stream management.ManagementService_WorkloadProxyTunnelClient
+
+	buf bytes.Buffer
+}
+
+// Read reads from the tunnel.
+func (t *TunnelConn) Read(p []byte) (int, error) {
+	if t.buf.Len() > 0 {
+		return t.buf.Read(p)
+	}
+
+	resp, err := t.stream.Recv()
+	if err != nil {
+		return 0, err
+	}
+
+	n := copy(p, resp.GetData())
+
+	t.buf.Write(resp.GetData()[n:])
+
+	return n, nil
+}
+
+// Write writes to the tunnel.
+func (t *TunnelConn) Write(p []byte) (int, error) {
+	if err := t.stream.Send(&management.WorkloadProxyTunnelRequest{Data: bytes.Clone(p)}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close closes the sending side of the tunnel.
+func (t *TunnelConn) Close() error {
+	return t.stream.CloseSend()
+}
+
 // LogReader is a log client reader which implements io.Reader.
 type LogReader struct {
 	ctx    context.Context //nolint:containedctx
@@ -350,12 +522,18 @@ func (client *ClusterClient) Talosconfig(ctx context.Context, opts ...Talosconfi
 }
 
 // KubernetesUpgradePreChecks runs the pre-checks for an upgrade.
-func (client *ClusterClient) KubernetesUpgradePreChecks(ctx context.Context, newVersion string) error {
+func (client *ClusterClient) KubernetesUpgradePreChecks(ctx context.Context, newVersion string, opts ...KubernetesUpgradePreChecksOption) error {
 	ctx = metadata.AppendToOutgoingContext(ctx, "context", client.clusterName)
 
-	resp, err := client.client.conn.KubernetesUpgradePreChecks(ctx, &management.KubernetesUpgradePreChecksRequest{
+	req := &management.KubernetesUpgradePreChecksRequest{
 		NewVersion: newVersion,
-	})
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := client.client.conn.KubernetesUpgradePreChecks(ctx, req)
 	if err != nil {
 		return err
 	}
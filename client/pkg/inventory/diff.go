@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package inventory
+
+import "time"
+
+// ChangeType is the type of the inventory change.
+type ChangeType string
+
+// Inventory change types.
+const (
+	ChangeMachineAdded   ChangeType = "machine_added"
+	ChangeMachineRemoved ChangeType = "machine_removed"
+	ChangeMachineMoved   ChangeType = "machine_moved"
+	ChangeDiskAdded      ChangeType = "disk_added"
+	ChangeDiskRemoved    ChangeType = "disk_removed"
+	ChangeNICAdded       ChangeType = "nic_added"
+	ChangeNICRemoved     ChangeType = "nic_removed"
+)
+
+// Change is a single change of the inventory.
+type Change struct {
+	// Machine is set for the added machines, and to the previous record for the removed machines.
+	Machine *Machine `json:"machine,omitempty"`
+	// Disk is set for the disk changes.
+	Disk *Disk `json:"disk,omitempty"`
+	// NIC is set for the network interface changes.
+	NIC *NIC `json:"nic,omitempty"`
+
+	Type      ChangeType `json:"type"`
+	MachineID string     `json:"machine_id"`
+	// FromCluster and ToCluster are set for the moved machines, empty cluster means the machine is not allocated.
+	FromCluster string `json:"from_cluster,omitempty"`
+	ToCluster   string `json:"to_cluster,omitempty"`
+}
+
+// Diff returns the changes between the previous and the current inventory.
+//
+// The changes are ordered by the machine ID of the current inventory followed by the removed machines.
+func Diff(previous, current []Machine) []Change {
+	previousByID := make(map[string]Machine, len(previous))
+
+	for _, machine := range previous {
+		previousByID[machine.ID] = machine
+	}
+
+	var changes []Change
+
+	for _, machine := range current {
+		old, ok := previousByID[machine.ID]
+		if !ok {
+			changes = append(changes, Change{
+				Type:      ChangeMachineAdded,
+				MachineID: machine.ID,
+				Machine:   &machine,
+			})
+
+			continue
+		}
+
+		delete(previousByID, machine.ID)
+
+		if old.Cluster != machine.Cluster {
+			changes = append(changes, Change{
+				Type:        ChangeMachineMoved,
+				MachineID:   machine.ID,
+				FromCluster: old.Cluster,
+				ToCluster:   machine.Cluster,
+			})
+		}
+
+		changes = append(changes, diffDisks(machine.ID, old.Disks, machine.Disks)...)
+		changes = append(changes, diffNICs(machine.ID, old.NICs, machine.NICs)...)
+	}
+
+	for _, machine := range previous {
+		if _, ok := previousByID[machine.ID]; !ok {
+			continue
+		}
+
+		changes = append(changes, Change{
+			Type:      ChangeMachineRemoved,
+			MachineID: machine.ID,
+			Machine:   &machine,
+		})
+	}
+
+	return changes
+}
+
+func diffDisks(machineID string, previous, current []Disk) []Change {
+	return diffItems(machineID, previous, current, Disk.Key, ChangeDiskAdded, ChangeDiskRemoved, func(change *Change, disk Disk) { change.Disk = &disk })
+}
+
+func diffNICs(machineID string, previous, current []NIC) []Change {
+	return diffItems(machineID, previous, current, func(nic NIC) string { return nic.MAC }, ChangeNICAdded, ChangeNICRemoved, func(change *Change, nic NIC) { change.NIC = &nic })
+}
+
+// diffItems compares the items of the machine by their keys.
+func diffItems[T any](machineID string, previous, current []T, key func(T) string, added, removed ChangeType, set func(*Change, T)) []Change {
+	previousKeys := make(map[string]struct{}, len(previous))
+
+	for _, item := range previous {
+		previousKeys[key(item)] = struct{}{}
+	}
+
+	currentKeys := make(map[string]struct{}, len(current))
+
+	var changes []Change
+
+	appendChange := func(changeType ChangeType, item T) {
+		change := Change{Type: changeType, MachineID: machineID}
+
+		set(&change, item)
+
+		changes = append(changes, change)
+	}
+
+	for _, item := range current {
+		currentKeys[key(item)] = struct{}{}
+
+		if _, ok := previousKeys[key(item)]; !ok {
+			appendChange(added, item)
+		}
+	}
+
+	for _, item := range previous {
+		if _, ok := currentKeys[key(item)]; !ok {
+			appendChange(removed, item)
+		}
+	}
+
+	return changes
+}
+
+// Report is the payload pushed to the inventory sync endpoint.
+//
+// The first report after the start of Omni is a full one, it carries all machines and no changes.
+// The subsequent reports carry only the changes since the previous report.
+type Report struct {
+	Timestamp time.Time `json:"timestamp"`
+	Machines  []Machine `json:"machines,omitempty"`
+	Changes   []Change  `json:"changes,omitempty"`
+	Full      bool      `json:"full"`
+}
@@ -0,0 +1,257 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package inventory builds the hardware inventory of the machines and tracks its changes.
+package inventory
+
+import (
+	"cmp"
+	"encoding/csv"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// Machine is the inventory record of a single machine.
+type Machine struct {
+	ID           string      `json:"id"`
+	Hostname     string      `json:"hostname,omitempty"`
+	Cluster      string      `json:"cluster,omitempty"`
+	Role         string      `json:"role,omitempty"`
+	TalosVersion string      `json:"talos_version,omitempty"`
+	Arch         string      `json:"arch,omitempty"`
+	Platform     string      `json:"platform,omitempty"`
+	Region       string      `json:"region,omitempty"`
+	Zone         string      `json:"zone,omitempty"`
+	InstanceType string      `json:"instance_type,omitempty"`
+	InstanceID   string      `json:"instance_id,omitempty"`
+	Processors   []Processor `json:"processors,omitempty"`
+	Disks        []Disk      `json:"disks,omitempty"`
+	NICs         []NIC       `json:"nics,omitempty"`
+	MemoryMB     uint64      `json:"memory_mb"`
+	Connected    bool        `json:"connected"`
+}
+
+// Processor is the inventory record of a CPU.
+type Processor struct {
+	Description  string `json:"description,omitempty"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	CoreCount    uint32 `json:"core_count"`
+	ThreadCount  uint32 `json:"thread_count"`
+	FrequencyMHz uint32 `json:"frequency_mhz"`
+}
+
+// Disk is the inventory record of a block device.
+type Disk struct {
+	LinuxName string `json:"linux_name"`
+	Model     string `json:"model,omitempty"`
+	Serial    string `json:"serial,omitempty"`
+	WWID      string `json:"wwid,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Size      uint64 `json:"size"`
+}
+
+// Key identifies the disk across the inventory snapshots.
+//
+// The serial and the WWID are preferred, as the Linux name might change between the reboots.
+func (disk Disk) Key() string {
+	switch {
+	case disk.Serial != "":
+		return "serial:" + disk.Serial
+	case disk.WWID != "":
+		return "wwid:" + disk.WWID
+	default:
+		return "name:" + disk.LinuxName
+	}
+}
+
+// NIC is the inventory record of a physical network interface.
+type NIC struct {
+	LinuxName   string `json:"linux_name"`
+	MAC         string `json:"mac"`
+	Description string `json:"description,omitempty"`
+	SpeedMbps   uint32 `json:"speed_mbps"`
+}
+
+// FromMachineStatus builds the inventory record from the machine status.
+func FromMachineStatus(machineStatus *omni.MachineStatus) Machine {
+	spec := machineStatus.TypedSpec().Value
+
+	machine := Machine{
+		ID:           machineStatus.Metadata().ID(),
+		Cluster:      spec.Cluster,
+		TalosVersion: spec.TalosVersion,
+		Connected:    spec.Connected,
+	}
+
+	if spec.Cluster != "" {
+		machine.Role = roleName(spec.Role)
+	}
+
+	if network := spec.Network; network != nil {
+		machine.Hostname = network.Hostname
+
+		for _, link := range network.NetworkLinks {
+			machine.NICs = append(machine.NICs, NIC{
+				LinuxName:   link.LinuxName,
+				MAC:         link.HardwareAddress,
+				Description: link.Description,
+				SpeedMbps:   link.SpeedMbps,
+			})
+		}
+	}
+
+	if platform := spec.PlatformMetadata; platform != nil {
+		machine.Platform = platform.Platform
+		machine.Region = platform.Region
+		machine.Zone = platform.Zone
+		machine.InstanceType = platform.InstanceType
+		machine.InstanceID = platform.InstanceId
+	}
+
+	if hardware := spec.Hardware; hardware != nil {
+		machine.Arch = hardware.Arch
+
+		for _, processor := range hardware.Processors {
+			machine.Processors = append(machine.Processors, Processor{
+				Description:  processor.Description,
+				Manufacturer: processor.Manufacturer,
+				CoreCount:    processor.CoreCount,
+				ThreadCount:  processor.ThreadCount,
+				FrequencyMHz: processor.Frequency,
+			})
+		}
+
+		for _, module := range hardware.MemoryModules {
+			machine.MemoryMB += uint64(module.SizeMb)
+		}
+
+		for _, device := range hardware.Blockdevices {
+			machine.Disks = append(machine.Disks, Disk{
+				LinuxName: device.LinuxName,
+				Model:     device.Model,
+				Serial:    device.Serial,
+				WWID:      device.Wwid,
+				Type:      device.Type,
+				Size:      device.Size,
+			})
+		}
+	}
+
+	return machine
+}
+
+func roleName(role specs.MachineStatusSpec_Role) string {
+	switch role {
+	case specs.MachineStatusSpec_CONTROL_PLANE:
+		return "controlplane"
+	case specs.MachineStatusSpec_WORKER:
+		return "worker"
+	case specs.MachineStatusSpec_NONE:
+	}
+
+	return ""
+}
+
+// Sort sorts the machines by ID.
+func Sort(machines []Machine) {
+	slices.SortFunc(machines, func(a, b Machine) int { return cmp.Compare(a.ID, b.ID) })
+}
+
+var csvHeader = []string{
+	"ID",
+	"HOSTNAME",
+	"CLUSTER",
+	"ROLE",
+	"CONNECTED",
+	"TALOS VERSION",
+	"ARCH",
+	"PLATFORM",
+	"REGION",
+	"ZONE",
+	"INSTANCE TYPE",
+	"INSTANCE ID",
+	"CPU",
+	"CORES",
+	"THREADS",
+	"MEMORY MB",
+	"DISKS",
+	"DISK SERIALS",
+	"DISK WWIDS",
+	"MACS",
+}
+
+// WriteCSV writes the machines as CSV, one machine per row.
+//
+// The multi-value columns (disks, MACs) are separated by semicolons.
+func WriteCSV(w io.Writer, machines []Machine) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, machine := range machines {
+		var (
+			cores, threads uint32
+			cpus           []string
+		)
+
+		for _, processor := range machine.Processors {
+			cores += processor.CoreCount
+			threads += processor.ThreadCount
+
+			cpus = append(cpus, processor.Description)
+		}
+
+		disks := make([]string, 0, len(machine.Disks))
+		serials := make([]string, 0, len(machine.Disks))
+		wwids := make([]string, 0, len(machine.Disks))
+
+		for _, disk := range machine.Disks {
+			disks = append(disks, disk.LinuxName+":"+strconv.FormatUint(disk.Size, 10))
+			serials = append(serials, disk.Serial)
+			wwids = append(wwids, disk.WWID)
+		}
+
+		macs := make([]string, 0, len(machine.NICs))
+
+		for _, nic := range machine.NICs {
+			macs = append(macs, nic.MAC)
+		}
+
+		if err := writer.Write([]string{
+			machine.ID,
+			machine.Hostname,
+			machine.Cluster,
+			machine.Role,
+			strconv.FormatBool(machine.Connected),
+			machine.TalosVersion,
+			machine.Arch,
+			machine.Platform,
+			machine.Region,
+			machine.Zone,
+			machine.InstanceType,
+			machine.InstanceID,
+			strings.Join(cpus, ";"),
+			strconv.FormatUint(uint64(cores), 10),
+			strconv.FormatUint(uint64(threads), 10),
+			strconv.FormatUint(machine.MemoryMB, 10),
+			strings.Join(disks, ";"),
+			strings.Join(serials, ";"),
+			strings.Join(wwids, ";"),
+			strings.Join(macs, ";"),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package inventory_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/inventory"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestFromMachineStatus(t *testing.T) {
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine-1")
+
+	machineStatus.TypedSpec().Value = &specs.MachineStatusSpec{
+		Cluster: "cluster-1",
+		Role:    specs.MachineStatusSpec_CONTROL_PLANE,
+		Network: &specs.MachineStatusSpec_NetworkStatus{
+			Hostname: "node-1",
+			NetworkLinks: []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+				{LinuxName: "eth0", HardwareAddress: "aa:bb:cc:dd:ee:ff"},
+			},
+		},
+		Hardware: &specs.MachineStatusSpec_HardwareStatus{
+			Arch: "amd64",
+			MemoryModules: []*specs.MachineStatusSpec_HardwareStatus_MemoryModule{
+				{SizeMb: 1024},
+				{SizeMb: 2048},
+			},
+			Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+				{LinuxName: "/dev/sda", Serial: "S1", Size: 100},
+			},
+		},
+	}
+
+	machine := inventory.FromMachineStatus(machineStatus)
+
+	assert.Equal(t, "machine-1", machine.ID)
+	assert.Equal(t, "node-1", machine.Hostname)
+	assert.Equal(t, "controlplane", machine.Role)
+	assert.Equal(t, "amd64", machine.Arch)
+	assert.EqualValues(t, 3072, machine.MemoryMB)
+	assert.Equal(t, []inventory.Disk{{LinuxName: "/dev/sda", Serial: "S1", Size: 100}}, machine.Disks)
+	assert.Equal(t, []inventory.NIC{{LinuxName: "eth0", MAC: "aa:bb:cc:dd:ee:ff"}}, machine.NICs)
+
+	var sb strings.Builder
+
+	require.NoError(t, inventory.WriteCSV(&sb, []inventory.Machine{machine}))
+
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	require.Len(t, lines, 2)
+
+	assert.True(t, strings.HasPrefix(lines[0], "ID,HOSTNAME,CLUSTER,ROLE"))
+	assert.Equal(t, "machine-1,node-1,cluster-1,controlplane,false,,amd64,,,,,,,0,0,3072,/dev/sda:100,S1,,aa:bb:cc:dd:ee:ff", lines[1])
+}
+
+func TestDiff(t *testing.T) {
+	previous := []inventory.Machine{
+		{
+			ID:      "machine-1",
+			Cluster: "cluster-1",
+			Disks: []inventory.Disk{
+				{LinuxName: "/dev/sda", Serial: "S1"},
+				{LinuxName: "/dev/sdb", Serial: "S2"},
+			},
+			NICs: []inventory.NIC{{MAC: "aa"}},
+		},
+		{ID: "machine-2"},
+	}
+
+	current := []inventory.Machine{
+		{
+			ID: "machine-1",
+			Disks: []inventory.Disk{
+				// the disk was renamed, but it's the same disk
+				{LinuxName: "/dev/sdb", Serial: "S1"},
+				{LinuxName: "/dev/sdc", Serial: "S3"},
+			},
+			NICs: []inventory.NIC{{MAC: "aa"}},
+		},
+		{ID: "machine-3"},
+	}
+
+	changes := inventory.Diff(previous, current)
+
+	summary := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		summary = append(summary, string(change.Type)+" "+change.MachineID)
+	}
+
+	assert.Equal(t, []string{
+		"machine_moved machine-1",
+		"disk_added machine-1",
+		"disk_removed machine-1",
+		"machine_added machine-3",
+		"machine_removed machine-2",
+	}, summary)
+
+	assert.Equal(t, "cluster-1", changes[0].FromCluster)
+	assert.Empty(t, changes[0].ToCluster)
+	assert.Equal(t, "S3", changes[1].Disk.Serial)
+	assert.Equal(t, "S2", changes[2].Disk.Serial)
+	assert.Equal(t, "machine-3", changes[3].Machine.ID)
+
+	assert.Empty(t, inventory.Diff(current, current))
+}
@@ -11,6 +11,7 @@ func init() {
 	registry.MustRegisterResource(AuthConfigType, &Config{})
 	registry.MustRegisterResource(IdentityType, &Identity{})
 	registry.MustRegisterResource(PublicKeyType, &PublicKey{})
+	registry.MustRegisterResource(IssuedKubeconfigType, &IssuedKubeconfig{})
 	registry.MustRegisterResource(UserType, &User{})
 	registry.MustRegisterResource(AccessPolicyType, &AccessPolicy{})
 	registry.MustRegisterResource(SAMLAssertionType, &SAMLAssertion{})
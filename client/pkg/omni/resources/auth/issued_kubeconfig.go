@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewIssuedKubeconfig creates a new IssuedKubeconfig resource.
+//
+// The ID of the resource is the ID of the service account token in the kubeconfig.
+func NewIssuedKubeconfig(ns, id string) *IssuedKubeconfig {
+	return typed.NewResource[IssuedKubeconfigSpec, IssuedKubeconfigExtension](
+		resource.NewMetadata(ns, IssuedKubeconfigType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.IssuedKubeconfigSpec{}),
+	)
+}
+
+const (
+	// IssuedKubeconfigType is the type of IssuedKubeconfig resource.
+	//
+	// tsgen:IssuedKubeconfigType
+	IssuedKubeconfigType = resource.Type("IssuedKubeconfigs.omni.sidero.dev")
+)
+
+// IssuedKubeconfig resource describes a service account kubeconfig issued by Omni.
+type IssuedKubeconfig = typed.Resource[IssuedKubeconfigSpec, IssuedKubeconfigExtension]
+
+// IssuedKubeconfigSpec wraps specs.IssuedKubeconfigSpec.
+type IssuedKubeconfigSpec = protobuf.ResourceSpec[specs.IssuedKubeconfigSpec, *specs.IssuedKubeconfigSpec]
+
+// IssuedKubeconfigExtension providers auxiliary methods for IssuedKubeconfig resource.
+type IssuedKubeconfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (IssuedKubeconfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             IssuedKubeconfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Cluster",
+				JSONPath: "{.cluster}",
+			},
+			{
+				Name:     "Subject",
+				JSONPath: "{.subject}",
+			},
+			{
+				Name:     "Expires At",
+				JSONPath: "{.expiresat}",
+			},
+			{
+				Name:     "Revoked",
+				JSONPath: "{.revoked}",
+			},
+		},
+	}
+}
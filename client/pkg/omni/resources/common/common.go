@@ -29,4 +29,6 @@ var UserManagedResourceTypes = []resource.Type{
 	omni.ExtensionsConfigurationType,
 	omni.MachineRequestSetType,
 	omni.InfraMachineConfigType,
+	omni.AdmissionPolicyType,
+	omni.AddonType,
 }
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewAddon creates new Addon resource.
+func NewAddon(ns string, id resource.ID) *Addon {
+	return typed.NewResource[AddonSpec, AddonExtension](
+		resource.NewMetadata(ns, AddonType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.AddonSpec{}),
+	)
+}
+
+const (
+	// AddonType is the type of the Addon resource.
+	// tsgen:AddonType
+	AddonType = resource.Type("Addons.omni.sidero.dev")
+)
+
+// Addon describes the Kubernetes manifests or the Helm chart installed into the workload clusters.
+type Addon = typed.Resource[AddonSpec, AddonExtension]
+
+// AddonSpec wraps specs.AddonSpec.
+type AddonSpec = protobuf.ResourceSpec[specs.AddonSpec, *specs.AddonSpec]
+
+// AddonExtension provides auxiliary methods for Addon resource.
+type AddonExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (AddonExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AddonType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Clusters",
+				JSONPath: "{.clusters}",
+			},
+			{
+				Name:     "Cluster Selector",
+				JSONPath: "{.clusterselector}",
+			},
+			{
+				Name:     "Chart",
+				JSONPath: "{.helmchart.name}",
+			},
+		},
+	}
+}
+
+// AddonClusterSelectorQuery parses the cluster selector of the addon.
+//
+// The empty selector doesn't match any cluster, so the addon applies only to the clusters listed by name.
+func AddonClusterSelectorQuery(addon *Addon) (*resource.LabelQuery, error) {
+	selector := strings.TrimSpace(addon.TypedSpec().Value.ClusterSelector)
+	if selector == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	query, err := labels.ParseQuery(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cluster selector %q: %w", selector, err)
+	}
+
+	return query, nil
+}
+
+// AddonMatchesCluster returns true if the addon is installed into the cluster.
+func AddonMatchesCluster(addon *Addon, cluster *Cluster) (bool, error) {
+	if slices.Contains(addon.TypedSpec().Value.Clusters, cluster.Metadata().ID()) {
+		return true, nil
+	}
+
+	query, err := AddonClusterSelectorQuery(addon)
+	if err != nil || query == nil {
+		return false, err
+	}
+
+	return query.Matches(*cluster.Metadata().Labels()), nil
+}
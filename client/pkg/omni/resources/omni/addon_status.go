@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewAddonStatus creates new AddonStatus resource.
+func NewAddonStatus(ns string, id resource.ID) *AddonStatus {
+	return typed.NewResource[AddonStatusSpec, AddonStatusExtension](
+		resource.NewMetadata(ns, AddonStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.AddonStatusSpec{}),
+	)
+}
+
+const (
+	// AddonStatusType is the type of the AddonStatus resource.
+	// tsgen:AddonStatusType
+	AddonStatusType = resource.Type("AddonStatuses.omni.sidero.dev")
+)
+
+// AddonStatus describes the state of the addon in the cluster.
+type AddonStatus = typed.Resource[AddonStatusSpec, AddonStatusExtension]
+
+// AddonStatusSpec wraps specs.AddonStatusSpec.
+type AddonStatusSpec = protobuf.ResourceSpec[specs.AddonStatusSpec, *specs.AddonStatusSpec]
+
+// AddonStatusExtension provides auxiliary methods for AddonStatus resource.
+type AddonStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (AddonStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AddonStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Out Of Sync",
+				JSONPath: "{.outofsync}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.error}",
+			},
+		},
+	}
+}
+
+// AddonStatusID returns the ID of the addon status in the cluster.
+func AddonStatusID(clusterID, addonID resource.ID) resource.ID {
+	return clusterID + "-" + addonID
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewAdmissionPolicy creates new AdmissionPolicy resource.
+func NewAdmissionPolicy(ns string, id resource.ID) *AdmissionPolicy {
+	return typed.NewResource[AdmissionPolicySpec, AdmissionPolicyExtension](
+		resource.NewMetadata(ns, AdmissionPolicyType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.AdmissionPolicySpec{}),
+	)
+}
+
+const (
+	// AdmissionPolicyType is the type of the AdmissionPolicy resource.
+	// tsgen:AdmissionPolicyType
+	AdmissionPolicyType = resource.Type("AdmissionPolicies.omni.sidero.dev")
+)
+
+// AdmissionPolicy describes a user-defined admission policy for the resources.
+type AdmissionPolicy = typed.Resource[AdmissionPolicySpec, AdmissionPolicyExtension]
+
+// AdmissionPolicySpec wraps specs.AdmissionPolicySpec.
+type AdmissionPolicySpec = protobuf.ResourceSpec[specs.AdmissionPolicySpec, *specs.AdmissionPolicySpec]
+
+// AdmissionPolicyExtension provides auxiliary methods for AdmissionPolicy resource.
+type AdmissionPolicyExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (AdmissionPolicyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AdmissionPolicyType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Resource Types",
+				JSONPath: "{.resourcetypes}",
+			},
+		},
+	}
+}
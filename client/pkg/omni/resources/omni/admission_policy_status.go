@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewAdmissionPolicyStatus creates new AdmissionPolicyStatus resource.
+func NewAdmissionPolicyStatus(ns string, id resource.ID) *AdmissionPolicyStatus {
+	return typed.NewResource[AdmissionPolicyStatusSpec, AdmissionPolicyStatusExtension](
+		resource.NewMetadata(ns, AdmissionPolicyStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.AdmissionPolicyStatusSpec{}),
+	)
+}
+
+const (
+	// AdmissionPolicyStatusType is the type of the AdmissionPolicyStatus resource.
+	// tsgen:AdmissionPolicyStatusType
+	AdmissionPolicyStatusType = resource.Type("AdmissionPolicyStatuses.omni.sidero.dev")
+)
+
+// AdmissionPolicyStatus reports whether the admission policy with the same ID is enforced.
+type AdmissionPolicyStatus = typed.Resource[AdmissionPolicyStatusSpec, AdmissionPolicyStatusExtension]
+
+// AdmissionPolicyStatusSpec wraps specs.AdmissionPolicyStatusSpec.
+type AdmissionPolicyStatusSpec = protobuf.ResourceSpec[specs.AdmissionPolicyStatusSpec, *specs.AdmissionPolicyStatusSpec]
+
+// AdmissionPolicyStatusExtension provides auxiliary methods for AdmissionPolicyStatus resource.
+type AdmissionPolicyStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (AdmissionPolicyStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AdmissionPolicyStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Error",
+				JSONPath: "{.error}",
+			},
+		},
+	}
+}
@@ -17,6 +17,11 @@ const (
 	// tsgen:ResourceManagedByClusterTemplates
 	ResourceManagedByClusterTemplates = SystemLabelPrefix + "managed-by-cluster-templates"
 
+	// FleetPatchSelector is the label selector of the fleet config patch, e.g. "env=prod,region=eu".
+	// The patch without the selector applies to all resources of its target.
+	// tsgen:FleetPatchSelector
+	FleetPatchSelector = SystemLabelPrefix + "fleet-patch-selector"
+
 	// ConfigPatchName human readable patch name.
 	// tsgen:ConfigPatchName
 	ConfigPatchName = "name"
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/api/v1alpha1"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewChangeRequest creates new ChangeRequest resource.
+func NewChangeRequest(ns string, id resource.ID) *ChangeRequest {
+	return typed.NewResource[ChangeRequestSpec, ChangeRequestExtension](
+		resource.NewMetadata(ns, ChangeRequestType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ChangeRequestSpec{}),
+	)
+}
+
+const (
+	// ChangeRequestType is the type of the ChangeRequest resource.
+	// tsgen:ChangeRequestType
+	ChangeRequestType = resource.Type("ChangeRequests.omni.sidero.dev")
+)
+
+// ChangeRequest describes a resource write held until it is approved by another user.
+type ChangeRequest = typed.Resource[ChangeRequestSpec, ChangeRequestExtension]
+
+// ChangeRequestSpec wraps specs.ChangeRequestSpec.
+type ChangeRequestSpec = protobuf.ResourceSpec[specs.ChangeRequestSpec, *specs.ChangeRequestSpec]
+
+// ChangeRequestExtension provides auxiliary methods for ChangeRequest resource.
+type ChangeRequestExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ChangeRequestExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ChangeRequestType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Operation",
+				JSONPath: "{.operation}",
+			},
+			{
+				Name:     "Resource Type",
+				JSONPath: "{.resourcetype}",
+			},
+			{
+				Name:     "Resource ID",
+				JSONPath: "{.resourceid}",
+			},
+			{
+				Name:     "Requested By",
+				JSONPath: "{.requestedby}",
+			},
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+		},
+		Sensitivity: meta.Sensitive,
+	}
+}
+
+// Change request operations.
+const (
+	ChangeRequestOperationCreate  = "create"
+	ChangeRequestOperationUpdate  = "update"
+	ChangeRequestOperationDestroy = "destroy"
+)
+
+// EncodeChangeRequestResource encodes the requested resource for the ChangeRequestSpec.Resource field.
+func EncodeChangeRequestResource(res resource.Resource) ([]byte, error) {
+	protoRes, err := protobuf.FromResource(res)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaled, err := protoRes.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(marshaled)
+}
+
+// DecodeChangeRequestResource decodes the requested resource from the ChangeRequestSpec.Resource field.
+func DecodeChangeRequestResource(data []byte) (resource.Resource, error) { //nolint:ireturn
+	var marshaled v1alpha1.Resource
+
+	if err := proto.Unmarshal(data, &marshaled); err != nil {
+		return nil, err
+	}
+
+	protoRes, err := protobuf.Unmarshal(&marshaled)
+	if err != nil {
+		return nil, err
+	}
+
+	return protobuf.UnmarshalResource(protoRes)
+}
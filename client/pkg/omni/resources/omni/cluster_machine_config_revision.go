@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewClusterMachineConfigRevision creates new ClusterMachineConfigRevision resource.
+func NewClusterMachineConfigRevision(ns string, id resource.ID) *ClusterMachineConfigRevision {
+	return typed.NewResource[ClusterMachineConfigRevisionSpec, ClusterMachineConfigRevisionExtension](
+		resource.NewMetadata(ns, ClusterMachineConfigRevisionType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ClusterMachineConfigRevisionSpec{}),
+	)
+}
+
+// ClusterMachineConfigRevisionID returns the ID of the cluster machine config revision.
+func ClusterMachineConfigRevisionID(clusterMachineID resource.ID, revision uint64) resource.ID {
+	return fmt.Sprintf("%s-r%d", clusterMachineID, revision)
+}
+
+const (
+	// ClusterMachineConfigRevisionType is the type of the ClusterMachineConfigRevision resource.
+	// tsgen:ClusterMachineConfigRevisionType
+	ClusterMachineConfigRevisionType = resource.Type("ClusterMachineConfigRevisions.omni.sidero.dev")
+)
+
+// ClusterMachineConfigRevision keeps a revision of the redacted machine config generated for the cluster machine.
+//
+// ClusterMachineConfigRevision resource ID is the cluster machine ID with the revision number suffix,
+// the cluster machine ID is kept in the LabelClusterMachine label.
+type ClusterMachineConfigRevision = typed.Resource[ClusterMachineConfigRevisionSpec, ClusterMachineConfigRevisionExtension]
+
+// ClusterMachineConfigRevisionSpec wraps specs.ClusterMachineConfigRevisionSpec.
+type ClusterMachineConfigRevisionSpec = protobuf.ResourceSpec[specs.ClusterMachineConfigRevisionSpec, *specs.ClusterMachineConfigRevisionSpec]
+
+// ClusterMachineConfigRevisionExtension provides auxiliary methods for ClusterMachineConfigRevision resource.
+type ClusterMachineConfigRevisionExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ClusterMachineConfigRevisionExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ClusterMachineConfigRevisionType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Revision",
+				JSONPath: "{.revision}",
+			},
+		},
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 )
 
@@ -84,6 +85,34 @@ func (ConfigPatchExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 	}
 }
 
+// Fleet config patch targets, the values of the LabelFleetPatchTarget label.
+const (
+	FleetPatchTargetCluster    = "cluster"
+	FleetPatchTargetMachineSet = "machine-set"
+	FleetPatchTargetMachine    = "machine"
+)
+
+// FleetPatchTargets lists the fleet config patch targets in the order their patches are applied.
+var FleetPatchTargets = []string{FleetPatchTargetCluster, FleetPatchTargetMachineSet, FleetPatchTargetMachine}
+
+// FleetPatchSelectorQuery parses the FleetPatchSelector annotation of the fleet config patch.
+//
+// The empty selector matches all resources.
+func FleetPatchSelectorQuery(patch *ConfigPatch) (resource.LabelQuery, error) {
+	selector, _ := patch.Metadata().Annotations().Get(FleetPatchSelector)
+
+	if strings.TrimSpace(selector) == "" {
+		return resource.LabelQuery{}, nil
+	}
+
+	query, err := labels.ParseQuery(selector)
+	if err != nil {
+		return resource.LabelQuery{}, fmt.Errorf("invalid fleet patch selector %q: %w", selector, err)
+	}
+
+	return *query, nil
+}
+
 // ValidateConfigPatch parses the config patch data using Talos config loader,
 // then validates that the config patch doesn't have fields that are controlled by omni.
 func ValidateConfigPatch(data []byte) error {
@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewConfigPatchRevision creates new ConfigPatchRevision resource.
+func NewConfigPatchRevision(ns string, id resource.ID) *ConfigPatchRevision {
+	return typed.NewResource[ConfigPatchRevisionSpec, ConfigPatchRevisionExtension](
+		resource.NewMetadata(ns, ConfigPatchRevisionType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ConfigPatchRevisionSpec{}),
+	)
+}
+
+// ConfigPatchRevisionID returns the ID of the config patch revision.
+func ConfigPatchRevisionID(configPatchID resource.ID, revision uint64) resource.ID {
+	return fmt.Sprintf("%s-r%d", configPatchID, revision)
+}
+
+const (
+	// ConfigPatchRevisionType is the type of the ConfigPatchRevision resource.
+	// tsgen:ConfigPatchRevisionType
+	ConfigPatchRevisionType = resource.Type("ConfigPatchRevisions.omni.sidero.dev")
+)
+
+// ConfigPatchRevision keeps a revision of the config patch content.
+//
+// ConfigPatchRevision resource ID is the config patch ID with the revision number suffix,
+// the config patch ID is kept in the LabelConfigPatch label.
+type ConfigPatchRevision = typed.Resource[ConfigPatchRevisionSpec, ConfigPatchRevisionExtension]
+
+// ConfigPatchRevisionSpec wraps specs.ConfigPatchRevisionSpec.
+type ConfigPatchRevisionSpec = protobuf.ResourceSpec[specs.ConfigPatchRevisionSpec, *specs.ConfigPatchRevisionSpec]
+
+// ConfigPatchRevisionExtension provides auxiliary methods for ConfigPatchRevision resource.
+type ConfigPatchRevisionExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ConfigPatchRevisionExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigPatchRevisionType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Revision",
+				JSONPath: "{.revision}",
+			},
+		},
+		Sensitivity: meta.Sensitive,
+	}
+}
@@ -0,0 +1,273 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// ConfigPatchTemplateMaxSize is the maximum size of the rendered config patch template.
+const ConfigPatchTemplateMaxSize = 1 << 20
+
+// ConfigPatchTemplateSampleListSize is the number of the addresses and the MACs in the sample data the templates are validated with.
+//
+// The templates which index the lists further, e.g. {{ index .Machine.MACs 8 }}, are rejected, use range to handle any number of the entries.
+const ConfigPatchTemplateSampleListSize = 8
+
+// ConfigPatchTemplateData is the data available in the config patch templates.
+//
+// Example:
+//
+//	machine:
+//	  network:
+//	    hostname: {{ .Cluster.Name }}-{{ .Machine.Labels.rack }}-{{ .Machine.ID | trunc 8 }}
+//
+// The templates are validated against the sample data before they are accepted, see [ConfigPatchTemplateSampleListSize].
+type ConfigPatchTemplateData struct {
+	Cluster    ConfigPatchTemplateCluster
+	MachineSet ConfigPatchTemplateMachineSet
+	Machine    ConfigPatchTemplateMachine
+}
+
+// ConfigPatchTemplateCluster is the cluster metadata available in the config patch templates.
+type ConfigPatchTemplateCluster struct {
+	Labels            map[string]string
+	Name              string
+	TalosVersion      string
+	KubernetesVersion string
+}
+
+// ConfigPatchTemplateMachineSet is the machine set metadata available in the config patch templates.
+type ConfigPatchTemplateMachineSet struct {
+	Labels map[string]string
+	Name   string
+}
+
+// ConfigPatchTemplateMachine is the machine facts available in the config patch templates.
+type ConfigPatchTemplateMachine struct {
+	Labels     map[string]string
+	Platform   ConfigPatchTemplatePlatform
+	ID         string
+	Role       string
+	Hostname   string
+	Domainname string
+	Arch       string
+	Addresses  []string
+	MACs       []string
+}
+
+// ConfigPatchTemplatePlatform is the platform metadata of the machine available in the config patch templates.
+type ConfigPatchTemplatePlatform struct {
+	Platform     string
+	Hostname     string
+	Region       string
+	Zone         string
+	InstanceType string
+	InstanceID   string
+	ProviderID   string
+	Spot         bool
+}
+
+// NewConfigPatchTemplateData builds the config patch template data of the machine.
+//
+// The machine set and the machine status are optional.
+func NewConfigPatchTemplateData(cluster *Cluster, machineSet *MachineSet, clusterMachine *ClusterMachine, machineStatus *MachineStatus) ConfigPatchTemplateData {
+	data := ConfigPatchTemplateData{
+		Cluster: ConfigPatchTemplateCluster{
+			Name:              cluster.Metadata().ID(),
+			Labels:            rawLabels(cluster.Metadata().Labels()),
+			TalosVersion:      cluster.TypedSpec().Value.TalosVersion,
+			KubernetesVersion: cluster.TypedSpec().Value.KubernetesVersion,
+		},
+		Machine: ConfigPatchTemplateMachine{
+			ID:     clusterMachine.Metadata().ID(),
+			Role:   "worker",
+			Labels: map[string]string{},
+		},
+	}
+
+	if _, ok := clusterMachine.Metadata().Labels().Get(LabelControlPlaneRole); ok {
+		data.Machine.Role = "controlplane"
+	}
+
+	if machineSet != nil {
+		data.MachineSet = ConfigPatchTemplateMachineSet{
+			Name:   machineSet.Metadata().ID(),
+			Labels: rawLabels(machineSet.Metadata().Labels()),
+		}
+	}
+
+	if machineStatus == nil {
+		return data
+	}
+
+	spec := machineStatus.TypedSpec().Value
+
+	data.Machine.Labels = rawLabels(machineStatus.Metadata().Labels())
+
+	if network := spec.GetNetwork(); network != nil {
+		data.Machine.Hostname = network.Hostname
+		data.Machine.Domainname = network.Domainname
+		data.Machine.Addresses = network.Addresses
+		data.Machine.MACs = xslices.Map(network.NetworkLinks, func(link *specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus) string {
+			return link.HardwareAddress
+		})
+	}
+
+	data.Machine.Arch = spec.GetHardware().GetArch()
+
+	if platform := spec.GetPlatformMetadata(); platform != nil {
+		data.Machine.Platform = ConfigPatchTemplatePlatform{
+			Platform:     platform.Platform,
+			Hostname:     platform.Hostname,
+			Region:       platform.Region,
+			Zone:         platform.Zone,
+			InstanceType: platform.InstanceType,
+			InstanceID:   platform.InstanceId,
+			ProviderID:   platform.ProviderId,
+			Spot:         platform.Spot,
+		}
+	}
+
+	return data
+}
+
+func rawLabels(labels *resource.Labels) map[string]string {
+	result := make(map[string]string, len(labels.Raw()))
+
+	for key, value := range labels.Raw() {
+		result[key] = value
+	}
+
+	return result
+}
+
+// configPatchTemplateFuncs are the only functions available in the config patch templates in addition to the text/template builtins.
+//
+// The functions are pure string helpers, so that the templates can't access anything except the template data.
+var configPatchTemplateFuncs = template.FuncMap{
+	"default": func(def, value string) string {
+		if value == "" {
+			return def
+		}
+
+		return value
+	},
+	"lower":      strings.ToLower,
+	"upper":      strings.ToUpper,
+	"replace":    func(old, replacement, s string) string { return strings.ReplaceAll(s, old, replacement) },
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+	"join":       func(sep string, elems []string) string { return strings.Join(elems, sep) },
+	"trunc": func(n int, s string) string {
+		if n < 0 || len(s) <= n {
+			return s
+		}
+
+		return s[:n]
+	},
+}
+
+// errTemplateTooLarge is returned when the rendered template exceeds ConfigPatchTemplateMaxSize.
+var errTemplateTooLarge = fmt.Errorf("rendered config patch template exceeds %d bytes", ConfigPatchTemplateMaxSize)
+
+type limitedBuffer struct {
+	bytes.Buffer
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > ConfigPatchTemplateMaxSize {
+		return 0, errTemplateTooLarge
+	}
+
+	return b.Buffer.Write(p)
+}
+
+// IsConfigPatchTemplate returns true if the config patch is a template.
+func IsConfigPatchTemplate(patch *ConfigPatch) bool {
+	_, ok := patch.Metadata().Labels().Get(LabelConfigPatchTemplate)
+
+	return ok
+}
+
+// RenderConfigPatchTemplate renders the config patch template with the given data.
+//
+// The missing map keys, e.g. machine labels, render as empty strings, while the unknown fields are reported as errors.
+func RenderConfigPatchTemplate(patch []byte, data ConfigPatchTemplateData) ([]byte, error) {
+	tmpl, err := template.New("patch").Option("missingkey=zero").Funcs(configPatchTemplateFuncs).Parse(string(patch))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the config patch template: %w", err)
+	}
+
+	var buf limitedBuffer
+
+	if err = tmpl.Execute(&buf, data); err != nil {
+		if errors.Is(err, errTemplateTooLarge) {
+			return nil, errTemplateTooLarge
+		}
+
+		return nil, fmt.Errorf("failed to render the config patch template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ConfigPatchTemplateSampleData is used to validate the config patch templates before they are accepted.
+//
+// The machine has several addresses and MACs, so that the templates indexing them for the multi-NIC machines are accepted.
+var ConfigPatchTemplateSampleData = ConfigPatchTemplateData{
+	Cluster: ConfigPatchTemplateCluster{
+		Name:              "cluster",
+		Labels:            map[string]string{},
+		TalosVersion:      "1.8.0",
+		KubernetesVersion: "1.31.0",
+	},
+	MachineSet: ConfigPatchTemplateMachineSet{
+		Name:   "machine-set",
+		Labels: map[string]string{},
+	},
+	Machine: ConfigPatchTemplateMachine{
+		ID:        "00000000-0000-0000-0000-000000000000",
+		Role:      "worker",
+		Hostname:  "machine",
+		Arch:      "amd64",
+		Labels:    map[string]string{},
+		Addresses: sampleList("192.168.0.%d/24", 2),
+		MACs:      sampleList("00:00:00:00:00:%02x", 0),
+		Platform: ConfigPatchTemplatePlatform{
+			Platform: "metal",
+		},
+	},
+}
+
+// sampleList builds the list of ConfigPatchTemplateSampleListSize entries formatted with the consecutive numbers starting with first.
+func sampleList(format string, first int) []string {
+	list := make([]string, 0, ConfigPatchTemplateSampleListSize)
+
+	for i := range ConfigPatchTemplateSampleListSize {
+		list = append(list, fmt.Sprintf(format, first+i))
+	}
+
+	return list
+}
+
+// ValidateConfigPatchTemplate renders the config patch template with the sample data, then validates the result using ValidateConfigPatch.
+func ValidateConfigPatchTemplate(patch []byte) error {
+	rendered, err := RenderConfigPatchTemplate(patch, ConfigPatchTemplateSampleData)
+	if err != nil {
+		return err
+	}
+
+	return ValidateConfigPatch(rendered)
+}
@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestRenderConfigPatchTemplate(t *testing.T) {
+	t.Parallel()
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "prod")
+	cluster.TypedSpec().Value.TalosVersion = "1.8.0"
+	cluster.Metadata().Labels().Set("region", "eu")
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, omni.ControlPlanesResourceID("prod"))
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "0123456789abcdef")
+	clusterMachine.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "0123456789abcdef")
+	machineStatus.Metadata().Labels().Set("rack", "r1")
+	machineStatus.TypedSpec().Value.Network = &specs.MachineStatusSpec_NetworkStatus{
+		Hostname: "talos-abc",
+		NetworkLinks: []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+			{HardwareAddress: "aa:bb:cc:dd:ee:ff"},
+			{HardwareAddress: "aa:bb:cc:dd:ee:00"},
+		},
+	}
+	machineStatus.TypedSpec().Value.PlatformMetadata = &specs.MachineStatusSpec_PlatformMetadata{
+		Platform: "aws",
+		Zone:     "eu-west-1a",
+	}
+
+	data := omni.NewConfigPatchTemplateData(cluster, machineSet, clusterMachine, machineStatus)
+
+	rendered, err := omni.RenderConfigPatchTemplate([]byte(strings.TrimSpace(`
+machine:
+  network:
+    hostname: {{ .Cluster.Name }}-{{ .Cluster.Labels.region }}-{{ .Machine.Labels.rack }}-{{ .Machine.ID | trunc 8 }}
+  nodeLabels:
+    role: {{ .Machine.Role }}
+    zone: {{ .Machine.Platform.Zone | default "none" }}
+    vlan: "{{ .Machine.Labels.vlan | default "100" }}"
+    mac: "{{ index .Machine.MACs 0 }}"
+    set: {{ .MachineSet.Name }}
+`)), data)
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.TrimSpace(`
+machine:
+  network:
+    hostname: prod-eu-r1-01234567
+  nodeLabels:
+    role: controlplane
+    zone: eu-west-1a
+    vlan: "100"
+    mac: "aa:bb:cc:dd:ee:ff"
+    set: prod-control-planes
+`), string(rendered))
+
+	_, err = omni.RenderConfigPatchTemplate([]byte("{{ .Machine.Serial }}"), data)
+	require.ErrorContains(t, err, "can't evaluate field Serial")
+
+	_, err = omni.RenderConfigPatchTemplate([]byte(`{{ range .Machine.MACs }}{{ range $.Machine.MACs }}{{ printf "%1000000s" . }}{{ end }}{{ end }}`), data)
+	require.ErrorContains(t, err, "rendered config patch template exceeds")
+}
+
+func TestValidateConfigPatchTemplate(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name          string
+		template      string
+		expectedError string
+	}{
+		{
+			name:     "valid",
+			template: "machine:\n  network:\n    hostname: {{ .Machine.Hostname }}-{{ .Machine.Labels.rack }}\n",
+		},
+		{
+			name:     "multiple NICs",
+			template: "machine:\n  nodeLabels:\n    mac: \"{{ index .Machine.MACs 1 }}\"\n    address: \"{{ index .Machine.Addresses 1 }}\"\n",
+		},
+		{
+			name:          "index out of the sample data",
+			template:      "machine:\n  nodeLabels:\n    mac: \"{{ index .Machine.MACs 8 }}\"\n",
+			expectedError: "index out of range",
+		},
+		{
+			name:          "parse error",
+			template:      "machine:\n  network:\n    hostname: {{ .Machine.Hostname \n",
+			expectedError: "failed to parse the config patch template",
+		},
+		{
+			name:          "unknown field",
+			template:      "machine:\n  network:\n    hostname: {{ .Machine.Rack }}\n",
+			expectedError: "can't evaluate field Rack",
+		},
+		{
+			name:          "forbidden field",
+			template:      "cluster:\n  clusterName: {{ .Cluster.Name }}\n",
+			expectedError: "is not allowed in the config patch",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := omni.ValidateConfigPatchTemplate([]byte(tt.template))
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
@@ -46,6 +46,23 @@ const (
 	// tsgen:LabelMachine
 	LabelMachine = SystemLabelPrefix + "machine"
 
+	// LabelFleetPatchTarget marks the config patch as a fleet patch, which applies to all clusters, machine sets or machines
+	// matching the FleetPatchSelector annotation, the value is one of the FleetPatchTarget constants.
+	// tsgen:LabelFleetPatchTarget
+	LabelFleetPatchTarget = SystemLabelPrefix + "fleet-patch-target"
+
+	// LabelConfigPatch defines the config patch relation label.
+	// tsgen:LabelConfigPatch
+	LabelConfigPatch = SystemLabelPrefix + "config-patch"
+
+	// LabelAddon defines the addon relation label.
+	// tsgen:LabelAddon
+	LabelAddon = SystemLabelPrefix + "addon"
+
+	// LabelConfigPatchTemplate marks the config patch as a template, which is rendered for each machine it applies to.
+	// tsgen:LabelConfigPatchTemplate
+	LabelConfigPatchTemplate = SystemLabelPrefix + "config-patch-template"
+
 	// LabelSystemPatch marks the patch as the system patch, so it shouldn't be editable by the user.
 	// tsgen:LabelSystemPatch
 	LabelSystemPatch = SystemLabelPrefix + "system-patch"
@@ -81,6 +98,10 @@ const (
 	// tsgen:LabelNoManualAllocation
 	LabelNoManualAllocation = SystemLabelPrefix + "no-manual-allocation"
 
+	// LabelMachineClassMismatch is set on the machine set nodes which no longer match the match expression of their machine class.
+	// tsgen:LabelMachineClassMismatch
+	LabelMachineClassMismatch = SystemLabelPrefix + "machine-class-mismatch"
+
 	// LabelIsManagedByStaticInfraProvider is set on the machines managed by static infra providers.
 	LabelIsManagedByStaticInfraProvider = SystemLabelPrefix + "is-managed-by-static-infra-provider"
 )
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineHealthStatus creates new MachineHealthStatus state.
+func NewMachineHealthStatus(ns, id string) *MachineHealthStatus {
+	return typed.NewResource[MachineHealthStatusSpec, MachineHealthStatusExtension](
+		resource.NewMetadata(ns, MachineHealthStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineHealthStatusSpec{}),
+	)
+}
+
+// MachineHealthStatusType is the type of MachineHealthStatus resource.
+//
+// tsgen:MachineHealthStatusType
+const MachineHealthStatusType = resource.Type("MachineHealthStatuses.omni.sidero.dev")
+
+// MachineHealthStatus resource contains the hardware health diagnostics of the machine with their history.
+//
+// MachineHealthStatus resource ID is a Machine UUID.
+type MachineHealthStatus = typed.Resource[MachineHealthStatusSpec, MachineHealthStatusExtension]
+
+// MachineHealthStatusSpec wraps specs.MachineHealthStatusSpec.
+type MachineHealthStatusSpec = protobuf.ResourceSpec[specs.MachineHealthStatusSpec, *specs.MachineHealthStatusSpec]
+
+// MachineHealthStatusExtension providers auxiliary methods for MachineHealthStatus resource.
+type MachineHealthStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineHealthStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineHealthStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Severity",
+				JSONPath: "{.severity}",
+			},
+		},
+	}
+}
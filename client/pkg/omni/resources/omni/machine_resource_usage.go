@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineResourceUsage creates new MachineResourceUsage state.
+func NewMachineResourceUsage(ns, id string) *MachineResourceUsage {
+	return typed.NewResource[MachineResourceUsageSpec, MachineResourceUsageExtension](
+		resource.NewMetadata(ns, MachineResourceUsageType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineResourceUsageSpec{}),
+	)
+}
+
+// MachineResourceUsageType is the type of MachineResourceUsage resource.
+//
+// tsgen:MachineResourceUsageType
+const MachineResourceUsageType = resource.Type("MachineResourceUsages.omni.sidero.dev")
+
+// MachineResourceUsage resource contains the CPU, memory, filesystem and network utilization of the machine.
+//
+// MachineResourceUsage resource ID is a Machine UUID.
+// It is updated on each collection interval, so it is kept in the ephemeral namespace.
+type MachineResourceUsage = typed.Resource[MachineResourceUsageSpec, MachineResourceUsageExtension]
+
+// MachineResourceUsageSpec wraps specs.MachineResourceUsageSpec.
+type MachineResourceUsageSpec = protobuf.ResourceSpec[specs.MachineResourceUsageSpec, *specs.MachineResourceUsageSpec]
+
+// MachineResourceUsageExtension providers auxiliary methods for MachineResourceUsage resource.
+type MachineResourceUsageExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineResourceUsageExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineResourceUsageType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.EphemeralNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Load",
+				JSONPath: "{.load1}",
+			},
+			{
+				Name:     "CPU %",
+				JSONPath: "{.cpuusagepercent}",
+			},
+			{
+				Name:     "Memory Available",
+				JSONPath: "{.memoryavailablebytes}",
+			},
+		},
+	}
+}
@@ -25,12 +25,14 @@ func init() {
 	registry.MustRegisterResource(ClusterMachineStatusType, &ClusterMachineStatus{})
 	registry.MustRegisterResource(ClusterMachineRequestStatusType, &ClusterMachineRequestStatus{})
 	registry.MustRegisterResource(ClusterMachineConfigType, &ClusterMachineConfig{})
+	registry.MustRegisterResource(ClusterMachineConfigRevisionType, &ClusterMachineConfigRevision{})
 	registry.MustRegisterResource(ClusterMachineConfigPatchesType, &ClusterMachineConfigPatches{})
 	registry.MustRegisterResource(ClusterMachineConfigStatusType, &ClusterMachineConfigStatus{})
 	registry.MustRegisterResource(ClusterMachineTalosVersionType, &ClusterMachineTalosVersion{})
 	registry.MustRegisterResource(ClusterMachineTemplateType, &ClusterMachineTemplate{})
 	registry.MustRegisterResource(ClusterTaintType, &ClusterTaint{})
 	registry.MustRegisterResource(ConfigPatchType, &ConfigPatch{})
+	registry.MustRegisterResource(ConfigPatchRevisionType, &ConfigPatchRevision{})
 	registry.MustRegisterResource(EtcdAuditResultType, &EtcdAuditResult{})
 	registry.MustRegisterResource(EtcdBackupType, &EtcdBackup{})
 	registry.MustRegisterResource(EtcdBackupS3ConfType, &EtcdBackupS3Conf{})
@@ -58,9 +60,16 @@ func init() {
 	registry.MustRegisterResource(MachineLabelsType, &MachineLabels{})
 	registry.MustRegisterResource(MachineType, &Machine{})
 	registry.MustRegisterResource(MachineClassType, &MachineClass{})
+	registry.MustRegisterResource(AdmissionPolicyType, &AdmissionPolicy{})
+	registry.MustRegisterResource(AdmissionPolicyStatusType, &AdmissionPolicyStatus{})
+	registry.MustRegisterResource(ChangeRequestType, &ChangeRequest{})
+	registry.MustRegisterResource(AddonType, &Addon{})
+	registry.MustRegisterResource(AddonStatusType, &AddonStatus{})
 	registry.MustRegisterResource(MachineConfigGenOptionsType, &MachineConfigGenOptions{})
 	registry.MustRegisterResource(MachineExtensionsStatusType, &MachineExtensionsStatus{})
 	registry.MustRegisterResource(MachineExtensionsType, &MachineExtensions{})
+	registry.MustRegisterResource(MachineHealthStatusType, &MachineHealthStatus{})
+	registry.MustRegisterResource(MachineResourceUsageType, &MachineResourceUsage{})
 	registry.MustRegisterResource(MachineRequestSetType, &MachineRequestSet{})
 	registry.MustRegisterResource(MachineRequestSetStatusType, &MachineRequestSetStatus{})
 	registry.MustRegisterResource(MachineSetType, &MachineSet{})
@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/virtual"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+	"github.com/siderolabs/omni/client/pkg/omnictl/output"
+)
+
+var (
+	// changeRequestCmd represents the changerequest command.
+	changeRequestCmd = &cobra.Command{
+		Use:     "changerequest",
+		Aliases: []string{"cr"},
+		Short:   "Change request related subcommands.",
+		Long: "The writes matching the admission policies with the REQUIRE_APPROVAL action are held as change requests " +
+			"until they are approved by another user with the approver role.\n\n" +
+			"Use 'omnictl get changerequests' to list the change requests.",
+	}
+
+	changeRequestShowCmd = &cobra.Command{
+		Use:     "show <id>",
+		Short:   "Show the change request and the requested resource",
+		Example: "omnictl changerequest show 5f3c4a1e-8d3b-4b0e-9a4c-7a2d1c9e6b10",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return showChangeRequest(ctx, client.Omni().State(), args[0])
+			})
+		},
+	}
+
+	changeRequestApproveCmd = &cobra.Command{
+		Use:   "approve <id>",
+		Short: "Approve the pending change request",
+		Long: "Approve the pending change request, Omni applies the requested change once it is approved.\n\n" +
+			"The change request can not be approved by the user who requested it.",
+		Example: "omnictl changerequest approve 5f3c4a1e-8d3b-4b0e-9a4c-7a2d1c9e6b10",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return reviewChangeRequest(ctx, client.Omni().State(), args[0], specs.ChangeRequestSpec_APPROVED)
+			})
+		},
+	}
+
+	changeRequestRejectCmd = &cobra.Command{
+		Use:     "reject <id>",
+		Short:   "Reject the pending change request",
+		Example: "omnictl changerequest reject 5f3c4a1e-8d3b-4b0e-9a4c-7a2d1c9e6b10",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return reviewChangeRequest(ctx, client.Omni().State(), args[0], specs.ChangeRequestSpec_REJECTED)
+			})
+		},
+	}
+)
+
+func showChangeRequest(ctx context.Context, st state.State, id string) error {
+	request, err := safe.StateGetByID[*omni.ChangeRequest](ctx, st, id)
+	if err != nil {
+		return err
+	}
+
+	spec := request.TypedSpec().Value
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	for _, line := range [][2]string{
+		{"ID", request.Metadata().ID()},
+		{"Phase", strings.ToLower(spec.Phase.String())},
+		{"Operation", spec.Operation},
+		{"Resource", fmt.Sprintf("%s(%s/%s)", spec.ResourceType, spec.ResourceNamespace, spec.ResourceId)},
+		{"Requested By", spec.RequestedBy},
+		{"Requested At", formatOptionalTime(spec.RequestedAt)},
+		{"Reasons", strings.Join(spec.Reasons, "; ")},
+		{"Approver Role", spec.ApproverRole},
+		{"Reviewed By", spec.ReviewedBy},
+		{"Reviewed At", formatOptionalTime(spec.ReviewedAt)},
+		{"Error", spec.Error},
+	} {
+		fmt.Fprintf(writer, "%s:\t%s\n", line[0], line[1]) //nolint:errcheck
+	}
+
+	if err = writer.Flush(); err != nil {
+		return err
+	}
+
+	// the requested resource is stripped if the current user can't read it
+	if len(spec.Resource) == 0 {
+		return nil
+	}
+
+	res, err := omni.DecodeChangeRequestResource(spec.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to decode the requested resource: %w", err)
+	}
+
+	fmt.Println("---")
+
+	return output.NewYAML().WriteResource(res, state.Created)
+}
+
+func reviewChangeRequest(ctx context.Context, st state.State, id string, phase specs.ChangeRequestSpec_Phase) error {
+	currentUser, err := safe.StateGetByID[*virtual.CurrentUser](ctx, st, virtual.CurrentUserID)
+	if err != nil {
+		return err
+	}
+
+	if _, err = safe.StateUpdateWithConflicts(ctx, st, omni.NewChangeRequest(resources.DefaultNamespace, id).Metadata(),
+		func(res *omni.ChangeRequest) error {
+			if res.TypedSpec().Value.Phase != specs.ChangeRequestSpec_PENDING {
+				return fmt.Errorf("change request is already %s", strings.ToLower(res.TypedSpec().Value.Phase.String()))
+			}
+
+			res.TypedSpec().Value.Phase = phase
+			res.TypedSpec().Value.ReviewedBy = currentUser.TypedSpec().Value.Identity
+			res.TypedSpec().Value.ReviewedAt = timestamppb.Now()
+
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
+	fmt.Printf("change request %q is %s\n", id, strings.ToLower(phase.String()))
+
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(changeRequestCmd)
+
+	changeRequestCmd.AddCommand(changeRequestShowCmd)
+	changeRequestCmd.AddCommand(changeRequestApproveCmd)
+	changeRequestCmd.AddCommand(changeRequestRejectCmd)
+}
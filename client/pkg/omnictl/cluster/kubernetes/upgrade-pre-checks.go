@@ -11,11 +11,13 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/client/management"
 	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
 )
 
 var upgradePreChecksCmdFlags struct {
-	toVersion string
+	toVersion            string
+	ignoreDeprecatedAPIs bool
 }
 
 // upgradePreChecksCmd represents the cluster kubernetes upgrade-pre-checks command.
@@ -32,12 +34,16 @@ var upgradePreChecksCmd = &cobra.Command{
 
 func upgradePreChecks(clusterName string) func(ctx context.Context, client *client.Client) error {
 	return func(ctx context.Context, client *client.Client) error {
-		return client.Management().WithCluster(clusterName).KubernetesUpgradePreChecks(ctx, upgradePreChecksCmdFlags.toVersion)
+		return client.Management().WithCluster(clusterName).KubernetesUpgradePreChecks(ctx, upgradePreChecksCmdFlags.toVersion,
+			management.WithIgnoreDeprecatedAPIs(upgradePreChecksCmdFlags.ignoreDeprecatedAPIs),
+		)
 	}
 }
 
 func init() {
 	upgradePreChecksCmd.Flags().StringVar(&upgradePreChecksCmdFlags.toVersion, "to", "", "target Kubernetes version for the planned upgrade")
+	upgradePreChecksCmd.Flags().BoolVar(&upgradePreChecksCmdFlags.ignoreDeprecatedAPIs, "ignore-deprecated-apis", false,
+		"do not fail the pre-checks if the cluster uses the API versions removed in the target Kubernetes version")
 	ensure.NoError(upgradePreChecksCmd.MarkFlagRequired("to"))
 	kubernetesCmd.AddCommand(upgradePreChecksCmd)
 }
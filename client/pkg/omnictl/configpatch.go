@@ -0,0 +1,365 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	configPatchFlags struct {
+		machine bool
+	}
+
+	// configPatchCmd represents the configpatch command.
+	configPatchCmd = &cobra.Command{
+		Use:     "configpatch",
+		Aliases: []string{"cp"},
+		Short:   "Config patch revision history related subcommands.",
+		Long: "Omni keeps a bounded revision history of each config patch and each generated machine config.\n\n" +
+			"The machine config revisions are redacted and selected with the --machine flag, the ID is the ID of the cluster machine.",
+	}
+
+	configPatchHistoryCmd = &cobra.Command{
+		Use:   "history <id>",
+		Short: "List the revisions of the config patch or the machine config",
+		Long: "List the revisions of the config patch or the machine config.\n\n" +
+			"For the machine configs, the revision currently applied to the machine is marked in the APPLIED column.",
+		Example: "omnictl configpatch history 400-cluster-patch\nomnictl configpatch history --machine 4c4c4544-0039-3010-8048-b7c04f384432",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return writeConfigRevisionHistory(ctx, client.Omni().State(), args[0])
+			})
+		},
+	}
+
+	configPatchDiffCmd = &cobra.Command{
+		Use:     "diff <id> <revision> [<revision>]",
+		Short:   "Show the difference between two revisions of the config patch or the machine config",
+		Long:    "Show the difference between two revisions of the config patch or the machine config, the second revision defaults to the latest one.",
+		Example: "omnictl configpatch diff 400-cluster-patch 3\nomnictl configpatch diff --machine 4c4c4544-0039-3010-8048-b7c04f384432 1 2",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return writeConfigRevisionDiff(ctx, client.Omni().State(), args[0], args[1:])
+			})
+		},
+	}
+
+	configPatchRollbackCmd = &cobra.Command{
+		Use:   "rollback <id> <revision>",
+		Short: "Restore the content of the config patch from the revision",
+		Long: "Restore the content of the config patch from the revision.\n\n" +
+			"The rollback is an update of the config patch, so it is recorded as a new revision.\n" +
+			"The removed config patch is re-created while its revisions are retained.",
+		Example: "omnictl configpatch rollback 400-cluster-patch 3",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return rollbackConfigPatch(ctx, client.Omni().State(), args[0], args[1])
+			})
+		},
+	}
+)
+
+// configRevision is the common view of the config patch and the machine config revisions.
+type configRevision struct {
+	created  *timestamppb.Timestamp
+	deleted  *timestamppb.Timestamp
+	version  string
+	data     []byte
+	revision uint64
+}
+
+func listConfigRevisions(ctx context.Context, st state.State, id string) ([]configRevision, error) {
+	var revisions []configRevision
+
+	if configPatchFlags.machine {
+		list, err := safe.StateListAll[*omni.ClusterMachineConfigRevision](ctx, st, state.WithLabelQuery(resource.LabelEqual(omni.LabelClusterMachine, id)))
+		if err != nil {
+			return nil, err
+		}
+
+		for revision := range list.All() {
+			data, err := uncompressedData(revision.TypedSpec().Value)
+			if err != nil {
+				return nil, err
+			}
+
+			revisions = append(revisions, configRevision{
+				revision: revision.TypedSpec().Value.Revision,
+				version:  revision.TypedSpec().Value.ClusterMachineConfigVersion,
+				created:  revision.TypedSpec().Value.Created,
+				deleted:  revision.TypedSpec().Value.Deleted,
+				data:     data,
+			})
+		}
+	} else {
+		list, err := safe.StateListAll[*omni.ConfigPatchRevision](ctx, st, state.WithLabelQuery(resource.LabelEqual(omni.LabelConfigPatch, id)))
+		if err != nil {
+			return nil, err
+		}
+
+		for revision := range list.All() {
+			data, err := uncompressedData(revision.TypedSpec().Value)
+			if err != nil {
+				return nil, err
+			}
+
+			revisions = append(revisions, configRevision{
+				revision: revision.TypedSpec().Value.Revision,
+				version:  revision.TypedSpec().Value.ConfigPatchVersion,
+				created:  revision.TypedSpec().Value.Created,
+				deleted:  revision.TypedSpec().Value.Deleted,
+				data:     data,
+			})
+		}
+	}
+
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("no revisions found for %q", id)
+	}
+
+	slices.SortFunc(revisions, func(a, b configRevision) int {
+		return cmp.Compare(a.revision, b.revision)
+	})
+
+	return revisions, nil
+}
+
+func uncompressedData(spec interface {
+	GetUncompressedData(opts ...specs.CompressionOption) (specs.Buffer, error)
+},
+) ([]byte, error) {
+	buffer, err := spec.GetUncompressedData()
+	if err != nil {
+		return nil, err
+	}
+
+	defer buffer.Free()
+
+	return bytes.Clone(buffer.Data()), nil
+}
+
+// appliedRevision returns the revision of the machine config currently applied to the machine.
+//
+// It is the latest revision introduced by the machine config version not greater than the applied one.
+func appliedRevision(ctx context.Context, st state.State, id string, revisions []configRevision) (uint64, error) {
+	status, err := safe.StateGetByID[*omni.ClusterMachineConfigStatus](ctx, st, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	appliedVersion, err := strconv.ParseUint(status.TypedSpec().Value.ClusterMachineConfigVersion, 10, 64)
+	if err != nil {
+		return 0, nil //nolint:nilerr // the config is not applied yet
+	}
+
+	var applied uint64
+
+	for _, revision := range revisions {
+		version, err := strconv.ParseUint(revision.version, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if version <= appliedVersion {
+			applied = revision.revision
+		}
+	}
+
+	return applied, nil
+}
+
+func writeConfigRevisionHistory(ctx context.Context, st state.State, id string) error {
+	revisions, err := listConfigRevisions(ctx, st, id)
+	if err != nil {
+		return err
+	}
+
+	var applied uint64
+
+	if configPatchFlags.machine {
+		if applied, err = appliedRevision(ctx, st, id, revisions); err != nil {
+			return err
+		}
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	header := "REVISION\tCREATED\tDELETED\tVERSION\tLINES"
+	if configPatchFlags.machine {
+		header += "\tAPPLIED"
+	}
+
+	fmt.Fprintln(writer, header) //nolint:errcheck
+
+	for _, revision := range revisions {
+		line := fmt.Sprintf("%d\t%s\t%s\t%s\t%d", revision.revision, formatOptionalTime(revision.created), formatOptionalTime(revision.deleted),
+			revision.version, bytes.Count(revision.data, []byte("\n")))
+
+		if configPatchFlags.machine {
+			marker := ""
+
+			if revision.revision == applied {
+				marker = "*"
+			}
+
+			line += "\t" + marker
+		}
+
+		fmt.Fprintln(writer, line) //nolint:errcheck
+	}
+
+	return writer.Flush()
+}
+
+func findConfigRevision(revisions []configRevision, arg string) (configRevision, error) {
+	number, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return configRevision{}, fmt.Errorf("invalid revision %q: %w", arg, err)
+	}
+
+	for _, revision := range revisions {
+		if revision.revision == number {
+			return revision, nil
+		}
+	}
+
+	return configRevision{}, fmt.Errorf("revision %d not found, the oldest revisions might be already removed", number)
+}
+
+func writeConfigRevisionDiff(ctx context.Context, st state.State, id string, args []string) error {
+	revisions, err := listConfigRevisions(ctx, st, id)
+	if err != nil {
+		return err
+	}
+
+	from, err := findConfigRevision(revisions, args[0])
+	if err != nil {
+		return err
+	}
+
+	to := revisions[len(revisions)-1]
+
+	if len(args) > 1 {
+		if to, err = findConfigRevision(revisions, args[1]); err != nil {
+			return err
+		}
+	}
+
+	fromPath := fmt.Sprintf("%s@%d", id, from.revision)
+	toPath := fmt.Sprintf("%s@%d", id, to.revision)
+
+	edits := myers.ComputeEdits(span.URIFromPath(fromPath), string(from.data), string(to.data))
+
+	fmt.Print(gotextdiff.ToUnified(fromPath, toPath, string(from.data), edits))
+
+	return nil
+}
+
+func rollbackConfigPatch(ctx context.Context, st state.State, id, arg string) error {
+	if configPatchFlags.machine {
+		return fmt.Errorf("the machine configs are generated, roll back the config patches instead")
+	}
+
+	revisions, err := listConfigRevisions(ctx, st, id)
+	if err != nil {
+		return err
+	}
+
+	revision, err := findConfigRevision(revisions, arg)
+	if err != nil {
+		return err
+	}
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st, omni.NewConfigPatch(resources.DefaultNamespace, id).Metadata(),
+		func(res *omni.ConfigPatch) error {
+			return res.TypedSpec().Value.SetUncompressedData(revision.data)
+		},
+	)
+	if state.IsNotFoundError(err) {
+		return restoreConfigPatch(ctx, st, id, revision)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("config patch %q is rolled back to the revision %d\n", id, revision.revision)
+
+	return nil
+}
+
+// restoreConfigPatch re-creates the removed config patch from the revision, the revision keeps the labels and the annotations of the config patch.
+func restoreConfigPatch(ctx context.Context, st state.State, id string, revision configRevision) error {
+	source, err := safe.StateGetByID[*omni.ConfigPatchRevision](ctx, st, omni.ConfigPatchRevisionID(id, revision.revision))
+	if err != nil {
+		return err
+	}
+
+	patch := omni.NewConfigPatch(resources.DefaultNamespace, id)
+
+	for _, label := range []string{omni.LabelCluster, omni.LabelMachineSet, omni.LabelClusterMachine, omni.LabelMachine} {
+		if value, ok := source.Metadata().Labels().Get(label); ok {
+			patch.Metadata().Labels().Set(label, value)
+		}
+	}
+
+	for _, annotation := range []string{omni.ConfigPatchName, omni.ConfigPatchDescription} {
+		if value, ok := source.Metadata().Annotations().Get(annotation); ok {
+			patch.Metadata().Annotations().Set(annotation, value)
+		}
+	}
+
+	if err = patch.TypedSpec().Value.SetUncompressedData(revision.data); err != nil {
+		return err
+	}
+
+	if err = st.Create(ctx, patch); err != nil {
+		return err
+	}
+
+	fmt.Printf("config patch %q is re-created from the revision %d\n", id, revision.revision)
+
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(configPatchCmd)
+
+	configPatchCmd.AddCommand(configPatchHistoryCmd)
+	configPatchCmd.AddCommand(configPatchDiffCmd)
+	configPatchCmd.AddCommand(configPatchRollbackCmd)
+
+	configPatchCmd.PersistentFlags().BoolVar(&configPatchFlags.machine, "machine", false, "use the revisions of the generated machine config of the cluster machine instead of the config patch")
+}
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	debugControllersFlags struct {
+		failing bool
+	}
+
+	// debugCmd represents the debug command.
+	debugCmd = &cobra.Command{
+		Use:   "debug",
+		Short: "Inspect the internal state of Omni",
+	}
+
+	debugControllersCmd = &cobra.Command{
+		Use:     "controllers",
+		Aliases: []string{"c"},
+		Short:   "List the Omni controllers with their reconcile status",
+		Long: "List the Omni controllers with the number of reconciles and errors, the queue depth, the number of reconciles in progress, " +
+			"the time of the last successful reconcile and the last error.",
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				controllers, err := client.Management().ListControllerStatuses(ctx)
+				if err != nil {
+					return err
+				}
+
+				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+				fmt.Fprintf(writer, "NAME\tKIND\tHEALTH\tRECONCILES\tERRORS\tQUEUE\tBUSY\tLAST SUCCESS\tLAST ERROR\n") //nolint:errcheck
+
+				for _, controller := range controllers {
+					if debugControllersFlags.failing && controller.Health != "failing" {
+						continue
+					}
+
+					busy := fmt.Sprint(controller.Busy)
+					if controller.BusySince != nil {
+						busy += fmt.Sprintf(" (%s)", time.Since(controller.BusySince.AsTime()).Truncate(time.Second))
+					}
+
+					fmt.Fprintf(writer, "%s\t%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\n", //nolint:errcheck
+						controller.Name,
+						controller.Kind,
+						controller.Health,
+						controller.Reconciles,
+						controller.Errors,
+						controller.QueueDepth,
+						busy,
+						formatOptionalTime(controller.LastSuccess),
+						controller.LastError,
+					)
+				}
+
+				return writer.Flush()
+			})
+		},
+	}
+)
+
+func formatOptionalTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+
+	return ts.AsTime().Format(time.RFC3339)
+}
+
+func init() {
+	RootCmd.AddCommand(debugCmd)
+
+	debugCmd.AddCommand(debugControllersCmd)
+
+	debugControllersCmd.Flags().BoolVar(&debugControllersFlags.failing, "failing", false, "list only the controllers which last reconcile failed")
+}
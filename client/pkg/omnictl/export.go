@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/archive"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var exportCmdFlags struct {
+	output string
+}
+
+// exportCmd represents the export command.
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export all user-managed resources to the archive",
+	Long: `Export the clusters, machine sets, config patches, machine classes, users, access policies, SAML label rules
+and the rest of the user-managed resources to the archive, which can be imported to another Omni instance with 'omnictl import'.
+
+The resources managed by Omni controllers and the service accounts are not exported.`,
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return access.WithClient(exportResources)
+	},
+}
+
+func exportResources(ctx context.Context, client *client.Client) (err error) {
+	f, err := os.Create(exportCmdFlags.output)
+	if err != nil {
+		return fmt.Errorf("failed to create the archive %q: %w", exportCmdFlags.output, err)
+	}
+
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+
+	manifest, err := archive.Export(ctx, client.Omni().State(), f, archive.ExportOptions{Source: client.Endpoint()})
+	if err != nil {
+		return err
+	}
+
+	total := 0
+
+	for _, count := range manifest.Resources {
+		total += count
+	}
+
+	fmt.Printf("exported %d resources of %d types to %q\n", total, len(manifest.Resources), exportCmdFlags.output)
+
+	return nil
+}
+
+func init() {
+	exportCmd.Flags().StringVarP(&exportCmdFlags.output, "output", "o", "omni-export.tar.gz", "Path to the archive")
+
+	RootCmd.AddCommand(exportCmd)
+}
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/archive"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var importCmdFlags struct {
+	idMap  map[string]string
+	file   string
+	dryRun bool
+}
+
+// importCmd represents the import command.
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import the resources from the archive created by 'omnictl export'",
+	Long: `Import the resources from the archive created by 'omnictl export'.
+
+The import is idempotent: the missing resources are created, the changed resources are updated, and the resources
+managed by Omni controllers are skipped. Use --dry-run to report the changes without applying them.
+
+The resource IDs can be changed with --id-map, e.g. '--id-map prod=staging' renames the cluster together with its machine sets,
+and '--id-map <old machine ID>=<new machine ID>' moves the machine allocation and the machine labels to another machine.`,
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return access.WithClient(importResources)
+	},
+}
+
+func importResources(ctx context.Context, client *client.Client) error {
+	f, err := os.Open(importCmdFlags.file)
+	if err != nil {
+		return fmt.Errorf("failed to read the archive %q: %w", importCmdFlags.file, err)
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	report, err := archive.Import(ctx, client.Omni().State(), f, archive.ImportOptions{
+		IDMap:  importCmdFlags.idMap,
+		DryRun: importCmdFlags.dryRun,
+	})
+	// the report of the resources imported before the failure is printed as well
+	if report != nil {
+		if writeErr := report.Write(os.Stdout); writeErr != nil && err == nil {
+			return writeErr
+		}
+	}
+
+	return err
+}
+
+func init() {
+	importCmd.Flags().StringVarP(&importCmdFlags.file, "file", "f", "", "Path to the archive")
+	importCmd.Flags().BoolVarP(&importCmdFlags.dryRun, "dry-run", "d", false, "Report the changes without applying them")
+	importCmd.Flags().StringToStringVar(&importCmdFlags.idMap, "id-map", nil, "Map the resource ID in the archive to another ID (e.g. --id-map old=new)")
+	ensure.NoError(importCmd.MarkFlagRequired("file"))
+
+	RootCmd.AddCommand(importCmd)
+}
@@ -29,6 +29,7 @@ import (
 const (
 	serviceAccountFlag     = "service-account"
 	serviceAccountUserFlag = "user"
+	namespacesFlag         = "namespaces"
 )
 
 var kubeconfigCmdFlags struct {
@@ -37,6 +38,7 @@ var kubeconfigCmdFlags struct {
 	serviceAccountUser   string
 	grantType            string
 	serviceAccountGroups []string
+	namespaces           []string
 	serviceAccountTTL    time.Duration
 	force                bool
 	merge                bool
@@ -63,6 +65,30 @@ Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
 	},
 }
 
+// kubeconfigRevokeCmd represents the kubeconfig revoke command.
+var kubeconfigRevokeCmd = &cobra.Command{
+	Use:   "revoke <id>",
+	Short: "Revoke the service account kubeconfig",
+	Long: `Revoke the service account kubeconfig, Omni rejects its token from then on.
+Use 'omnictl get issuedkubeconfigs' to list the issued service account kubeconfigs.
+
+The service account kubeconfigs issued by the Omni versions which didn't track them are not listed and can't be revoked,
+they are accepted until they expire unless Omni is started with --auth-untracked-service-account-tokens-cutoff.`,
+	Example: "omnictl kubeconfig revoke 5f3c4a1e-8d3b-4b0e-9a4c-7a2d1c9e6b10",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(func(ctx context.Context, client *client.Client) error {
+			if err := client.Management().RevokeKubeconfig(ctx, args[0]); err != nil {
+				return err
+			}
+
+			fmt.Printf("revoked kubeconfig %s\n", args[0])
+
+			return nil
+		})
+	},
+}
+
 //nolint:gocognit
 func getKubeconfig(args []string) func(ctx context.Context, client *client.Client) error {
 	return func(ctx context.Context, client *client.Client) error {
@@ -128,6 +154,12 @@ func getKubeconfig(args []string) func(ctx context.Context, client *client.Clien
 				kubeconfigCmdFlags.serviceAccountUser,
 				kubeconfigCmdFlags.serviceAccountGroups...,
 			))
+
+			if len(kubeconfigCmdFlags.namespaces) > 0 {
+				opts = append(opts, management.WithServiceAccountNamespaces(kubeconfigCmdFlags.namespaces...))
+			}
+		} else if len(kubeconfigCmdFlags.namespaces) > 0 {
+			return fmt.Errorf("--%s flag can be used only when --%s is set to true", namespacesFlag, serviceAccountFlag)
 		}
 
 		opts = append(opts,
@@ -222,10 +254,13 @@ func init() {
 		fmt.Sprintf("user to be used in the service account token (sub). required when --%s is set to true", serviceAccountFlag))
 	kubeconfigCmd.Flags().StringSliceVar(&kubeconfigCmdFlags.serviceAccountGroups, "groups", []string{constants.DefaultAccessGroup},
 		fmt.Sprintf("group to be used in the service account token (groups). only used when --%s is set to true", serviceAccountFlag))
+	kubeconfigCmd.Flags().StringSliceVar(&kubeconfigCmdFlags.namespaces, namespacesFlag, nil,
+		fmt.Sprintf("limit the service account token to the namespaces, all namespaces are allowed if not set. only used when --%s is set to true", serviceAccountFlag))
 	kubeconfigCmd.Flags().StringVar(&kubeconfigCmdFlags.grantType, "grant-type", "", fmt.Sprintf("Authorization grant type to use. One of (%s)", allGrantTypes))
 	kubeconfigCmd.Flags().BoolVar(&kubeconfigCmdFlags.breakGlass, "break-glass", false, "get kubeconfig that allows accessing nodes bypasing Omni (if enabled for the account)")
 
 	kubeconfigCmd.MarkFlagRequired("cluster") //nolint:errcheck
 
+	kubeconfigCmd.AddCommand(kubeconfigRevokeCmd)
 	RootCmd.AddCommand(kubeconfigCmd)
 }
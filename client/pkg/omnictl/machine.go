@@ -0,0 +1,214 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/inventory"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	machineInventoryFlags struct {
+		output  string
+		cluster string
+	}
+
+	machineDiagnosticsFlags struct {
+		cluster  string
+		severity string
+		history  bool
+	}
+
+	// machineCmd represents the machine command.
+	machineCmd = &cobra.Command{
+		Use:   "machine",
+		Short: "Machine related subcommands.",
+	}
+
+	machineInventoryCmd = &cobra.Command{
+		Use:     "inventory",
+		Aliases: []string{"inv"},
+		Short:   "Export the hardware inventory of the machines",
+		Long: "Export the CPUs, memory, block devices, network interfaces and platform metadata of all machines as CSV or JSON.\n\n" +
+			"The CSV output has a single row per machine, the multi-value columns are separated by semicolons.",
+		Example: "omnictl machine inventory -o json > inventory.json",
+		Args:    cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(writeInventory)
+		},
+	}
+
+	machineDiagnosticsCmd = &cobra.Command{
+		Use:   "diagnostics",
+		Short: "List the hardware health diagnostics of the machines",
+		Long: "List the active hardware health diagnostics of all machines: missing and read-only disks, flapping network links and Talos diagnostics.\n\n" +
+			"The resolved diagnostics are listed with the --history flag.",
+		Example: "omnictl machine diagnostics --severity error",
+		Args:    cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			minSeverity, ok := specs.MachineHealthStatusSpec_Severity_value[strings.ToUpper(machineDiagnosticsFlags.severity)]
+			if !ok {
+				return fmt.Errorf("unsupported severity %q", machineDiagnosticsFlags.severity)
+			}
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return writeDiagnostics(ctx, client, specs.MachineHealthStatusSpec_Severity(minSeverity))
+			})
+		},
+	}
+)
+
+type machineDiagnostic struct {
+	*specs.MachineHealthStatusSpec_Diagnostic
+
+	machineID string
+	hostname  string
+	cluster   string
+}
+
+func writeDiagnostics(ctx context.Context, client *client.Client, minSeverity specs.MachineHealthStatusSpec_Severity) error {
+	healthList, err := safe.StateListAll[*omni.MachineHealthStatus](ctx, client.Omni().State())
+	if err != nil {
+		return err
+	}
+
+	machineStatusList, err := safe.StateListAll[*omni.MachineStatus](ctx, client.Omni().State())
+	if err != nil {
+		return err
+	}
+
+	hostnames := make(map[string]string, machineStatusList.Len())
+
+	for machineStatus := range machineStatusList.All() {
+		hostnames[machineStatus.Metadata().ID()] = machineStatus.TypedSpec().Value.GetNetwork().GetHostname()
+	}
+
+	var diagnostics []machineDiagnostic
+
+	for health := range healthList.All() {
+		cluster, _ := health.Metadata().Labels().Get(omni.LabelCluster)
+
+		if machineDiagnosticsFlags.cluster != "" && cluster != machineDiagnosticsFlags.cluster {
+			continue
+		}
+
+		items := health.TypedSpec().Value.Diagnostics
+
+		if machineDiagnosticsFlags.history {
+			items = append(slices.Clone(items), health.TypedSpec().Value.History...)
+		}
+
+		for _, diagnostic := range items {
+			if diagnostic.Severity < minSeverity {
+				continue
+			}
+
+			diagnostics = append(diagnostics, machineDiagnostic{
+				MachineHealthStatusSpec_Diagnostic: diagnostic,
+				machineID:                          health.Metadata().ID(),
+				hostname:                           hostnames[health.Metadata().ID()],
+				cluster:                            cluster,
+			})
+		}
+	}
+
+	slices.SortStableFunc(diagnostics, func(a, b machineDiagnostic) int {
+		if c := cmp.Compare(b.Severity, a.Severity); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.machineID, b.machineID)
+	})
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	header := "MACHINE\tHOSTNAME\tCLUSTER\tSEVERITY\tTYPE\tSUBJECT\tSINCE\tMESSAGE"
+	if machineDiagnosticsFlags.history {
+		header += "\tRESOLVED"
+	}
+
+	fmt.Fprintln(writer, header) //nolint:errcheck
+
+	for _, diagnostic := range diagnostics {
+		line := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s",
+			diagnostic.machineID,
+			diagnostic.hostname,
+			diagnostic.cluster,
+			diagnostic.Severity,
+			diagnostic.Type,
+			diagnostic.Subject,
+			formatOptionalTime(diagnostic.FirstSeen),
+			diagnostic.Message,
+		)
+
+		if machineDiagnosticsFlags.history {
+			line += "\t" + formatOptionalTime(diagnostic.Resolved)
+		}
+
+		fmt.Fprintln(writer, line) //nolint:errcheck
+	}
+
+	return writer.Flush()
+}
+
+func writeInventory(ctx context.Context, client *client.Client) error {
+	list, err := safe.StateListAll[*omni.MachineStatus](ctx, client.Omni().State())
+	if err != nil {
+		return err
+	}
+
+	machines := make([]inventory.Machine, 0, list.Len())
+
+	for machineStatus := range list.All() {
+		if machineInventoryFlags.cluster != "" && machineStatus.TypedSpec().Value.Cluster != machineInventoryFlags.cluster {
+			continue
+		}
+
+		machines = append(machines, inventory.FromMachineStatus(machineStatus))
+	}
+
+	inventory.Sort(machines)
+
+	switch machineInventoryFlags.output {
+	case "csv":
+		return inventory.WriteCSV(os.Stdout, machines)
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(machines)
+	default:
+		return fmt.Errorf("unsupported output format %q", machineInventoryFlags.output)
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(machineCmd)
+
+	machineCmd.AddCommand(machineInventoryCmd)
+	machineCmd.AddCommand(machineDiagnosticsCmd)
+
+	machineInventoryCmd.Flags().StringVarP(&machineInventoryFlags.output, "output", "o", "csv", "output format (csv, json)")
+	machineInventoryCmd.Flags().StringVarP(&machineInventoryFlags.cluster, "cluster", "c", "", "export only the machines of the cluster")
+
+	machineDiagnosticsCmd.Flags().StringVarP(&machineDiagnosticsFlags.cluster, "cluster", "c", "", "list only the diagnostics of the machines of the cluster")
+	machineDiagnosticsCmd.Flags().StringVar(&machineDiagnosticsFlags.severity, "severity", "info", "minimum severity of the listed diagnostics (info, warning, error)")
+	machineDiagnosticsCmd.Flags().BoolVar(&machineDiagnosticsFlags.history, "history", false, "list the resolved diagnostics as well")
+}
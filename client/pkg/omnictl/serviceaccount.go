@@ -175,6 +175,35 @@ var (
 			})
 		},
 	}
+
+	serviceAccountWorkloadProxyTokenCmd = &cobra.Command{
+		Use:   "workload-proxy-token",
+		Short: "Print a bearer token to access the exposed services using the service account key from the environment",
+		Long: "Print a bearer token to access the exposed services of the workload clusters using the service account key set in the " +
+			serviceaccount.OmniServiceAccountKeyEnvVar + " environment variable.\n\n" +
+			"The token should be passed in the \"Authorization: Bearer <token>\" header of the requests to the exposed services.",
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			envKey, valueBase64 := serviceaccount.GetFromEnv()
+			if envKey == "" {
+				return fmt.Errorf("service account key is not set in the %s environment variable", serviceaccount.OmniServiceAccountKeyEnvVar)
+			}
+
+			sa, err := serviceaccount.Decode(valueBase64)
+			if err != nil {
+				return err
+			}
+
+			token, err := pkgaccess.NewWorkloadProxyBearerToken(sa.Key)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(token)
+
+			return nil
+		},
+	}
 )
 
 func generateServiceAccountPGPKey(name string) (*pgp.Key, error) {
@@ -192,6 +221,7 @@ func init() {
 	serviceAccountCmd.AddCommand(serviceAccountListCmd)
 	serviceAccountCmd.AddCommand(serviceAccountDestroyCmd)
 	serviceAccountCmd.AddCommand(serviceAccountRenewCmd)
+	serviceAccountCmd.AddCommand(serviceAccountWorkloadProxyTokenCmd)
 
 	roleFlag := "role"
 	useUserRoleFlag := "use-user-role"
@@ -0,0 +1,207 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/machine" // register the Talos API descriptors to decode the recorded payloads
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+	"github.com/siderolabs/omni/client/pkg/sessionrecording"
+)
+
+var (
+	sessionReplayFlags struct {
+		identityFile string
+		speed        float64
+	}
+
+	// sessionCmd represents the session command.
+	sessionCmd = &cobra.Command{
+		Use:   "session",
+		Short: "Inspect the recorded Talos and Kubernetes sessions",
+	}
+
+	sessionListCmd = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l"},
+		Short:   "List the recorded sessions",
+		Args:    cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				recordings, err := client.Management().ListSessionRecordings(ctx)
+				if err != nil {
+					return err
+				}
+
+				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+				fmt.Fprintf(writer, "ID\tTYPE\tCLUSTER\tIDENTITY\tMETHOD\tSTART TIME\tSIZE\n") //nolint:errcheck
+
+				for _, recording := range recordings {
+					fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%d\n", //nolint:errcheck
+						recording.Id,
+						recording.Type,
+						recording.Cluster,
+						recording.Identity,
+						recording.Method,
+						recording.StartTime.AsTime().Format(time.RFC3339),
+						recording.Size,
+					)
+				}
+
+				return writer.Flush()
+			})
+		},
+	}
+
+	sessionReplayCmd = &cobra.Command{
+		Use:     "replay <id>",
+		Aliases: []string{"r"},
+		Short:   "Replay the recorded session",
+		Long: "Print the events of the recorded session with their offsets from the start of the session.\n\n" +
+			"The recordings are encrypted for the age recipient configured in Omni, they are decrypted locally with the matching identity.\n" +
+			"The recorded Talos API payloads are decoded to JSON, the Kubernetes streams are printed as is.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return replaySession(ctx, client, args[0])
+			})
+		},
+	}
+)
+
+func replaySession(ctx context.Context, client *client.Client, id string) error {
+	identities, err := sessionrecording.ReadIdentities(sessionReplayFlags.identityFile)
+	if err != nil {
+		return err
+	}
+
+	recording, recordingReader, err := client.Management().ReadSessionRecording(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	reader, err := sessionrecording.NewReader(recordingReader, identities...)
+	if err != nil {
+		return err
+	}
+
+	previous := recording.StartTime.AsTime()
+	method := lookupMethod(recording.Method)
+
+	fmt.Printf("Session:   %s\n", recording.Id)
+	fmt.Printf("Type:      %s\n", recording.Type)
+	fmt.Printf("Cluster:   %s\n", recording.Cluster)
+	fmt.Printf("Identity:  %s\n", recording.Identity)
+	fmt.Printf("Method:    %s\n", recording.Method)
+
+	if recording.Nodes != "" {
+		fmt.Printf("Nodes:     %s\n", recording.Nodes)
+	}
+
+	fmt.Printf("Started:   %s\n\n", previous.Format(time.RFC3339))
+
+	for {
+		event, err := reader.Next()
+		if err != nil {
+			// the recording of the session in progress ends abruptly
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if sessionReplayFlags.speed > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(float64(event.Time.Sub(previous)) / sessionReplayFlags.speed)):
+			}
+		}
+
+		previous = event.Time
+
+		fmt.Printf("[%s] %s: %s\n", event.Time.Sub(recording.StartTime.AsTime()).Round(time.Millisecond), event.Direction, formatSessionData(method, event.Direction, event.Data))
+	}
+}
+
+// lookupMethod finds the descriptor of the recorded Talos API method, it returns nil for the Kubernetes sessions.
+func lookupMethod(fullMethodName string) protoreflect.MethodDescriptor {
+	service, methodName, ok := strings.Cut(strings.TrimLeft(fullMethodName, "/"), "/")
+	if !ok {
+		return nil
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil
+	}
+
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil
+	}
+
+	return serviceDesc.Methods().ByName(protoreflect.Name(methodName))
+}
+
+func formatSessionData(method protoreflect.MethodDescriptor, direction string, data []byte) string {
+	if method == nil {
+		return fmt.Sprintf("%q", data)
+	}
+
+	var messageDesc protoreflect.MessageDescriptor
+
+	switch direction {
+	case sessionrecording.DirectionRequest:
+		messageDesc = method.Input()
+	case sessionrecording.DirectionResponse:
+		messageDesc = method.Output()
+	default:
+		return string(data)
+	}
+
+	msg := dynamicpb.NewMessage(messageDesc)
+
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return fmt.Sprintf("%q", data)
+	}
+
+	out, err := protojson.Marshal(msg)
+	if err != nil {
+		return fmt.Sprintf("%q", data)
+	}
+
+	return string(out)
+}
+
+func init() {
+	RootCmd.AddCommand(sessionCmd)
+
+	sessionCmd.AddCommand(sessionListCmd)
+	sessionCmd.AddCommand(sessionReplayCmd)
+
+	sessionReplayCmd.Flags().StringVar(&sessionReplayFlags.identityFile, "identity-file", "", "path to the age identity matching the session recording recipient")
+	sessionReplayCmd.Flags().Float64Var(&sessionReplayFlags.speed, "speed", 0, "replay the events with the recorded delays divided by the speed factor, print them at once if 0")
+	ensure.NoError(sessionReplayCmd.MarkFlagRequired("identity-file"))
+}
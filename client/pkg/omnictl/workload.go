@@ -0,0 +1,128 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+)
+
+var portForwardCmdFlags struct {
+	address   string
+	localPort int
+}
+
+// workloadCmd represents the workload command.
+var workloadCmd = &cobra.Command{
+	Use:   "workload",
+	Short: "Access the services exposed from the workload clusters",
+}
+
+// portForwardCmd represents the workload port-forward command.
+var portForwardCmd = &cobra.Command{
+	Use:   "port-forward cluster service",
+	Short: "Forward a local port to a service exposed from the workload cluster",
+	Long: `Forward a local port to a service exposed from the workload cluster through the Omni API.
+
+The service is either in the <name>.<namespace> format or the alias of the exposed service.
+Each accepted local connection opens a separate tunnel to the service.`,
+	Example: "omnictl workload port-forward my-cluster postgres.databases --local-port 5432",
+	Args:    cobra.ExactArgs(2),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(portForward(args[0], args[1]))
+	},
+	SilenceUsage: true,
+}
+
+func portForward(cluster, service string) func(ctx context.Context, client *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		var lc net.ListenConfig
+
+		listener, err := lc.Listen(ctx, "tcp", net.JoinHostPort(portForwardCmdFlags.address, strconv.Itoa(portForwardCmdFlags.localPort)))
+		if err != nil {
+			return fmt.Errorf("failed to listen: %w", err)
+		}
+
+		stop := context.AfterFunc(ctx, func() { listener.Close() }) //nolint:errcheck
+		defer stop()
+
+		fmt.Printf("Forwarding from %s to %s in the cluster %q\n", listener.Addr(), service, cluster)
+
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				return fmt.Errorf("failed to accept connection: %w", err)
+			}
+
+			panichandler.Go(func() {
+				if forwardErr := forwardConnection(ctx, client, cluster, service, conn); forwardErr != nil {
+					fmt.Printf("error forwarding connection from %s: %s\n", conn.RemoteAddr(), forwardErr)
+				}
+			}, nil)
+		}
+	}
+}
+
+func forwardConnection(ctx context.Context, client *client.Client, cluster, service string, conn net.Conn) error {
+	defer conn.Close() //nolint:errcheck
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tunnel, err := client.Management().WorkloadProxyTunnel(ctx, cluster, service)
+	if err != nil {
+		return err
+	}
+
+	eg := panichandler.NewErrGroup()
+
+	eg.Go(func() error {
+		defer tunnel.Close() //nolint:errcheck
+
+		_, copyErr := io.Copy(tunnel, conn)
+
+		return copyErr
+	})
+
+	eg.Go(func() error {
+		// the tunnel is closed by the server, close the local connection as well
+		defer conn.Close() //nolint:errcheck
+
+		_, copyErr := io.Copy(conn, tunnel)
+		if errors.Is(copyErr, io.EOF) {
+			return nil
+		}
+
+		return copyErr
+	})
+
+	if err = eg.Wait(); err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func init() {
+	portForwardCmd.Flags().StringVar(&portForwardCmdFlags.address, "address", "localhost", "local address to listen on")
+	portForwardCmd.Flags().IntVarP(&portForwardCmdFlags.localPort, "local-port", "p", 0, "local port to listen on, a random port is used if not set")
+
+	workloadCmd.AddCommand(portForwardCmd)
+	RootCmd.AddCommand(workloadCmd)
+}
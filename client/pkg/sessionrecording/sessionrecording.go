@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package sessionrecording implements the format of the Talos and Kubernetes session recordings made by Omni.
+//
+// The recorded events are encoded as JSON lines and encrypted with age for the recipient configured in Omni,
+// so that only the holder of the matching identity can read them, Omni itself can't.
+package sessionrecording
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"filippo.io/age"
+)
+
+// Event directions.
+const (
+	// DirectionRequest is the data sent by the client to the cluster.
+	DirectionRequest = "request"
+
+	// DirectionResponse is the data sent by the cluster to the client.
+	DirectionResponse = "response"
+
+	// DirectionStatus is the final status of the session.
+	DirectionStatus = "status"
+)
+
+// Event is a single chunk of data recorded in the session.
+type Event struct {
+	Time      time.Time `json:"time"`
+	Direction string    `json:"direction"`
+	Data      []byte    `json:"data,omitempty"`
+}
+
+// ReadIdentities reads the age identities from the identity file.
+func ReadIdentities(path string) ([]age.Identity, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the session recording identity: %w", err)
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	identities, err := age.ParseIdentities(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the session recording identity: %w", err)
+	}
+
+	return identities, nil
+}
+
+// Reader reads the events of the encrypted session recording.
+type Reader struct {
+	decoder *json.Decoder
+}
+
+// NewReader decrypts the session recording with the identities.
+func NewReader(r io.Reader, identities ...age.Identity) (*Reader, error) {
+	decrypter, err := age.Decrypt(bufio.NewReader(r), identities...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the session recording: %w", err)
+	}
+
+	return &Reader{
+		decoder: json.NewDecoder(decrypter),
+	}, nil
+}
+
+// Next returns the next event of the session, io.EOF is returned at the end of the recording.
+//
+// The recording of a session which is still in progress or was interrupted ends with io.ErrUnexpectedEOF.
+func (r *Reader) Next() (Event, error) {
+	var event Event
+
+	if err := r.decoder.Decode(&event); err != nil {
+		return Event{}, err
+	}
+
+	return event, nil
+}
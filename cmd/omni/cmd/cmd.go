@@ -15,6 +15,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/go-logr/zapr"
@@ -23,6 +24,7 @@ import (
 	"github.com/siderolabs/go-debug"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"k8s.io/klog/v2"
@@ -41,6 +43,7 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/virtual"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
 	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
@@ -49,6 +52,7 @@ import (
 	"github.com/siderolabs/omni/internal/pkg/ctxstore"
 	"github.com/siderolabs/omni/internal/pkg/features"
 	"github.com/siderolabs/omni/internal/pkg/siderolink"
+	"github.com/siderolabs/omni/internal/pkg/tracing"
 	"github.com/siderolabs/omni/internal/version"
 )
 
@@ -76,6 +80,10 @@ var rootCmd = &cobra.Command{
 			return errors.New("flags --auth-saml-url and --auth-saml-metadata are mutually exclusive")
 		}
 
+		if config.Config.InventorySync.Endpoint != "" && config.Config.InventorySync.Interval <= 0 {
+			return errors.New("flag --inventory-sync-interval should be positive")
+		}
+
 		var loggerConfig zap.Config
 
 		if constants.IsDebugBuild {
@@ -122,6 +130,20 @@ var rootCmd = &cobra.Command{
 
 		logger.Info("starting Omni", zap.String("version", version.Tag))
 
+		shutdownTracing, err := tracing.Setup(context.Background(), config.Config.Tracing, logger.With(logging.Component("tracing")))
+		if err != nil {
+			return fmt.Errorf("failed to set up tracing: %w", err)
+		}
+
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer shutdownCancel()
+
+			if shutdownErr := shutdownTracing(shutdownCtx); shutdownErr != nil {
+				logger.Error("failed to shut down tracing", zap.Error(shutdownErr))
+			}
+		}()
+
 		logger.Debug("using config", zap.Any("config", config.Config))
 
 		signals := make(chan os.Signal, 1)
@@ -166,6 +188,23 @@ func runWithState(logger *zap.Logger) func(context.Context, state.State, *virtua
 
 		resourceState = auditWrap.WrapState(resourceState)
 
+		var sessionRecorder *sessionrecording.Recorder
+
+		if config.Config.SessionRecording.Dir != "" {
+			var recorderErr error
+
+			sessionRecorder, recorderErr = sessionrecording.NewRecorder(
+				config.Config.SessionRecording.Dir,
+				config.Config.SessionRecording.Recipient,
+				config.Config.SessionRecording.Clusters,
+				config.Config.SessionRecording.Retention,
+				logger.With(logging.Component("session_recorder")),
+			)
+			if recorderErr != nil {
+				return fmt.Errorf("failed to set up session recording: %w", recorderErr)
+			}
+		}
+
 		talosClientFactory := talos.NewClientFactory(resourceState, logger)
 		prometheus.MustRegister(talosClientFactory)
 
@@ -276,6 +315,7 @@ func runWithState(logger *zap.Logger) func(context.Context, state.State, *virtua
 			rootCmdArgs.certFile,
 			backend.NewProxyServer(rootCmdArgs.frontendBind, handler, rootCmdArgs.keyFile, rootCmdArgs.certFile),
 			auditWrap,
+			sessionRecorder,
 			logger,
 		)
 		if err != nil {
@@ -346,6 +386,11 @@ var initOnce = sync.OnceValue(func() *cobra.Command {
 	rootCmd.Flags().StringVar(&config.Config.SideroLinkAPIURL, "siderolink-api-advertised-url", config.Config.SideroLinkAPIURL, "SideroLink advertised API URL.")
 	rootCmd.Flags().IntVar(&config.Config.LoadBalancer.MinPort, "lb-min-port", config.Config.LoadBalancer.MinPort, "cluster load balancer port range min value.")
 	rootCmd.Flags().IntVar(&config.Config.LoadBalancer.MaxPort, "lb-max-port", config.Config.LoadBalancer.MaxPort, "cluster load balancer port range max value.")
+	rootCmd.Flags().StringVar(&config.Config.LoadBalancer.HealthCheck, "lb-health-check", config.Config.LoadBalancer.HealthCheck,
+		"cluster load balancer upstream health check type: 'tcp' or 'readyz' (HTTPS kube-apiserver readiness check).")
+	rootCmd.Flags().StringVar(&config.Config.LoadBalancer.SNIDomain, "lb-sni-domain", config.Config.LoadBalancer.SNIDomain,
+		"multiplex the load balancers of the clusters which opt in on a single port by the TLS server name <cluster>.<domain>, the domain should resolve to the SideroLink address of Omni.")
+	rootCmd.Flags().IntVar(&config.Config.LoadBalancer.SNIPort, "lb-sni-port", config.Config.LoadBalancer.SNIPort, "port for the SNI multiplexing of the cluster load balancers.")
 	rootCmd.Flags().IntVar(&config.Config.LogServerPort, "log-server-port", config.Config.LogServerPort, "port for TCP log server")
 
 	rootCmd.Flags().IntVar(&config.Config.MachineLogConfig.BufferInitialCapacity, "machine-log-buffer-capacity",
@@ -399,23 +444,14 @@ var initOnce = sync.OnceValue(func() *cobra.Command {
 	)
 	rootCmd.Flags().Var(&config.Config.Auth.SAML.LabelRules, "auth-saml-label-rules", "defines mapping of SAML assertion attributes into Omni identity labels")
 
-	rootCmd.Flags().StringSliceVar(&config.Config.InitialUsers, "initial-users", config.Config.InitialUsers, "initial set of user emails. these users will be created on startup.")
+	rootCmd.Flags().StringVar(&config.Config.Auth.UntrackedServiceAccountTokensCutoff, "auth-untracked-service-account-tokens-cutoff", config.Config.Auth.UntrackedServiceAccountTokensCutoff,
+		"RFC 3339 time after which the service account tokens issued before the kubeconfigs were tracked are rejected, as they can't be revoked. "+
+			"If empty, they are accepted until they expire.",
+	)
 
-	rootCmd.Flags().StringVar(&config.Config.Storage.Kind, "storage-kind", config.Config.Storage.Kind, "storage type: etcd|boltdb.")
-	rootCmd.Flags().BoolVar(&config.Config.Storage.Etcd.Embedded, "etcd-embedded", config.Config.Storage.Etcd.Embedded, "use embedded etcd server.")
-	rootCmd.Flags().BoolVar(&config.Config.Storage.Etcd.EmbeddedUnsafeFsync, "etcd-embedded-unsafe-fsync", config.Config.Storage.Etcd.EmbeddedUnsafeFsync,
-		"disable fsync in the embedded etcd server (dangerous).")
-	rootCmd.Flags().StringSliceVar(&config.Config.Storage.Etcd.Endpoints, "etcd-endpoints", config.Config.Storage.Etcd.Endpoints, "external etcd endpoints.")
-	rootCmd.Flags().DurationVar(&config.Config.Storage.Etcd.DialKeepAliveTime,
-		"etcd-dial-keepalive-time", config.Config.Storage.Etcd.DialKeepAliveTime, "external etcd client keep-alive time (interval).")
-	rootCmd.Flags().DurationVar(&config.Config.Storage.Etcd.DialKeepAliveTimeout,
-		"etcd-dial-keepalive-timeout", config.Config.Storage.Etcd.DialKeepAliveTimeout, "external etcd client keep-alive timeout.")
-	rootCmd.Flags().StringVar(&config.Config.Storage.Etcd.CAPath, "etcd-ca-path", config.Config.Storage.Etcd.CAPath, "external etcd CA path.")
-	rootCmd.Flags().StringVar(&config.Config.Storage.Etcd.CertPath, "etcd-client-cert-path", config.Config.Storage.Etcd.CertPath, "external etcd client cert path.")
-	rootCmd.Flags().StringVar(&config.Config.Storage.Etcd.KeyPath, "etcd-client-key-path", config.Config.Storage.Etcd.KeyPath, "external etcd client key path.")
+	rootCmd.Flags().StringSliceVar(&config.Config.InitialUsers, "initial-users", config.Config.InitialUsers, "initial set of user emails. these users will be created on startup.")
 
-	rootCmd.Flags().StringVar(&config.Config.SecondaryStorage.Path, "secondary-storage-path", config.Config.SecondaryStorage.Path,
-		"path of the file for boltdb-backed secondary storage for frequently updated data.")
+	addStorageFlags(rootCmd.Flags())
 
 	rootCmd.Flags().StringVar(&config.Config.TalosRegistry, "talos-installer-registry", config.Config.TalosRegistry, "Talos installer image registry.")
 	rootCmd.Flags().StringVar(&config.Config.KubernetesRegistry, "kubernetes-registry", config.Config.KubernetesRegistry, "Kubernetes container registry.")
@@ -423,16 +459,46 @@ var initOnce = sync.OnceValue(func() *cobra.Command {
 	rootCmd.Flags().StringVar(&config.Config.ImageFactoryPXEBaseURL, "image-factory-pxe-address", config.Config.ImageFactoryPXEBaseURL, "Image factory pxe base URL to use.")
 
 	rootCmd.Flags().StringVar(
-		&config.Config.Storage.Etcd.PrivateKeySource,
-		"private-key-source",
-		config.Config.Storage.Etcd.PrivateKeySource,
-		"file containing private key to use for decrypting master key slot.",
+		&config.Config.ImageFactoryCache.Dir,
+		"image-factory-cache-dir",
+		config.Config.ImageFactoryCache.Dir,
+		"Local directory to cache the installation media and installer images generated by the image factory, the cache is disabled if empty",
 	)
-	rootCmd.Flags().StringSliceVar(
-		&config.Config.Storage.Etcd.PublicKeyFiles,
-		"public-key-files",
-		config.Config.Storage.Etcd.PublicKeyFiles,
-		"list of paths to files containing public keys to use for encrypting keys slots.",
+
+	rootCmd.Flags().BoolVar(
+		&config.Config.ImageFactoryCache.UseBackupStore,
+		"image-factory-cache-use-backup-store",
+		config.Config.ImageFactoryCache.UseBackupStore,
+		"Cache the images generated by the image factory in the etcd backup store instead of the local directory",
+	)
+
+	rootCmd.Flags().StringArrayVar(
+		&config.Config.ImageFactoryCache.PrewarmImages,
+		"image-factory-cache-prewarm-image",
+		config.Config.ImageFactoryCache.PrewarmImages,
+		"Image factory file name to cache for each schematic in use, e.g. metal-amd64.iso or installer-amd64.tar, can be specified multiple times",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.ImageFactoryCache.PrewarmInterval,
+		"image-factory-cache-prewarm-interval",
+		config.Config.ImageFactoryCache.PrewarmInterval,
+		"Interval of the image factory cache prewarming",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.ImageFactoryCache.MaxAge,
+		"image-factory-cache-max-age",
+		config.Config.ImageFactoryCache.MaxAge,
+		"Time after which the unused images are evicted from the image factory cache, the images are never evicted if zero",
+	)
+
+	rootCmd.Flags().BoolVar(
+		&config.Config.ImageFactoryCache.ServeInstallerRegistry,
+		"image-factory-cache-serve-installer-registry",
+		config.Config.ImageFactoryCache.ServeInstallerRegistry,
+		"Serve the installer images to the machines from the Omni API endpoint through the image factory cache, "+
+			"the machines should be able to reach the API endpoint over HTTPS",
 	)
 
 	rootCmd.Flags().DurationVar(
@@ -449,13 +515,12 @@ var initOnce = sync.OnceValue(func() *cobra.Command {
 
 	rootCmd.Flags().BoolVar(&config.Config.WorkloadProxying.Enabled, "workload-proxying-enabled", config.Config.WorkloadProxying.Enabled, "enable workload proxying feature.")
 	rootCmd.Flags().StringVar(&config.Config.WorkloadProxying.Subdomain, "workload-proxying-subdomain", config.Config.WorkloadProxying.Subdomain, "workload proxying subdomain.")
-
-	rootCmd.Flags().BoolVar(&config.Config.ConfigDataCompression.Enabled, "config-data-compression-enabled", config.Config.ConfigDataCompression.Enabled, "enable config data compression.")
+	rootCmd.Flags().BoolVar(&config.Config.WorkloadProxying.AccessLogs, "workload-proxying-access-logs", config.Config.WorkloadProxying.AccessLogs,
+		"log every request to the exposed services with the authenticated identity.")
 
 	rootCmd.Flags().IntVar(&config.Config.LocalResourceServerPort, "local-resource-server-port", config.Config.LocalResourceServerPort, "port for local read-only public resource server.")
 
 	ensure.NoError(rootCmd.MarkFlagRequired("private-key-source"))
-	ensure.NoError(rootCmd.Flags().MarkHidden("etcd-embedded-unsafe-fsync"))
 
 	rootCmd.Flags().StringVar(&rootCmdArgs.keyFile, "key", "", "TLS key file")
 	rootCmd.Flags().StringVar(&rootCmdArgs.certFile, "cert", "", "TLS cert file")
@@ -565,6 +630,174 @@ var initOnce = sync.OnceValue(func() *cobra.Command {
 		"Directory for audit log storage",
 	)
 
+	rootCmd.Flags().BoolVar(
+		&config.Config.Tracing.Enabled,
+		"tracing-enabled",
+		config.Config.Tracing.Enabled,
+		"Enable OpenTelemetry tracing of the API, state, proxies and image factory calls",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.Tracing.Endpoint,
+		"tracing-endpoint",
+		config.Config.Tracing.Endpoint,
+		"OTLP gRPC endpoint of the trace collector",
+	)
+
+	rootCmd.Flags().BoolVar(
+		&config.Config.Tracing.Insecure,
+		"tracing-insecure",
+		config.Config.Tracing.Insecure,
+		"Connect to the trace collector without TLS",
+	)
+
+	rootCmd.Flags().Float64Var(
+		&config.Config.Tracing.SamplingRatio,
+		"tracing-sampling-ratio",
+		config.Config.Tracing.SamplingRatio,
+		"Ratio of the sampled traces started by Omni, the traces started by the callers follow their sampling decision",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.Tracing.ServiceName,
+		"tracing-service-name",
+		config.Config.Tracing.ServiceName,
+		"Service name reported in the traces",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.SessionRecording.Dir,
+		"session-recording-dir",
+		config.Config.SessionRecording.Dir,
+		"Directory for the encrypted Talos and Kubernetes session recordings, the recording is disabled if not set",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.SessionRecording.Recipient,
+		"session-recording-recipient",
+		config.Config.SessionRecording.Recipient,
+		"age public key the session recordings are encrypted for, the matching identity is required only to replay them with omnictl",
+	)
+
+	rootCmd.Flags().StringSliceVar(
+		&config.Config.SessionRecording.Clusters,
+		"session-recording-clusters",
+		config.Config.SessionRecording.Clusters,
+		"Cluster name patterns to record the sessions of, all clusters are recorded if not set",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.SessionRecording.Retention,
+		"session-recording-retention",
+		config.Config.SessionRecording.Retention,
+		"Retention period of the session recordings",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.InventorySync.Endpoint,
+		"inventory-sync-endpoint",
+		config.Config.InventorySync.Endpoint,
+		"URL to push the machine hardware inventory changes to, the sync is disabled if not set",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.InventorySync.Interval,
+		"inventory-sync-interval",
+		config.Config.InventorySync.Interval,
+		"Interval of the machine hardware inventory sync, should be positive",
+	)
+
+	rootCmd.Flags().StringToStringVar(
+		&config.Config.InventorySync.Headers,
+		"inventory-sync-headers",
+		config.Config.InventorySync.Headers,
+		"HTTP headers to add to the machine hardware inventory push requests",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.MachineResourceUsageInterval,
+		"machine-resource-usage-interval",
+		config.Config.MachineResourceUsageInterval,
+		"Interval of the machine CPU, memory, filesystem and network usage collection, the collection is disabled if zero",
+	)
+
+	rootCmd.Flags().IntVar(
+		&config.Config.ConfigRevisionLimit,
+		"config-revision-limit",
+		config.Config.ConfigRevisionLimit,
+		"Number of revisions kept for each config patch and generated machine config, the history is disabled if zero",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.ConfigRevisionRetention,
+		"config-revision-retention",
+		config.Config.ConfigRevisionRetention,
+		"Retention period of the revisions of the removed config patches and machine configs",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.SupportBundle.Interval,
+		"support-bundle-interval",
+		config.Config.SupportBundle.Interval,
+		"Interval of the fleet-wide support bundles stored in the etcd backup store, the scheduled bundles are disabled if zero",
+	)
+
+	rootCmd.Flags().BoolVar(
+		&config.Config.SupportBundle.OnUnhealthy,
+		"support-bundle-on-unhealthy",
+		config.Config.SupportBundle.OnUnhealthy,
+		"Collect a support bundle of the cluster when it becomes unhealthy and store it in the etcd backup store",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.SupportBundle.Recipient,
+		"support-bundle-recipient",
+		config.Config.SupportBundle.Recipient,
+		"age public key the stored support bundles are encrypted for, it is required to store the bundles",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.SupportBundle.UnhealthyCooldown,
+		"support-bundle-unhealthy-cooldown",
+		config.Config.SupportBundle.UnhealthyCooldown,
+		"Minimum interval between the support bundles of the same unhealthy cluster",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.SupportBundle.Retention,
+		"support-bundle-retention",
+		config.Config.SupportBundle.Retention,
+		"Retention period of the stored support bundles",
+	)
+
+	rootCmd.Flags().StringArrayVar(
+		&config.Config.SupportBundle.RedactPatterns,
+		"support-bundle-redact-pattern",
+		config.Config.SupportBundle.RedactPatterns,
+		"Additional regular expression to redact from the support bundles, only the first capture group is redacted if the pattern has one",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.SelfBackup.Interval,
+		"self-backup-interval",
+		config.Config.SelfBackup.Interval,
+		"Interval of the encrypted Omni state snapshots stored in the etcd backup store, the snapshots are disabled if zero",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.SelfBackup.Retention,
+		"self-backup-retention",
+		config.Config.SelfBackup.Retention,
+		"Retention period of the stored Omni state snapshots",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.SelfBackup.KeyFile,
+		"self-backup-key-file",
+		config.Config.SelfBackup.KeyFile,
+		"Path to the age key used to encrypt the Omni state snapshots, it is generated if it doesn't exist",
+	)
+
 	rootCmd.Flags().BoolVar(
 		&config.Config.InitialServiceAccount.Enabled,
 		"create-initial-service-account",
@@ -593,5 +826,54 @@ var initOnce = sync.OnceValue(func() *cobra.Command {
 		"the lifetime duration of the initial service account key",
 	)
 
+	restoreCmd.Flags().BoolVar(&restoreCmdArgs.rekeySideroLink, "rekey-siderolink", false,
+		"generate the new SideroLink Wireguard key, the machines should be provisioned again to reconnect")
+	restoreCmd.Flags().BoolVar(&restoreCmdArgs.force, "force", false,
+		"restore the snapshot even if the state already has clusters, machines or users")
+
+	restoreCmd.Flags().StringVar(&config.Config.AccountID, "account-id", config.Config.AccountID, "instance account ID, the etcd storage encryption key is bound to it.")
+	restoreCmd.Flags().StringVar(&config.Config.SelfBackup.KeyFile, "self-backup-key-file", config.Config.SelfBackup.KeyFile, "Path to the age key the snapshot is encrypted with")
+
+	// the snapshot is restored to the storage configured with the same flags as Omni uses
+	addStorageFlags(restoreCmd.Flags())
+
+	rootCmd.AddCommand(restoreCmd)
+
 	return rootCmd
 })
+
+// addStorageFlags adds the flags configuring the Omni state storage.
+func addStorageFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&config.Config.Storage.Kind, "storage-kind", config.Config.Storage.Kind, "storage type: etcd|boltdb.")
+	flags.BoolVar(&config.Config.Storage.Etcd.Embedded, "etcd-embedded", config.Config.Storage.Etcd.Embedded, "use embedded etcd server.")
+	flags.BoolVar(&config.Config.Storage.Etcd.EmbeddedUnsafeFsync, "etcd-embedded-unsafe-fsync", config.Config.Storage.Etcd.EmbeddedUnsafeFsync,
+		"disable fsync in the embedded etcd server (dangerous).")
+	flags.StringSliceVar(&config.Config.Storage.Etcd.Endpoints, "etcd-endpoints", config.Config.Storage.Etcd.Endpoints, "external etcd endpoints.")
+	flags.DurationVar(&config.Config.Storage.Etcd.DialKeepAliveTime,
+		"etcd-dial-keepalive-time", config.Config.Storage.Etcd.DialKeepAliveTime, "external etcd client keep-alive time (interval).")
+	flags.DurationVar(&config.Config.Storage.Etcd.DialKeepAliveTimeout,
+		"etcd-dial-keepalive-timeout", config.Config.Storage.Etcd.DialKeepAliveTimeout, "external etcd client keep-alive timeout.")
+	flags.StringVar(&config.Config.Storage.Etcd.CAPath, "etcd-ca-path", config.Config.Storage.Etcd.CAPath, "external etcd CA path.")
+	flags.StringVar(&config.Config.Storage.Etcd.CertPath, "etcd-client-cert-path", config.Config.Storage.Etcd.CertPath, "external etcd client cert path.")
+	flags.StringVar(&config.Config.Storage.Etcd.KeyPath, "etcd-client-key-path", config.Config.Storage.Etcd.KeyPath, "external etcd client key path.")
+
+	flags.StringVar(&config.Config.SecondaryStorage.Path, "secondary-storage-path", config.Config.SecondaryStorage.Path,
+		"path of the file for boltdb-backed secondary storage for frequently updated data.")
+
+	flags.StringVar(
+		&config.Config.Storage.Etcd.PrivateKeySource,
+		"private-key-source",
+		config.Config.Storage.Etcd.PrivateKeySource,
+		"file containing private key to use for decrypting master key slot.",
+	)
+	flags.StringSliceVar(
+		&config.Config.Storage.Etcd.PublicKeyFiles,
+		"public-key-files",
+		config.Config.Storage.Etcd.PublicKeyFiles,
+		"list of paths to files containing public keys to use for encrypting keys slots.",
+	)
+
+	flags.BoolVar(&config.Config.ConfigDataCompression.Enabled, "config-data-compression-enabled", config.Config.ConfigDataCompression.Enabled, "enable config data compression.")
+
+	ensure.NoError(flags.MarkHidden("etcd-embedded-unsafe-fsync"))
+}
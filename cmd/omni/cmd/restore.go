@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/compression"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/migration"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/virtual"
+	"github.com/siderolabs/omni/internal/backend/selfbackup"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+var restoreCmdArgs struct {
+	rekeySideroLink bool
+	force           bool
+}
+
+// restoreCmd restores the Omni state snapshot.
+var restoreCmd = &cobra.Command{
+	Use:   "restore <snapshot>",
+	Short: "Restore the encrypted Omni state snapshot",
+	Long: `Restore the encrypted Omni state snapshot to the storage configured with the same flags as the Omni itself.
+The etcd storage also requires the same --account-id and --private-key-source as the Omni.
+
+The snapshots stored in the etcd backup store should be downloaded first, as the store configuration is a part of the restored state.
+The snapshot is decrypted with the key from --self-backup-key-file. Omni should not be running while the snapshot is restored.`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger, err := zap.NewProduction()
+		if err != nil {
+			return fmt.Errorf("failed to set up logging: %w", err)
+		}
+
+		if err = compression.InitConfig(config.Config.ConfigDataCompression.Enabled); err != nil {
+			return err
+		}
+
+		identity, err := selfbackup.LoadIdentity(config.Config.SelfBackup.KeyFile, false, logger)
+		if err != nil {
+			return err
+		}
+
+		snapshot, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+
+		defer snapshot.Close() //nolint:errcheck
+
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+
+		ctx = actor.MarkContextAsInternalActor(ctx)
+
+		return omni.NewState(ctx, config.Config, logger, prometheus.NewRegistry(), func(ctx context.Context, st state.State, _ *virtual.State) error {
+			report, err := selfbackup.Restore(ctx, st, snapshot, identity, selfbackup.RestoreOptions{
+				MaxDBVersion:    migration.NewManager(st, logger).LatestVersion(),
+				RekeySideroLink: restoreCmdArgs.rekeySideroLink,
+				Force:           restoreCmdArgs.force,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to restore the snapshot: %w", err)
+			}
+
+			return report.Write(cmd.OutOrStdout())
+		})
+	},
+}
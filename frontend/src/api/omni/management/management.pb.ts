@@ -42,6 +42,7 @@ export type MachineLogsRequest = {
 
 export type ValidateConfigRequest = {
   config?: string
+  template?: boolean
 }
 
 export type TalosconfigRequest = {
@@ -96,15 +97,32 @@ export type KubeconfigRequest = {
   service_account_groups?: string[]
   grant_type?: string
   break_glass?: boolean
+  service_account_namespaces?: string[]
+}
+
+export type RevokeKubeconfigRequest = {
+  id?: string
 }
 
 export type KubernetesUpgradePreChecksRequest = {
   new_version?: string
+  ignore_deprecated_apis?: boolean
+}
+
+export type KubernetesUpgradePreChecksResponseDeprecatedAPIUsage = {
+  api_version?: string
+  kind?: string
+  namespace?: string
+  name?: string
+  removed_in?: string
+  replacement?: string
+  helm_release?: string
 }
 
 export type KubernetesUpgradePreChecksResponse = {
   ok?: boolean
   reason?: string
+  deprecated_api_usages?: KubernetesUpgradePreChecksResponseDeprecatedAPIUsage[]
 }
 
 export type KubernetesSyncManifestRequest = {
@@ -160,6 +178,71 @@ export type ReadAuditLogResponse = {
   audit_log?: Uint8Array
 }
 
+export type WorkloadProxyTunnelRequestInit = {
+  cluster?: string
+  service?: string
+}
+
+export type WorkloadProxyTunnelRequest = {
+  init?: WorkloadProxyTunnelRequestInit
+  data?: Uint8Array
+}
+
+export type WorkloadProxyTunnelResponse = {
+  data?: Uint8Array
+}
+
+export type SessionRecording = {
+  id?: string
+  type?: string
+  cluster?: string
+  identity?: string
+  method?: string
+  nodes?: string
+  start_time?: GoogleProtobufTimestamp.Timestamp
+  size?: string
+}
+
+export type ListSessionRecordingsResponse = {
+  recordings?: SessionRecording[]
+}
+
+export type ReadSessionRecordingRequest = {
+  id?: string
+}
+
+export type ReadSessionRecordingResponse = {
+  recording?: SessionRecording
+  data?: Uint8Array
+}
+
+export type ControllerStatus = {
+  name?: string
+  kind?: string
+  health?: string
+  reconciles?: string
+  errors?: string
+  queue_depth?: string
+  busy?: number
+  busy_since?: GoogleProtobufTimestamp.Timestamp
+  last_success?: GoogleProtobufTimestamp.Timestamp
+  last_error?: string
+  last_error_time?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type ListControllerStatusesResponse = {
+  controllers?: ControllerStatus[]
+}
+
+export type PreviewMachineClassMatchesRequest = {
+  match_labels?: string[]
+  match_expression?: string
+}
+
+export type PreviewMachineClassMatchesResponse = {
+  machine_ids?: string[]
+}
+
 export class ManagementService {
   static Kubeconfig(req: KubeconfigRequest, ...options: fm.fetchOption[]): Promise<KubeconfigResponse> {
     return fm.fetchReq<KubeconfigRequest, KubeconfigResponse>("POST", `/management.ManagementService/Kubeconfig`, req, ...options)
@@ -188,6 +271,9 @@ export class ManagementService {
   static DestroyServiceAccount(req: DestroyServiceAccountRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<DestroyServiceAccountRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/DestroyServiceAccount`, req, ...options)
   }
+  static RevokeKubeconfig(req: RevokeKubeconfigRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<RevokeKubeconfigRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/RevokeKubeconfig`, req, ...options)
+  }
   static KubernetesUpgradePreChecks(req: KubernetesUpgradePreChecksRequest, ...options: fm.fetchOption[]): Promise<KubernetesUpgradePreChecksResponse> {
     return fm.fetchReq<KubernetesUpgradePreChecksRequest, KubernetesUpgradePreChecksResponse>("POST", `/management.ManagementService/KubernetesUpgradePreChecks`, req, ...options)
   }
@@ -203,4 +289,16 @@ export class ManagementService {
   static ReadAuditLog(req: ReadAuditLogRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ReadAuditLogResponse>, ...options: fm.fetchOption[]): Promise<void> {
     return fm.fetchStreamingRequest<ReadAuditLogRequest, ReadAuditLogResponse>("POST", `/management.ManagementService/ReadAuditLog`, req, entityNotifier, ...options)
   }
+  static ListSessionRecordings(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<ListSessionRecordingsResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, ListSessionRecordingsResponse>("POST", `/management.ManagementService/ListSessionRecordings`, req, ...options)
+  }
+  static ReadSessionRecording(req: ReadSessionRecordingRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ReadSessionRecordingResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<ReadSessionRecordingRequest, ReadSessionRecordingResponse>("POST", `/management.ManagementService/ReadSessionRecording`, req, entityNotifier, ...options)
+  }
+  static ListControllerStatuses(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<ListControllerStatusesResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, ListControllerStatusesResponse>("POST", `/management.ManagementService/ListControllerStatuses`, req, ...options)
+  }
+  static PreviewMachineClassMatches(req: PreviewMachineClassMatchesRequest, ...options: fm.fetchOption[]): Promise<PreviewMachineClassMatchesResponse> {
+    return fm.fetchReq<PreviewMachineClassMatchesRequest, PreviewMachineClassMatchesResponse>("POST", `/management.ManagementService/PreviewMachineClassMatches`, req, ...options)
+  }
 }
\ No newline at end of file
@@ -122,14 +122,36 @@ export type AccessPolicyTest = {
   expected?: AccessPolicyTestExpected
 }
 
+export type AccessPolicyTalosAPIRule = {
+  roles?: string[]
+  clusters?: string[]
+  machine_label_selectors?: string[]
+  allowed_methods?: string[]
+  denied_methods?: string[]
+}
+
 export type AccessPolicySpec = {
   user_groups?: {[key: string]: AccessPolicyUserGroup}
   cluster_groups?: {[key: string]: AccessPolicyClusterGroup}
   rules?: AccessPolicyRule[]
   tests?: AccessPolicyTest[]
+  talos_api_rules?: AccessPolicyTalosAPIRule[]
 }
 
 export type SAMLLabelRuleSpec = {
   match_labels?: string[]
   assign_role_on_registration?: string
+}
+
+export type IssuedKubeconfigSpec = {
+  cluster?: string
+  issued_by?: string
+  subject?: string
+  groups?: string[]
+  namespaces?: string[]
+  issued_at?: GoogleProtobufTimestamp.Timestamp
+  expires_at?: GoogleProtobufTimestamp.Timestamp
+  revoked?: boolean
+  revoked_by?: string
+  revoked_at?: GoogleProtobufTimestamp.Timestamp
 }
\ No newline at end of file
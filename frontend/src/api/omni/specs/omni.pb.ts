@@ -129,6 +129,12 @@ export enum KubernetesUpgradeStatusSpecPhase {
   Reverting = 4,
 }
 
+export enum ExposedServiceSpecProtocol {
+  HTTP = 0,
+  GRPC = 1,
+  TCP = 2,
+}
+
 export enum ExtensionsConfigurationStatusSpecPhase {
   Unknown = 0,
   Ready = 1,
@@ -162,6 +168,31 @@ export enum InfraMachineConfigSpecMachinePowerState {
   POWER_STATE_ON = 2,
 }
 
+export enum MachineHealthStatusSpecSeverity {
+  INFO = 0,
+  WARNING = 1,
+  ERROR = 2,
+}
+
+export enum AdmissionPolicySpecAction {
+  DENY = 0,
+  REQUIRE_APPROVAL = 1,
+}
+
+export enum ChangeRequestSpecPhase {
+  PENDING = 0,
+  APPROVED = 1,
+  REJECTED = 2,
+  APPLIED = 3,
+  FAILED = 4,
+}
+
+export enum AddonStatusSpecPhase {
+  PENDING = 0,
+  APPLIED = 1,
+  FAILED = 2,
+}
+
 export type MachineSpec = {
   management_address?: string
   connected?: boolean
@@ -221,6 +252,7 @@ export type MachineStatusSpecNetworkStatusNetworkLinkStatus = {
   speed_mbps?: number
   link_up?: boolean
   description?: string
+  state_changes?: number
 }
 
 export type MachineStatusSpecNetworkStatus = {
@@ -289,12 +321,19 @@ export type ClusterSpecFeatures = {
   use_embedded_discovery_service?: boolean
 }
 
+export type ClusterSpecLoadBalancer = {
+  bind_port?: number
+  bind_address?: string
+  sni?: boolean
+}
+
 export type ClusterSpec = {
   install_image?: string
   kubernetes_version?: string
   talos_version?: string
   features?: ClusterSpecFeatures
   backup_configuration?: EtcdBackupConf
+  load_balancer?: ClusterSpecLoadBalancer
 }
 
 export type ClusterTaintSpec = {
@@ -462,11 +501,20 @@ export type LoadBalancerConfigSpec = {
   bind_port?: string
   siderolink_endpoint?: string
   endpoints?: string[]
+  bind_address?: string
+  sni_host?: string
+}
+
+export type LoadBalancerStatusSpecUpstream = {
+  address?: string
+  healthy?: boolean
+  last_error?: string
 }
 
 export type LoadBalancerStatusSpec = {
   healthy?: boolean
   stopped?: boolean
+  upstreams?: LoadBalancerStatusSpecUpstream[]
 }
 
 export type KubernetesVersionSpec = {
@@ -497,6 +545,24 @@ export type ConfigPatchSpec = {
   compressed_data?: Uint8Array
 }
 
+export type ConfigPatchRevisionSpec = {
+  data?: string
+  compressed_data?: Uint8Array
+  revision?: string
+  config_patch_version?: string
+  created?: GoogleProtobufTimestamp.Timestamp
+  deleted?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type ClusterMachineConfigRevisionSpec = {
+  data?: string
+  compressed_data?: Uint8Array
+  revision?: string
+  cluster_machine_config_version?: string
+  created?: GoogleProtobufTimestamp.Timestamp
+  deleted?: GoogleProtobufTimestamp.Timestamp
+}
+
 export type MachineSetSpecMachineClass = {
   name?: string
   machine_count?: number
@@ -636,6 +702,10 @@ export type ExposedServiceSpec = {
   label?: string
   icon_base64?: string
   url?: string
+  allowed_users?: string[]
+  allowed_identity_label_selectors?: string[]
+  min_role?: string
+  protocol?: ExposedServiceSpecProtocol
 }
 
 export type ClusterWorkloadProxyStatusSpec = {
@@ -667,6 +737,7 @@ export type MachineClassSpecProvision = {
 export type MachineClassSpec = {
   match_labels?: string[]
   auto_provision?: MachineClassSpecProvision
+  match_expression?: string
 }
 
 export type MachineConfigGenOptionsSpecInstallImage = {
@@ -829,4 +900,127 @@ export type InfraMachineConfigSpec = {
   power_state?: InfraMachineConfigSpecMachinePowerState
   acceptance_status?: InfraMachineConfigSpecAcceptanceStatus
   extra_kernel_args?: string
-}
\ No newline at end of file
+}
+export type MachineResourceUsageSpecMount = {
+  filesystem?: string
+  mounted_on?: string
+  size_bytes?: string
+  available_bytes?: string
+}
+
+export type MachineResourceUsageSpecNetworkInterface = {
+  name?: string
+  rx_bytes_per_second?: number
+  tx_bytes_per_second?: number
+}
+
+export type MachineResourceUsageSpec = {
+  load1?: number
+  load5?: number
+  load15?: number
+  cpu_usage_percent?: number
+  memory_total_bytes?: string
+  memory_available_bytes?: string
+  mounts?: MachineResourceUsageSpecMount[]
+  network_interfaces?: MachineResourceUsageSpecNetworkInterface[]
+  collected_at?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type MachineHealthStatusSpecDiagnostic = {
+  type?: string
+  severity?: MachineHealthStatusSpecSeverity
+  subject?: string
+  message?: string
+  first_seen?: GoogleProtobufTimestamp.Timestamp
+  resolved?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type MachineHealthStatusSpecDisk = {
+  key?: string
+  linux_name?: string
+  last_seen?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type MachineHealthStatusSpecLink = {
+  name?: string
+  link_up?: boolean
+  transitions?: GoogleProtobufTimestamp.Timestamp[]
+  state_changes?: number
+}
+
+export type MachineHealthStatusSpec = {
+  diagnostics?: MachineHealthStatusSpecDiagnostic[]
+  history?: MachineHealthStatusSpecDiagnostic[]
+  disks?: MachineHealthStatusSpecDisk[]
+  links?: MachineHealthStatusSpecLink[]
+  severity?: MachineHealthStatusSpecSeverity
+}
+
+export type AdmissionPolicySpecRule = {
+  expression?: string
+  message?: string
+}
+
+export type AdmissionPolicySpec = {
+  resource_types?: string[]
+  match_expression?: string
+  rules?: AdmissionPolicySpecRule[]
+  operations?: string[]
+  action?: AdmissionPolicySpecAction
+  approver_role?: string
+}
+
+export type AdmissionPolicyStatusSpec = {
+  error?: string
+}
+
+export type ChangeRequestSpec = {
+  operation?: string
+  resource_namespace?: string
+  resource_type?: string
+  resource_id?: string
+  resource?: Uint8Array
+  resource_version?: string
+  requested_by?: string
+  requested_at?: GoogleProtobufTimestamp.Timestamp
+  reasons?: string[]
+  approver_role?: string
+  phase?: ChangeRequestSpecPhase
+  reviewed_by?: string
+  reviewed_at?: GoogleProtobufTimestamp.Timestamp
+  error?: string
+}
+
+export type AddonSpecHelmChart = {
+  repository?: string
+  name?: string
+  version?: string
+  values?: string
+  release_name?: string
+}
+
+export type AddonSpec = {
+  clusters?: string[]
+  cluster_selector?: string
+  namespace?: string
+  manifests?: string
+  helm_chart?: AddonSpecHelmChart
+  prune?: boolean
+}
+
+export type AddonStatusSpecObject = {
+  api_version?: string
+  kind?: string
+  namespace?: string
+  name?: string
+}
+
+export type AddonStatusSpec = {
+  phase?: AddonStatusSpecPhase
+  objects?: AddonStatusSpecObject[]
+  out_of_sync?: number
+  error?: string
+  last_sync?: GoogleProtobufTimestamp.Timestamp
+  addon_version?: string
+  prune?: boolean
+}
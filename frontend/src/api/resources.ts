@@ -62,8 +62,14 @@ export const InfraProviderNamespace = "infra-provider";
 export const MachineLocked = "omni.sidero.dev/locked";
 export const UpdateLocked = "omni.sidero.dev/locked-update";
 export const ResourceManagedByClusterTemplates = "omni.sidero.dev/managed-by-cluster-templates";
+export const FleetPatchSelector = "omni.sidero.dev/fleet-patch-selector";
 export const ConfigPatchName = "name";
 export const ConfigPatchDescription = "description";
+export const AdmissionPolicyType = "AdmissionPolicies.omni.sidero.dev";
+export const AdmissionPolicyStatusType = "AdmissionPolicyStatuses.omni.sidero.dev";
+export const ChangeRequestType = "ChangeRequests.omni.sidero.dev";
+export const AddonType = "Addons.omni.sidero.dev";
+export const AddonStatusType = "AddonStatuses.omni.sidero.dev";
 export const EtcdBackupS3ConfID = "etcd-backup-s3-conf";
 export const EtcdBackupS3ConfType = "EtcdBackupS3Configs.omni.sidero.dev";
 export const BackupDataType = "BackupDatas.omni.sidero.dev";
@@ -90,6 +96,7 @@ export const ClusterTaintType = "ClusterTaints.omni.sidero.dev";
 export const ClusterUUIDType = "ClusterUUIDs.omni.sidero.dev";
 export const ClusterWorkloadProxyStatusType = "ClusterWorkloadProxyStatuses.omni.sidero.dev";
 export const ConfigPatchType = "ConfigPatches.omni.sidero.dev";
+export const ConfigPatchRevisionType = "ConfigPatchRevisions.omni.sidero.dev";
 export const ControlPlaneStatusType = "ControlPlaneStatuses.omni.sidero.dev";
 export const EtcdBackupType = "EtcdBackups.omni.sidero.dev";
 export const EtcdBackupStoreStatusID = "etcdbackup-store-status";
@@ -121,11 +128,16 @@ export const LabelHostname = "omni.sidero.dev/hostname";
 export const LabelMachineSet = "omni.sidero.dev/machine-set";
 export const LabelClusterMachine = "omni.sidero.dev/cluster-machine";
 export const LabelMachine = "omni.sidero.dev/machine";
+export const LabelFleetPatchTarget = "omni.sidero.dev/fleet-patch-target";
+export const LabelConfigPatch = "omni.sidero.dev/config-patch";
+export const LabelAddon = "omni.sidero.dev/addon";
+export const LabelConfigPatchTemplate = "omni.sidero.dev/config-patch-template";
 export const LabelSystemPatch = "omni.sidero.dev/system-patch";
 export const LabelExposedServiceAlias = "omni.sidero.dev/exposed-service-alias";
 export const LabelMachineRequest = "omni.sidero.dev/machine-request";
 export const LabelMachineRequestSet = "omni.sidero.dev/machine-request-set";
 export const LabelNoManualAllocation = "omni.sidero.dev/no-manual-allocation";
+export const LabelMachineClassMismatch = "omni.sidero.dev/machine-class-mismatch";
 export const MachineStatusLabelConnected = "omni.sidero.dev/connected";
 export const MachineStatusLabelDisconnected = "omni.sidero.dev/disconnected";
 export const MachineStatusLabelInvalidState = "omni.sidero.dev/invalid-state";
@@ -164,8 +176,11 @@ export const MachineStatusLinkType = "MachineStatusLinks.omni.sidero.dev";
 export const MachineStatusMetricsType = "MachineStatusMetrics.omni.sidero.dev";
 export const MachineStatusMetricsID = "metrics";
 export const MachineStatusSnapshotType = "MachineStatusSnapshots.omni.sidero.dev";
+export const MachineResourceUsageType = "MachineResourceUsages.omni.sidero.dev";
+export const MachineHealthStatusType = "MachineHealthStatuses.omni.sidero.dev";
 export const OngoingTaskType = "OngoingTasks.omni.sidero.dev";
 export const RedactedClusterMachineConfigType = "RedactedClusterMachineConfigs.omni.sidero.dev";
+export const ClusterMachineConfigRevisionType = "ClusterMachineConfigRevisions.omni.sidero.dev";
 export const SchematicType = "Schematics.omni.sidero.dev";
 export const SchematicConfigurationType = "SchematicConfigurations.omni.sidero.dev";
 export const ClusterSecretsType = "ClusterSecrets.omni.sidero.dev";
@@ -179,6 +194,7 @@ export const IdentityType = "Identities.omni.sidero.dev";
 export const SAMLLabelPrefix = "saml.omni.sidero.dev/";
 export const LabelIdentityUserID = "user-id";
 export const LabelIdentityTypeServiceAccount = "type-service-account";
+export const IssuedKubeconfigType = "IssuedKubeconfigs.omni.sidero.dev";
 export const PublicKeyType = "PublicKeys.omni.sidero.dev";
 export const SAMLLabelRuleType = "SAMLLabelRules.omni.sidero.dev";
 export const UserType = "Users.omni.sidero.dev";
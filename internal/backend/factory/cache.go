@@ -0,0 +1,302 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+)
+
+// CachePrefix is the key prefix of the cached images in the etcd backup store.
+const CachePrefix = "image-factory-cache/"
+
+// ErrNotCached is returned by [Cache.Get] when the image is not in the cache.
+var ErrNotCached = errors.New("image is not cached")
+
+// Cache keeps the installation media and installer images generated by the image factory,
+// so that they can be served when the image factory is not reachable.
+type Cache interface {
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Evict removes the images which were not used since the given time.
+	Evict(ctx context.Context, before time.Time) error
+}
+
+// CacheKey returns the cache key of the image generated by the image factory.
+//
+// The Talos version is normalized, so that the same image requested with and without the "v" prefix is cached once.
+func CacheKey(schematicID, talosVersion, filename string) string {
+	return path.Join("image", schematicID, strings.TrimPrefix(talosVersion, "v"), filename)
+}
+
+// DirCache keeps the images in a local directory.
+type DirCache struct {
+	dir string
+}
+
+// NewDirCache initializes DirCache.
+func NewDirCache(dir string) *DirCache {
+	return &DirCache{dir: dir}
+}
+
+// Get implements [Cache].
+//
+// The returned reader is an [*os.File], so the range requests can be served from it.
+// The modification time of the file is updated, so that the images in use are not evicted.
+func (c *DirCache) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	fullpath, err := c.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(fullpath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotCached
+		}
+
+		return nil, err
+	}
+
+	now := time.Now()
+
+	os.Chtimes(fullpath, now, now) //nolint:errcheck
+
+	return f, nil
+}
+
+// Put implements [Cache].
+//
+// The image is written to a temporary file first, so that the partially downloaded images are never served.
+func (c *DirCache) Put(_ context.Context, key string, r io.Reader) error {
+	fullpath, err := c.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(fullpath), 0o755); err != nil {
+		return fmt.Errorf("failed to create cache dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fullpath), filepath.Base(fullpath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		tmp.Close() //nolint:errcheck
+
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close cache file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), fullpath)
+}
+
+// Evict implements [Cache].
+func (c *DirCache) Evict(_ context.Context, before time.Time) error {
+	err := filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if !info.ModTime().Before(before) {
+			return nil
+		}
+
+		if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (c *DirCache) path(key string) (string, error) {
+	p := filepath.FromSlash(key)
+
+	if !filepath.IsLocal(p) {
+		return "", fmt.Errorf("invalid cache key %q", key)
+	}
+
+	return filepath.Join(c.dir, p), nil
+}
+
+// StoreGetter returns the etcd backup store.
+type StoreGetter interface {
+	GetStore() (etcdbackup.Store, error)
+}
+
+// ObjectStoreCache keeps the images in the etcd backup store under the [CachePrefix].
+type ObjectStoreCache struct {
+	stores StoreGetter
+}
+
+// NewObjectStoreCache initializes ObjectStoreCache.
+func NewObjectStoreCache(stores StoreGetter) *ObjectStoreCache {
+	return &ObjectStoreCache{stores: stores}
+}
+
+// Get implements [Cache].
+func (c *ObjectStoreCache) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	objectStore, err := c.objectStore()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := objectStore.GetObject(ctx, CachePrefix+key)
+	if err != nil {
+		if errors.Is(err, etcdbackup.ErrObjectNotFound) {
+			return nil, ErrNotCached
+		}
+
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Put implements [Cache].
+func (c *ObjectStoreCache) Put(ctx context.Context, key string, r io.Reader) error {
+	objectStore, err := c.objectStore()
+	if err != nil {
+		return err
+	}
+
+	return objectStore.PutObject(ctx, CachePrefix+key, r)
+}
+
+// Evict implements [Cache].
+//
+// The object store doesn't track the object reads, so the images are evicted by the time they were stored.
+func (c *ObjectStoreCache) Evict(ctx context.Context, before time.Time) error {
+	objectStore, err := c.objectStore()
+	if err != nil {
+		return err
+	}
+
+	objects, err := objectStore.ListObjects(ctx, CachePrefix)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+
+	for _, object := range objects {
+		if object.Timestamp.Before(before) {
+			errs = errors.Join(errs, objectStore.DeleteObject(ctx, object.Key))
+		}
+	}
+
+	return errs
+}
+
+func (c *ObjectStoreCache) objectStore() (etcdbackup.ObjectStore, error) {
+	store, err := c.stores.GetStore()
+	if err != nil {
+		return nil, err
+	}
+
+	objectStore, ok := store.(etcdbackup.ObjectStore)
+	if !ok {
+		return nil, etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	return objectStore, nil
+}
+
+// evictionInterval is the interval of the cache eviction.
+const evictionInterval = time.Hour
+
+// Evictor periodically removes the images which were not used for longer than MaxAge from the cache.
+type Evictor struct {
+	Cache  Cache
+	Logger *zap.Logger
+
+	// MaxAge is the time after which the unused images are removed, the eviction is disabled if it's zero.
+	MaxAge time.Duration
+}
+
+// Run the evictor until the context is canceled.
+func (e *Evictor) Run(ctx context.Context) error {
+	if e.Cache == nil || e.MaxAge == 0 {
+		<-ctx.Done()
+
+		return nil
+	}
+
+	ticker := time.NewTicker(evictionInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Cache.Evict(ctx, time.Now().Add(-e.MaxAge)); err != nil {
+			e.Logger.Warn("failed to evict the unused images from the cache", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// copyAndCache copies the body to the writer and stores it in the cache at the same time.
+//
+// The data is not cached if either the read or the write fails, or if verify returns an error.
+func copyAndCache(ctx context.Context, cache Cache, key string, w io.Writer, body io.Reader, verify func() error) error {
+	reader, writer := io.Pipe()
+
+	cacheErr := make(chan error, 1)
+
+	go func() {
+		err := cache.Put(context.WithoutCancel(ctx), key, reader)
+
+		reader.CloseWithError(err)
+
+		cacheErr <- err
+	}()
+
+	_, err := io.Copy(io.MultiWriter(w, writer), body)
+	if err == nil && verify != nil {
+		err = verify()
+	}
+
+	writer.CloseWithError(err)
+
+	if cacheErr := <-cacheErr; cacheErr != nil {
+		return cacheErr
+	}
+
+	return err
+}
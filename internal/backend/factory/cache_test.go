@@ -0,0 +1,166 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package factory_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/factory"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func startImageFactory(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+
+		io.WriteString(w, "image "+r.URL.Path) //nolint:errcheck
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestHandlerCache(t *testing.T) { //nolint:paralleltest
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	var requests atomic.Int32
+
+	imageFactory := startImageFactory(t, &requests)
+
+	baseURL := config.Config.ImageFactoryBaseURL
+	config.Config.ImageFactoryBaseURL = imageFactory.URL
+
+	t.Cleanup(func() { config.Config.ImageFactoryBaseURL = baseURL })
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	media := omni.NewInstallationMedia(resources.EphemeralNamespace, "iso-metal-amd64")
+	media.TypedSpec().Value = &specs.InstallationMediaSpec{
+		Architecture:   "amd64",
+		Profile:        "metal",
+		ContentType:    "application/x-iso",
+		DestFilePrefix: "omni-metal-amd64",
+		Extension:      "iso",
+	}
+
+	require.NoError(t, st.Create(ctx, media))
+
+	handler := &factory.Handler{
+		State:  st,
+		Logger: zaptest.NewLogger(t),
+		Cache:  factory.NewDirCache(t.TempDir()),
+	}
+
+	get := func(method string) *httptest.ResponseRecorder {
+		req := httptest.NewRequestWithContext(ctx, method, "/image/schematic/1.8.0/iso-metal-amd64", nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := get(http.MethodGet)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "image /image/schematic/1.8.0/metal-amd64.iso", w.Body.String())
+	assert.EqualValues(t, 1, requests.Load())
+
+	// the image factory is not called anymore
+	imageFactory.Close()
+
+	w = get(http.MethodGet)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "image /image/schematic/1.8.0/metal-amd64.iso", w.Body.String())
+	assert.Equal(t, "attachment; filename=omni-metal-amd64-1.8.0.iso", w.Header().Get("Content-Disposition"))
+
+	w = get(http.MethodHead)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "44", w.Header().Get("Content-Length"))
+
+	assert.EqualValues(t, 1, requests.Load())
+}
+
+func TestPrewarmer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	var requests atomic.Int32
+
+	imageFactory := startImageFactory(t, &requests)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for id, version := range map[string]string{"machine-1": "1.8.0", "machine-2": "1.8.0", "machine-3": "1.7.6"} {
+		schematicConfiguration := omni.NewSchematicConfiguration(resources.DefaultNamespace, id)
+		schematicConfiguration.TypedSpec().Value.SchematicId = "schematic"
+		schematicConfiguration.TypedSpec().Value.TalosVersion = version
+
+		require.NoError(t, st.Create(ctx, schematicConfiguration))
+	}
+
+	cache := factory.NewDirCache(t.TempDir())
+
+	ctx, cancel = context.WithCancel(ctx)
+
+	prewarmer := &factory.Prewarmer{
+		State:    st,
+		Cache:    cache,
+		Logger:   zaptest.NewLogger(t),
+		BaseURL:  imageFactory.URL,
+		Images:   []string{"metal-amd64.iso", "installer-amd64.tar"},
+		Interval: time.Hour,
+	}
+
+	done := make(chan error, 1)
+
+	go func() { done <- prewarmer.Run(ctx) }()
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.EqualValues(collect, 4, requests.Load())
+	}, 4*time.Second, 10*time.Millisecond)
+
+	cancel()
+
+	require.NoError(t, <-done)
+
+	for _, key := range []string{
+		factory.CacheKey("schematic", "1.8.0", "metal-amd64.iso"),
+		factory.CacheKey("schematic", "v1.8.0", "installer-amd64.tar"),
+		factory.CacheKey("schematic", "1.7.6", "metal-amd64.iso"),
+		factory.CacheKey("schematic", "1.7.6", "installer-amd64.tar"),
+	} {
+		r, err := cache.Get(context.Background(), key)
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+
+		assert.Contains(t, string(data), "image /image/schematic/")
+	}
+}
@@ -7,12 +7,14 @@
 package factory
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -32,6 +34,9 @@ import (
 type Handler struct {
 	State  state.State
 	Logger *zap.Logger
+
+	// Cache keeps the generated images, the images are always proxied to the image factory if it's nil.
+	Cache Cache
 }
 
 func setContentHeaders(w http.ResponseWriter, contentType, filename string) {
@@ -84,6 +89,10 @@ func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if handler.Cache != nil && handler.serveCached(w, r, params) {
+		return
+	}
+
 	handler.Logger.Info("proxy request", zap.String("url", params.ProxyURL))
 
 	proxyReq, err := http.NewRequestWithContext(r.Context(), r.Method, params.ProxyURL, nil)
@@ -126,7 +135,59 @@ func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(resp.StatusCode)
 
-	io.Copy(w, resp.Body) //nolint:errcheck
+	if handler.Cache == nil || r.Method != http.MethodGet || resp.StatusCode != http.StatusOK {
+		io.Copy(w, resp.Body) //nolint:errcheck
+
+		return
+	}
+
+	handler.copyAndCache(r.Context(), w, resp.Body, params.CacheKey)
+}
+
+// serveCached serves the image from the cache, it returns false if the image is not cached.
+func (handler *Handler) serveCached(w http.ResponseWriter, r *http.Request, params *ProxyParams) bool {
+	cached, err := handler.Cache.Get(r.Context(), params.CacheKey)
+	if err != nil {
+		if !errors.Is(err, ErrNotCached) {
+			handler.Logger.Warn("failed to read the image cache", zap.String("key", params.CacheKey), zap.Error(err))
+		}
+
+		return false
+	}
+
+	defer cached.Close() //nolint:errcheck
+
+	handler.Logger.Info("serving cached image", zap.String("key", params.CacheKey))
+
+	setContentHeaders(w, params.ContentType, params.DestinationFilename)
+
+	// the local files support the range requests and HEAD requests with the content length
+	if seeker, ok := cached.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, params.DestinationFilename, time.Time{}, seeker)
+
+		return true
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodGet {
+		io.Copy(w, cached) //nolint:errcheck
+	}
+
+	return true
+}
+
+// copyAndCache streams the image to the client and stores it in the cache at the same time.
+//
+// The image is not cached if either the download or the response fails.
+func (handler *Handler) copyAndCache(ctx context.Context, w io.Writer, body io.Reader, key string) {
+	if err := copyAndCache(ctx, handler.Cache, key, w, body, nil); err != nil {
+		handler.Logger.Warn("failed to cache the image", zap.String("key", key), zap.Error(err))
+
+		return
+	}
+
+	handler.Logger.Info("cached image", zap.String("key", key))
 }
 
 var errNotFound = errors.New("not found")
@@ -134,6 +195,7 @@ var errNotFound = errors.New("not found")
 // ProxyParams is exposed for the unit tests.
 type ProxyParams struct {
 	ProxyURL            string
+	CacheKey            string
 	ContentType         string
 	DestinationFilename string
 }
@@ -191,6 +253,7 @@ func parseRequest(r *http.Request, st state.State) (*ProxyParams, error) {
 	proxyURL = proxyURL.JoinPath(segments...)
 
 	p.ProxyURL = proxyURL.String()
+	p.CacheKey = CacheKey(segments[1], segments[2], filename)
 
 	return p, nil
 }
@@ -57,6 +57,7 @@ func TestParseRequest(t *testing.T) {
 			incomingURL: "/image/schematic/1.6.0/iso-metal-arm64?secureboot=true",
 			expectedParams: &factory.ProxyParams{
 				ProxyURL:            "https://factory.talos.dev/image/schematic/1.6.0/metal-arm64-secureboot.iso",
+				CacheKey:            "image/schematic/1.6.0/metal-arm64-secureboot.iso",
 				ContentType:         "application/x-iso",
 				DestinationFilename: "omni-metal-arm64-1.6.0-secureboot.iso",
 			},
@@ -66,6 +67,7 @@ func TestParseRequest(t *testing.T) {
 			incomingURL: "/image/schematic/1.6.0/iso-metal-arm64",
 			expectedParams: &factory.ProxyParams{
 				ProxyURL:            "https://factory.talos.dev/image/schematic/1.6.0/metal-arm64.iso",
+				CacheKey:            "image/schematic/1.6.0/metal-arm64.iso",
 				ContentType:         "application/x-iso",
 				DestinationFilename: "omni-metal-arm64-1.6.0.iso",
 			},
@@ -0,0 +1,158 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// downloadTimeout limits the download of a single image from the image factory, it fits the largest installation media.
+const downloadTimeout = 30 * time.Minute
+
+// downloadClient downloads the images from the image factory to the cache.
+var downloadClient = &http.Client{
+	Timeout: downloadTimeout,
+}
+
+// Prewarmer downloads the images of the schematics in use by the SchematicConfiguration resources into the cache,
+// so that they are available when the image factory is not reachable.
+type Prewarmer struct {
+	State  state.State
+	Cache  Cache
+	Logger *zap.Logger
+
+	// BaseURL is the image factory base URL.
+	BaseURL string
+
+	// Registry caches the installer images of each schematic if it's set.
+	Registry *Registry
+
+	// Images are the image factory file names to cache for each schematic, e.g. metal-amd64.iso or installer-amd64.tar.
+	Images []string
+
+	Interval time.Duration
+}
+
+// Run the prewarmer until the context is canceled.
+func (p *Prewarmer) Run(ctx context.Context) error {
+	if p.Cache == nil || (len(p.Images) == 0 && p.Registry == nil) || p.Interval == 0 {
+		<-ctx.Done()
+
+		return nil
+	}
+
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.prewarm(ctx); err != nil {
+			p.Logger.Warn("failed to prewarm the image cache", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Prewarmer) prewarm(ctx context.Context) error {
+	schematicConfigurations, err := safe.ReaderListAll[*omni.SchematicConfiguration](ctx, p.State)
+	if err != nil {
+		return err
+	}
+
+	type schematicVersion struct {
+		schematicID  string
+		talosVersion string
+	}
+
+	seen := map[schematicVersion]struct{}{}
+
+	var errs error
+
+	for schematicConfiguration := range schematicConfigurations.All() {
+		key := schematicVersion{
+			schematicID:  schematicConfiguration.TypedSpec().Value.SchematicId,
+			talosVersion: schematicConfiguration.TypedSpec().Value.TalosVersion,
+		}
+
+		if key.schematicID == "" || key.talosVersion == "" {
+			continue
+		}
+
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+
+		for _, image := range p.Images {
+			errs = errors.Join(errs, p.fetch(ctx, key.schematicID, key.talosVersion, image))
+		}
+
+		if p.Registry != nil {
+			errs = errors.Join(errs, p.Registry.Prewarm(ctx, "installer/"+key.schematicID, "v"+strings.TrimPrefix(key.talosVersion, "v")))
+		}
+	}
+
+	return errs
+}
+
+func (p *Prewarmer) fetch(ctx context.Context, schematicID, talosVersion, image string) error {
+	cacheKey := CacheKey(schematicID, talosVersion, image)
+
+	cached, err := p.Cache.Get(ctx, cacheKey)
+	if err == nil {
+		return cached.Close()
+	}
+
+	if !errors.Is(err, ErrNotCached) {
+		return err
+	}
+
+	imageURL, err := url.JoinPath(p.BaseURL, "image", schematicID, talosVersion, image)
+	if err != nil {
+		return err
+	}
+
+	p.Logger.Info("prewarming image cache", zap.String("url", imageURL))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := downloadClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download %q: %w", imageURL, err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %q: unexpected status %s", imageURL, resp.Status)
+	}
+
+	if err = p.Cache.Put(ctx, cacheKey, resp.Body); err != nil {
+		return fmt.Errorf("failed to cache %q: %w", imageURL, err)
+	}
+
+	return nil
+}
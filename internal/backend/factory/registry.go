@@ -0,0 +1,447 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package factory
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// registryPrefix is the cache key prefix of the installer images served by the registry.
+	registryPrefix = "registry"
+
+	// maxManifestSize limits the size of the image manifests fetched from the image factory.
+	maxManifestSize = 4 * 1024 * 1024
+
+	contentTypeOCIIndex    = "application/vnd.oci.image.index.v1+json"
+	contentTypeOCIManifest = "application/vnd.oci.image.manifest.v1+json"
+)
+
+// manifestMediaTypes are accepted from the image factory registry.
+var manifestMediaTypes = []string{
+	contentTypeOCIIndex,
+	contentTypeOCIManifest,
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+var (
+	// installerRepository matches the installer images generated by the image factory for a schematic.
+	installerRepository = regexp.MustCompile(`^installer(-secureboot)?/[0-9a-f]{64}$`)
+	tagReference        = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+	digestReference     = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+	errUnknown = errors.New("unknown to the image factory registry")
+)
+
+// Registry serves the installer images generated by the image factory as a read-only OCI registry.
+//
+// It is a pull-through cache: the images are fetched from the image factory registry once and served from the cache afterwards,
+// so the machines can be installed and upgraded when the image factory is not reachable.
+// The blobs are content-addressed, so the layers shared by the installers of the different schematics are cached once.
+type Registry struct {
+	Cache  Cache
+	Logger *zap.Logger
+
+	// BaseURL is the image factory base URL, the image factory serves the registry on the same host.
+	BaseURL string
+}
+
+// ServeHTTP implements http.Handler.
+func (reg *Registry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+
+	// API version check
+	if r.URL.Path == "/v2/" || r.URL.Path == "/v2" {
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	repository, kind, reference, ok := parseRegistryPath(r.URL.Path)
+	if !ok || !installerRepository.MatchString(repository) {
+		registryError(w, http.StatusNotFound, "NAME_UNKNOWN", "repository is not served")
+
+		return
+	}
+
+	switch kind {
+	case "manifests":
+		reg.serveManifest(w, r, repository, reference)
+	case "blobs":
+		reg.serveBlob(w, r, repository, reference)
+	}
+}
+
+func (reg *Registry) serveManifest(w http.ResponseWriter, r *http.Request, repository, reference string) {
+	if !tagReference.MatchString(reference) && !digestReference.MatchString(reference) {
+		registryError(w, http.StatusBadRequest, "MANIFEST_INVALID", "invalid manifest reference")
+
+		return
+	}
+
+	data, err := reg.manifest(r.Context(), repository, reference)
+	if err != nil {
+		if errors.Is(err, errUnknown) {
+			registryError(w, http.StatusNotFound, "MANIFEST_UNKNOWN", err.Error())
+
+			return
+		}
+
+		reg.Logger.Warn("failed to get the installer manifest", zap.String("repository", repository), zap.String("reference", reference), zap.Error(err))
+
+		registryError(w, http.StatusBadGateway, "UNAVAILABLE", "failed to get the manifest")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", manifestMediaType(data))
+	w.Header().Set("Docker-Content-Digest", digestOf(data))
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodGet {
+		w.Write(data) //nolint:errcheck
+	}
+}
+
+func (reg *Registry) serveBlob(w http.ResponseWriter, r *http.Request, repository, digest string) {
+	if !digestReference.MatchString(digest) {
+		registryError(w, http.StatusBadRequest, "DIGEST_INVALID", "invalid blob digest")
+
+		return
+	}
+
+	w.Header().Set("Docker-Content-Digest", digest)
+
+	cached, err := reg.Cache.Get(r.Context(), blobKey(digest))
+	if err == nil {
+		defer cached.Close() //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+
+		// the local files support the range requests and HEAD requests with the content length
+		if seeker, ok := cached.(io.ReadSeeker); ok {
+			http.ServeContent(w, r, "", time.Time{}, seeker)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+
+		if r.Method == http.MethodGet {
+			io.Copy(w, cached) //nolint:errcheck
+		}
+
+		return
+	}
+
+	if !errors.Is(err, ErrNotCached) {
+		reg.Logger.Warn("failed to read the image cache", zap.String("digest", digest), zap.Error(err))
+	}
+
+	resp, err := reg.fetch(r.Context(), r.Method, repository, "blobs", digest)
+	if err != nil {
+		if errors.Is(err, errUnknown) {
+			registryError(w, http.StatusNotFound, "BLOB_UNKNOWN", err.Error())
+
+			return
+		}
+
+		reg.Logger.Warn("failed to fetch the installer blob", zap.String("repository", repository), zap.String("digest", digest), zap.Error(err))
+
+		registryError(w, http.StatusBadGateway, "UNAVAILABLE", "failed to fetch the blob")
+
+		return
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+
+	if resp.ContentLength >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(resp.ContentLength, 10))
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method != http.MethodGet {
+		return
+	}
+
+	if err = reg.copyAndCacheBlob(r.Context(), w, resp.Body, digest); err != nil {
+		reg.Logger.Warn("failed to cache the installer blob", zap.String("digest", digest), zap.Error(err))
+	}
+}
+
+// Prewarm caches the installer image with all its platforms.
+func (reg *Registry) Prewarm(ctx context.Context, repository, tag string) error {
+	data, err := reg.manifest(ctx, repository, tag)
+	if err != nil {
+		return err
+	}
+
+	var index imageManifest
+
+	if err = json.Unmarshal(data, &index); err != nil {
+		return fmt.Errorf("failed to decode the manifest of %s:%s: %w", repository, tag, err)
+	}
+
+	manifests := []imageManifest{index}
+
+	if len(index.Manifests) > 0 {
+		manifests = manifests[:0]
+
+		for _, platform := range index.Manifests {
+			if !digestReference.MatchString(platform.Digest) {
+				return fmt.Errorf("invalid manifest digest %q", platform.Digest)
+			}
+
+			data, err = reg.manifest(ctx, repository, platform.Digest)
+			if err != nil {
+				return err
+			}
+
+			var manifest imageManifest
+
+			if err = json.Unmarshal(data, &manifest); err != nil {
+				return fmt.Errorf("failed to decode the manifest %s of %s: %w", platform.Digest, repository, err)
+			}
+
+			manifests = append(manifests, manifest)
+		}
+	}
+
+	for _, manifest := range manifests {
+		for _, blob := range manifest.blobs() {
+			if err = reg.prewarmBlob(ctx, repository, blob.Digest); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (reg *Registry) prewarmBlob(ctx context.Context, repository, digest string) error {
+	if !digestReference.MatchString(digest) {
+		return fmt.Errorf("invalid blob digest %q", digest)
+	}
+
+	cached, err := reg.Cache.Get(ctx, blobKey(digest))
+	if err == nil {
+		return cached.Close()
+	}
+
+	if !errors.Is(err, ErrNotCached) {
+		return err
+	}
+
+	resp, err := reg.fetch(ctx, http.MethodGet, repository, "blobs", digest)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	return reg.copyAndCacheBlob(ctx, io.Discard, resp.Body, digest)
+}
+
+// manifest returns the manifest from the cache, or fetches and caches it.
+func (reg *Registry) manifest(ctx context.Context, repository, reference string) ([]byte, error) {
+	key := manifestKey(repository, reference)
+
+	cached, err := reg.Cache.Get(ctx, key)
+	if err == nil {
+		defer cached.Close() //nolint:errcheck
+
+		return io.ReadAll(io.LimitReader(cached, maxManifestSize))
+	}
+
+	if !errors.Is(err, ErrNotCached) {
+		reg.Logger.Warn("failed to read the image cache", zap.String("key", key), zap.Error(err))
+	}
+
+	resp, err := reg.fetch(ctx, http.MethodGet, repository, "manifests", reference)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxManifestSize {
+		return nil, fmt.Errorf("manifest is larger than %d bytes", maxManifestSize)
+	}
+
+	digest := digestOf(data)
+
+	if digestReference.MatchString(reference) && reference != digest {
+		return nil, fmt.Errorf("manifest digest mismatch: expected %s, got %s", reference, digest)
+	}
+
+	if err = reg.Cache.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		reg.Logger.Warn("failed to cache the installer manifest", zap.String("key", key), zap.Error(err))
+	}
+
+	return data, nil
+}
+
+func (reg *Registry) fetch(ctx context.Context, method, repository, kind, reference string) (*http.Response, error) {
+	baseURL := strings.TrimSuffix(reg.BaseURL, "/")
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+"/v2/"+repository+"/"+kind+"/"+reference, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if kind == "manifests" {
+		req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	}
+
+	resp, err := downloadClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp, nil
+	case http.StatusNotFound:
+		resp.Body.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("%s %s@%s is %w", strings.TrimSuffix(kind, "s"), repository, reference, errUnknown)
+	default:
+		resp.Body.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+}
+
+// copyAndCacheBlob streams the blob and caches it if its digest matches.
+func (reg *Registry) copyAndCacheBlob(ctx context.Context, w io.Writer, body io.Reader, digest string) error {
+	hasher := sha256.New()
+
+	return copyAndCache(ctx, reg.Cache, blobKey(digest), w, io.TeeReader(body, hasher), func() error {
+		if actual := hashDigest(hasher); actual != digest {
+			return fmt.Errorf("blob digest mismatch: expected %s, got %s", digest, actual)
+		}
+
+		return nil
+	})
+}
+
+type descriptor struct {
+	Digest string `json:"digest"`
+}
+
+type imageManifest struct {
+	Config    *descriptor  `json:"config"`
+	MediaType string       `json:"mediaType"`
+	Manifests []descriptor `json:"manifests"`
+	Layers    []descriptor `json:"layers"`
+}
+
+func (m imageManifest) blobs() []descriptor {
+	if m.Config == nil {
+		return m.Layers
+	}
+
+	return append([]descriptor{*m.Config}, m.Layers...)
+}
+
+// parseRegistryPath parses the /v2/<repository>/<manifests|blobs>/<reference> path.
+func parseRegistryPath(p string) (repository, kind, reference string, ok bool) {
+	p, ok = strings.CutPrefix(p, "/v2/")
+	if !ok {
+		return "", "", "", false
+	}
+
+	for _, kind = range []string{"manifests", "blobs"} {
+		if idx := strings.LastIndex(p, "/"+kind+"/"); idx > 0 {
+			repository, reference = p[:idx], p[idx+len(kind)+2:]
+
+			return repository, kind, reference, reference != ""
+		}
+	}
+
+	return "", "", "", false
+}
+
+// manifestKey returns the cache key of the manifest.
+//
+// The manifests referenced by the digest are shared by all repositories, the tags are kept per repository.
+func manifestKey(repository, reference string) string {
+	if digestReference.MatchString(reference) {
+		return path.Join(registryPrefix, "manifests", reference)
+	}
+
+	return path.Join(registryPrefix, repository, "tags", reference)
+}
+
+func blobKey(digest string) string {
+	return path.Join(registryPrefix, "blobs", digest)
+}
+
+func manifestMediaType(data []byte) string {
+	var manifest imageManifest
+
+	switch {
+	case json.Unmarshal(data, &manifest) != nil:
+		return contentTypeOCIManifest
+	case manifest.MediaType != "":
+		return manifest.MediaType
+	case len(manifest.Manifests) > 0:
+		return contentTypeOCIIndex
+	default:
+		return contentTypeOCIManifest
+	}
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func hashDigest(hasher hash.Hash) string {
+	return "sha256:" + hex.EncodeToString(hasher.Sum(nil))
+}
+
+func registryError(w http.ResponseWriter, statusCode int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	json.NewEncoder(w).Encode(map[string]any{ //nolint:errcheck
+		"errors": []map[string]string{{"code": code, "message": message}},
+	})
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package factory_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/factory"
+)
+
+const schematicID = "376567988ad370138ad8b2698212367b8edcb69b5fd68c80be1f2ec7d603b4ba"
+
+func digest(data string) string {
+	sum := sha256.Sum256([]byte(data))
+
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// startRegistry starts the registry serving the installer index with a single platform manifest.
+func startRegistry(t *testing.T, requests *atomic.Int32, blobs map[string]string) (*httptest.Server, string) {
+	for _, data := range []string{"config", "layer"} {
+		blobs[digest(data)] = data
+	}
+
+	manifest, err := json.Marshal(map[string]any{
+		"mediaType": "application/vnd.oci.image.manifest.v1+json",
+		"config":    map[string]string{"digest": digest("config")},
+		"layers":    []map[string]string{{"digest": digest("layer")}},
+	})
+	require.NoError(t, err)
+
+	index, err := json.Marshal(map[string]any{
+		"mediaType": "application/vnd.oci.image.index.v1+json",
+		"manifests": []map[string]string{{"digest": digest(string(manifest))}},
+	})
+	require.NoError(t, err)
+
+	manifests := map[string][]byte{
+		"v1.8.0":                 index,
+		digest(string(manifest)): manifest,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		prefix := "/v2/installer/" + schematicID + "/"
+
+		switch {
+		case strings.HasPrefix(r.URL.Path, prefix+"manifests/"):
+			data, ok := manifests[strings.TrimPrefix(r.URL.Path, prefix+"manifests/")]
+			if !ok {
+				http.NotFound(w, r)
+
+				return
+			}
+
+			w.Write(data) //nolint:errcheck
+		case strings.HasPrefix(r.URL.Path, prefix+"blobs/"):
+			data, ok := blobs[strings.TrimPrefix(r.URL.Path, prefix+"blobs/")]
+			if !ok {
+				http.NotFound(w, r)
+
+				return
+			}
+
+			w.Write([]byte(data)) //nolint:errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return srv, digest(string(manifest))
+}
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	var requests atomic.Int32
+
+	upstream, manifestDigest := startRegistry(t, &requests, map[string]string{
+		// the blob doesn't match its digest
+		digest("tampered"): "evil",
+	})
+
+	registry := &factory.Registry{
+		Cache:   factory.NewDirCache(t.TempDir()),
+		Logger:  zaptest.NewLogger(t),
+		BaseURL: upstream.URL,
+	}
+
+	get := func(method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequestWithContext(ctx, method, path, nil)
+		w := httptest.NewRecorder()
+
+		registry.ServeHTTP(w, req)
+
+		return w
+	}
+
+	repository := "/v2/installer/" + schematicID
+
+	pull := func() {
+		w := get(http.MethodGet, repository+"/manifests/v1.8.0")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/vnd.oci.image.index.v1+json", w.Header().Get("Content-Type"))
+		assert.Equal(t, digest(w.Body.String()), w.Header().Get("Docker-Content-Digest"))
+
+		w = get(http.MethodHead, repository+"/manifests/"+manifestDigest)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/vnd.oci.image.manifest.v1+json", w.Header().Get("Content-Type"))
+		assert.Equal(t, manifestDigest, w.Header().Get("Docker-Content-Digest"))
+
+		w = get(http.MethodGet, repository+"/blobs/"+digest("layer"))
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "layer", w.Body.String())
+	}
+
+	pull()
+
+	w := get(http.MethodGet, repository+"/blobs/"+digest("tampered"))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// the images are served from the cache when the image factory is not reachable
+	upstream.Close()
+
+	pull()
+
+	// the blob which doesn't match its digest is not cached
+	w = get(http.MethodGet, repository+"/blobs/"+digest("tampered"))
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+
+	w = get(http.MethodGet, "/v2/library/alpine/manifests/latest")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = get(http.MethodGet, "/v2/")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "registry/2.0", w.Header().Get("Docker-Distribution-API-Version"))
+}
+
+func TestRegistryPrewarm(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	var requests atomic.Int32
+
+	upstream, _ := startRegistry(t, &requests, map[string]string{})
+
+	cache := factory.NewDirCache(t.TempDir())
+
+	registry := &factory.Registry{
+		Cache:   cache,
+		Logger:  zaptest.NewLogger(t),
+		BaseURL: upstream.URL,
+	}
+
+	require.NoError(t, registry.Prewarm(ctx, "installer/"+schematicID, "v1.8.0"))
+
+	// index, platform manifest, config and layer
+	assert.EqualValues(t, 4, requests.Load())
+
+	require.NoError(t, registry.Prewarm(ctx, "installer/"+schematicID, "v1.8.0"))
+
+	assert.EqualValues(t, 4, requests.Load())
+
+	// nothing is evicted, as the images were just used
+	require.NoError(t, cache.Evict(ctx, time.Now().Add(-time.Minute)))
+
+	require.NoError(t, registry.Prewarm(ctx, "installer/"+schematicID, "v1.8.0"))
+
+	assert.EqualValues(t, 4, requests.Load())
+
+	require.NoError(t, cache.Evict(ctx, time.Now().Add(time.Minute)))
+
+	require.NoError(t, registry.Prewarm(ctx, "installer/"+schematicID, "v1.8.0"))
+
+	assert.EqualValues(t, 8, requests.Load())
+}
@@ -178,6 +178,14 @@ func TestGenerateConfigs(t *testing.T) {
 }
 
 func runServer(t *testing.T, st state.State, opts ...grpc.ServerOption) string {
+	return runManagementServer(t, grpcomni.NewManagementServer(
+		st,
+		nil,
+		zaptest.NewLogger(t),
+	), opts...)
+}
+
+func runManagementServer(t *testing.T, managementServer management.ManagementServiceServer, opts ...grpc.ServerOption) string {
 	var err error
 
 	listener, err := net.Listen("tcp", "localhost:0")
@@ -185,8 +193,6 @@ func runServer(t *testing.T, st state.State, opts ...grpc.ServerOption) string {
 
 	grpcAddress := fmt.Sprintf("grpc://%s", listener.Addr())
 
-	logger := zaptest.NewLogger(t)
-
 	opts = append(opts,
 		grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 			md, ok := metadata.FromIncomingContext(ctx)
@@ -217,11 +223,7 @@ func runServer(t *testing.T, st state.State, opts ...grpc.ServerOption) string {
 
 	server := grpc.NewServer(opts...)
 
-	management.RegisterManagementServiceServer(server, grpcomni.NewManagementServer(
-		st,
-		nil,
-		logger,
-	))
+	management.RegisterManagementServiceServer(server, managementServer)
 
 	var eg errgroup.Group
 
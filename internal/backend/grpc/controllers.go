@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/pkg/controllerstatus"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// ListControllerStatuses returns the reconcile statuses of the Omni controllers.
+func (s *managementServer) ListControllerStatuses(ctx context.Context, _ *emptypb.Empty) (*management.ListControllerStatusesResponse, error) {
+	if _, err := auth.CheckGRPC(ctx, auth.WithRole(role.Admin)); err != nil {
+		return nil, err
+	}
+
+	type controllerStatuses interface {
+		ControllerStatuses() []controllerstatus.Status
+	}
+
+	omniRuntime, err := runtime.LookupInterface[controllerStatuses](omni.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := omniRuntime.ControllerStatuses()
+
+	resp := &management.ListControllerStatusesResponse{
+		Controllers: make([]*management.ControllerStatus, 0, len(statuses)),
+	}
+
+	for _, st := range statuses {
+		resp.Controllers = append(resp.Controllers, &management.ControllerStatus{
+			Name:          st.Name,
+			Kind:          string(st.Kind),
+			Health:        string(st.Health),
+			Reconciles:    st.Reconciles,
+			Errors:        st.Errors,
+			QueueDepth:    st.QueueDepth,
+			Busy:          int32(st.Busy),
+			BusySince:     optionalTimestamp(st.BusySince),
+			LastSuccess:   optionalTimestamp(st.LastSuccess),
+			LastError:     st.LastError,
+			LastErrorTime: optionalTimestamp(st.LastErrorTime),
+		})
+	}
+
+	return resp, nil
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
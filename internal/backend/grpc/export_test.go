@@ -23,6 +23,10 @@ func NewManagementServer(st state.State, imageFactoryClient *imagefactory.Client
 	}
 }
 
+func (s *ManagementServer) SetWorkloadProxyDialer(dialer WorkloadProxyDialer) {
+	s.workloadProxyDialer = dialer
+}
+
 func GenerateDest(apiurl string) (string, error) {
 	return generateDest(apiurl)
 }
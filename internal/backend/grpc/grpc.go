@@ -28,6 +28,8 @@ import (
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
 	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/monitoring"
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
+	"github.com/siderolabs/omni/internal/backend/supportbundle"
 	"github.com/siderolabs/omni/internal/memconn"
 	"github.com/siderolabs/omni/internal/pkg/compress"
 	"github.com/siderolabs/omni/internal/pkg/config"
@@ -49,8 +51,11 @@ func MakeServiceServers(
 	jwtSigningKeyProvider JWTSigningKeyProvider,
 	dnsService *dns.Service,
 	imageFactoryClient *imagefactory.Client,
+	workloadProxyDialer WorkloadProxyDialer,
 	logger *zap.Logger,
 	auditor AuditLogger,
+	sessionRecorder *sessionrecording.Recorder,
+	supportBundleBuilder *supportbundle.Builder,
 ) iter.Seq2[ServiceServer, error] {
 	dest, err := generateDest(config.Config.APIURL)
 	if err != nil {
@@ -71,7 +76,10 @@ func MakeServiceServers(
 			logger:                logger.With(logging.Component("management_server")),
 			dnsService:            dnsService,
 			imageFactoryClient:    imageFactoryClient,
+			workloadProxyDialer:   workloadProxyDialer,
 			auditor:               auditor,
+			sessionRecorder:       sessionRecorder,
+			supportBundleBuilder:  supportBundleBuilder,
 			omniconfigDest:        dest,
 		},
 		&authServer{
@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/machineexpr"
+)
+
+// PreviewMachineClassMatches returns the machines matching the machine class selectors before the machine class is saved.
+func (s *managementServer) PreviewMachineClassMatches(ctx context.Context, req *management.PreviewMachineClassMatchesRequest) (*management.PreviewMachineClassMatchesResponse, error) {
+	if _, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader)); err != nil {
+		return nil, err
+	}
+
+	selectors, err := labels.ParseSelectors(req.MatchLabels)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse match labels: %v", err)
+	}
+
+	var expression *machineexpr.Expression
+
+	if req.MatchExpression != "" {
+		if expression, err = machineexpr.Compile(req.MatchExpression); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to parse match expression: %v", err)
+		}
+	}
+
+	if len(selectors) == 0 && expression == nil {
+		return &management.PreviewMachineClassMatchesResponse{}, nil
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineStatuses, err := safe.StateListAll[*omni.MachineStatus](ctx, s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &management.PreviewMachineClassMatchesResponse{}
+
+	for machineStatus := range machineStatuses.All() {
+		machineLabels := *machineStatus.Metadata().Labels()
+
+		if _, noManualAllocation := machineLabels.Get(omni.LabelNoManualAllocation); noManualAllocation {
+			continue
+		}
+
+		if !selectors.Matches(machineLabels) {
+			continue
+		}
+
+		if expression != nil {
+			if matched, matchErr := expression.Match(machineStatus); matchErr != nil || !matched {
+				continue
+			}
+		}
+
+		resp.MachineIds = append(resp.MachineIds, machineStatus.Metadata().ID())
+	}
+
+	return resp, nil
+}
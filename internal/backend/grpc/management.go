@@ -22,6 +22,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-kubernetes/kubernetes/manifests"
 	"github.com/siderolabs/go-kubernetes/kubernetes/upgrade"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
@@ -49,12 +50,15 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
 	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
+	"github.com/siderolabs/omni/internal/backend/supportbundle"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+	"github.com/siderolabs/omni/internal/pkg/kubernetes/deprecatedapi"
 	"github.com/siderolabs/omni/internal/pkg/siderolink"
 	"github.com/siderolabs/omni/internal/pkg/xcontext"
 )
@@ -79,12 +83,15 @@ type managementServer struct {
 	omniState             state.State
 	jwtSigningKeyProvider JWTSigningKeyProvider
 
-	logHandler         *siderolink.LogHandler
-	logger             *zap.Logger
-	dnsService         *dns.Service
-	imageFactoryClient *imagefactory.Client
-	auditor            AuditLogger
-	omniconfigDest     string
+	logHandler           *siderolink.LogHandler
+	logger               *zap.Logger
+	dnsService           *dns.Service
+	imageFactoryClient   *imagefactory.Client
+	workloadProxyDialer  WorkloadProxyDialer
+	auditor              AuditLogger
+	sessionRecorder      *sessionrecording.Recorder
+	supportBundleBuilder *supportbundle.Builder
+	omniconfigDest       string
 }
 
 func (s *managementServer) register(server grpc.ServiceRegistrar) {
@@ -116,6 +123,10 @@ func (s *managementServer) Kubeconfig(ctx context.Context, req *management.Kubec
 		return s.serviceAccountKubeconfig(ctx, req)
 	}
 
+	if len(req.GetServiceAccountNamespaces()) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "namespaces can be set only for the service account kubeconfig")
+	}
+
 	// not a service account, generate OIDC (user) or admin kubeconfig
 
 	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader))
@@ -263,7 +274,12 @@ func (s *managementServer) ValidateConfig(ctx context.Context, request *manageme
 		return nil, err
 	}
 
-	if err := omnires.ValidateConfigPatch([]byte(request.Config)); err != nil {
+	validate := omnires.ValidateConfigPatch
+	if request.Template {
+		validate = omnires.ValidateConfigPatchTemplate
+	}
+
+	if err := validate([]byte(request.Config)); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -482,11 +498,72 @@ func (s *managementServer) KubernetesUpgradePreChecks(ctx context.Context, req *
 		}, nil
 	}
 
+	usages, err := checkDeprecatedAPIs(ctx, path, restConfig, &logBuffer)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(usages) > 0 && !req.IgnoreDeprecatedApis {
+		fmt.Fprintf(&logBuffer, "pre-checks failed: %d objects use the API versions removed in %s, migrate them or ignore the deprecated APIs to upgrade anyway\n",
+			len(usages), path.ToVersion())
+
+		return &management.KubernetesUpgradePreChecksResponse{
+			Ok:                  false,
+			Reason:              logBuffer.String(),
+			DeprecatedApiUsages: usages,
+		}, nil
+	}
+
 	s.logger.Debug("k8s upgrade pre-checks successful", zap.String("log", logBuffer.String()), zap.String("cluster", requestContext.Name))
 
-	return &management.KubernetesUpgradePreChecksResponse{
-		Ok: true,
-	}, nil
+	response := &management.KubernetesUpgradePreChecksResponse{
+		Ok:                  true,
+		DeprecatedApiUsages: usages,
+	}
+
+	// the usages are reported as warnings if the deprecated APIs are ignored
+	if len(usages) > 0 {
+		response.Reason = logBuffer.String()
+	}
+
+	return response, nil
+}
+
+func checkDeprecatedAPIs(ctx context.Context, path *upgrade.Path, restConfig *rest.Config, logBuffer *strings.Builder) ([]*management.KubernetesUpgradePreChecksResponse_DeprecatedAPIUsage, error) {
+	removals, err := deprecatedapi.ForUpgrade(path.FromVersion(), path.ToVersion())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid upgrade path: %v", err)
+	}
+
+	if len(removals) == 0 {
+		return nil, nil
+	}
+
+	fmt.Fprintln(logBuffer, "checking for objects using removed Kubernetes API versions")
+
+	scanner, err := deprecatedapi.NewScanner(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := scanner.Scan(ctx, removals)
+	if err != nil {
+		return nil, fmt.Errorf("error checking for removed Kubernetes API versions: %w", err)
+	}
+
+	return xslices.Map(usages, func(usage deprecatedapi.Usage) *management.KubernetesUpgradePreChecksResponse_DeprecatedAPIUsage {
+		fmt.Fprintln(logBuffer, usage.String())
+
+		return &management.KubernetesUpgradePreChecksResponse_DeprecatedAPIUsage{
+			ApiVersion:  usage.Removal.APIVersion(),
+			Kind:        usage.Removal.Kind,
+			Namespace:   usage.Namespace,
+			Name:        usage.Name,
+			RemovedIn:   usage.Removal.RemovedIn,
+			Replacement: usage.Removal.Replacement,
+			HelmRelease: usage.HelmRelease,
+		}
+	}), nil
 }
 
 //nolint:gocognit,gocyclo,cyclop
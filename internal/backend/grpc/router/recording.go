@@ -0,0 +1,138 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package router
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/grpc-proxy/proxy"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+	"github.com/siderolabs/omni/internal/pkg/grpcutil"
+)
+
+// mutatingMethodSet is the set of Talos API methods which change the state of the machines, their sessions are recorded.
+var mutatingMethodSet = xslices.ToSet(xslices.Map([]string{
+	"ApplyConfiguration",
+	"Bootstrap",
+	"EtcdAlarmDisarm",
+	"EtcdDefragment",
+	"EtcdForfeitLeadership",
+	"EtcdLeaveCluster",
+	"EtcdRecover",
+	"EtcdRemoveMemberByID",
+	"ImagePull",
+	"MetaDelete",
+	"MetaWrite",
+	"Reboot",
+	"Reset",
+	"Restart",
+	"Rollback",
+	"ServiceRestart",
+	"ServiceStart",
+	"ServiceStop",
+	"Shutdown",
+	"Upgrade",
+}, func(method string) string {
+	return grpcutil.MustFullMethodName(&machine.MachineService_ServiceDesc, method)
+}))
+
+// recordingStreamInterceptor records the request and response payloads of the mutating Talos API calls of the cluster.
+func recordingStreamInterceptor(recorder *sessionrecording.Recorder, clusterName string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if _, ok := mutatingMethodSet[method]; !ok {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		md := sessionrecording.Metadata{
+			Type:    sessionrecording.TypeTalos,
+			Cluster: clusterName,
+			Method:  strings.TrimLeft(method, "/"),
+		}
+
+		if identity, ok := ctxstore.Value[auth.IdentityContextKey](ctx); ok {
+			md.Identity = identity.Identity
+		}
+
+		if outgoingMD, ok := metadata.FromOutgoingContext(ctx); ok {
+			md.Nodes = getNodeID(outgoingMD)
+		}
+
+		session, err := recorder.Start(md)
+		if err != nil {
+			// the call is not allowed if it can't be recorded
+			return nil, status.Errorf(codes.Unavailable, "failed to start session recording: %v", err)
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, append(opts, grpc.ForceCodecV2(&recordingCodec{
+			CodecV2: proxy.Codec(),
+			session: session,
+		}))...)
+		if err != nil {
+			session.Close(err) //nolint:errcheck
+
+			return nil, err
+		}
+
+		return &recordedClientStream{
+			ClientStream: stream,
+			session:      session,
+		}, nil
+	}
+}
+
+// recordingCodec records the raw message frames passing through the proxy codec.
+type recordingCodec struct {
+	encoding.CodecV2
+
+	session *sessionrecording.Session
+}
+
+func (c *recordingCodec) Marshal(v any) (mem.BufferSlice, error) {
+	data, err := c.CodecV2.Marshal(v)
+	if err == nil {
+		c.session.Record(sessionrecording.DirectionRequest, data.Materialize())
+	}
+
+	return data, err
+}
+
+func (c *recordingCodec) Unmarshal(data mem.BufferSlice, v any) error {
+	c.session.Record(sessionrecording.DirectionResponse, data.Materialize())
+
+	return c.CodecV2.Unmarshal(data, v)
+}
+
+// recordedClientStream finishes the session recording when the stream is done.
+type recordedClientStream struct {
+	grpc.ClientStream
+
+	session   *sessionrecording.Session
+	closeOnce sync.Once
+}
+
+func (s *recordedClientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.closeOnce.Do(func() {
+			s.session.Close(err) //nolint:errcheck
+		})
+	}
+
+	return err
+}
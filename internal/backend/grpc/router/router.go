@@ -31,6 +31,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/client/resolver"
 	talosconstants "github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/role"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc"
@@ -46,6 +47,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/dns"
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
 	"github.com/siderolabs/omni/internal/memconn"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/certs"
@@ -58,7 +60,7 @@ const (
 
 // TalosAuditor is an interface for auditing Talos access.
 type TalosAuditor interface {
-	AuditTalosAccess(context.Context, string, string, string) error
+	AuditTalosAccess(ctx context.Context, fullMethodName, clusterID, nodeID, denyReason string) error
 }
 
 // Router wraps grpc-proxy StreamDirector.
@@ -68,12 +70,13 @@ type Router struct {
 	metricCacheSize, metricActiveClients prometheus.Gauge
 	metricCacheHits, metricCacheMisses   prometheus.Counter
 
-	omniBackend  proxy.Backend
-	nodeResolver NodeResolver
-	verifier     grpc.UnaryServerInterceptor
-	cosiState    state.State
-	talosAuditor TalosAuditor
-	authEnabled  bool
+	omniBackend     proxy.Backend
+	nodeResolver    NodeResolver
+	verifier        grpc.UnaryServerInterceptor
+	cosiState       state.State
+	talosAPIPolicy  TalosAPIPolicy
+	sessionRecorder *sessionrecording.Recorder
+	authEnabled     bool
 }
 
 // NewRouter builds new Router.
@@ -84,12 +87,14 @@ func NewRouter(
 	authEnabled bool,
 	talosAuditor TalosAuditor,
 	verifier grpc.UnaryServerInterceptor,
+	sessionRecorder *sessionrecording.Recorder,
 ) (*Router, error) {
 	omniConn, err := grpc.NewClient(transport.Address(),
 		grpc.WithContextDialer(func(dctx context.Context, _ string) (net.Conn, error) {
 			return transport.DialContext(dctx)
 		}),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithDefaultCallOptions(
 			// we are proxying requests to ourselves, so we don't need to impose a limit
 			grpc.MaxCallRecvMsgSize(math.MaxInt32),
@@ -118,12 +123,13 @@ func NewRouter(
 			Name: "omni_grpc_proxy_talos_backend_cache_misses_total",
 			Help: "Number of gRPC Proxy Talos client cache misses.",
 		}),
-		omniBackend:  NewOmniBackend("omni", nodeResolver, omniConn),
-		nodeResolver: nodeResolver,
-		verifier:     verifier,
-		cosiState:    cosiState,
-		talosAuditor: talosAuditor,
-		authEnabled:  authEnabled,
+		omniBackend:     NewOmniBackend("omni", nodeResolver, omniConn),
+		nodeResolver:    nodeResolver,
+		verifier:        verifier,
+		cosiState:       cosiState,
+		talosAPIPolicy:  NewAccessPolicyTalosAPI(cosiState, talosAuditor),
+		sessionRecorder: sessionRecorder,
+		authEnabled:     authEnabled,
 	}
 
 	return r, nil
@@ -160,9 +166,7 @@ func (r *Router) Director(ctx context.Context, fullMethodName string) (proxy.Mod
 			return proxy.One2One, nil, err
 		}
 
-		if err = r.talosAuditor.AuditTalosAccess(ctx, fullMethodName, getClusterName(md), getNodeID(md)); err != nil {
-			return proxy.One2One, nil, err
-		}
+		// the calls are audited by the Talos API policy after the authentication, along with the deny reason
 
 		return proxy.One2One, backends, nil
 	}
@@ -214,7 +218,7 @@ func (r *Router) getTalosBackend(ctx context.Context, md metadata.MD) ([]proxy.B
 
 		r.metricActiveClients.Inc()
 
-		backend := NewTalosBackend(id, clusterName, r.nodeResolver, conn, r.authEnabled, r.verifier)
+		backend := NewTalosBackend(id, clusterName, r.nodeResolver, conn, r.authEnabled, r.verifier, r.talosAPIPolicy)
 		r.talosBackends.Add(id, backend)
 
 		runtime.SetFinalizer(backend, func(backend *TalosBackend) {
@@ -309,10 +313,15 @@ func (r *Router) getConn(ctx context.Context, contextName string) (*grpc.ClientC
 			MinConnectTimeout: 20 * time.Second,
 		}),
 		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithDefaultCallOptions(grpc.ForceCodecV2(proxy.Codec())),
 		grpc.WithSharedWriteBuffer(true),
 	}
 
+	if contextName != "" && r.sessionRecorder.ShouldRecord(contextName) {
+		opts = append(opts, grpc.WithChainStreamInterceptor(recordingStreamInterceptor(r.sessionRecorder, contextName)))
+	}
+
 	return grpc.NewClient(
 		endpoint,
 		opts...,
@@ -49,6 +49,7 @@ func Interceptors(logger *zap.Logger) grpc.ServerOption {
 		grpc_ctxtags.StreamServerInterceptor(),
 		grpc_zap.StreamServerInterceptor(logger, grpc_zap.WithMessageProducer(msgProducer)),
 		grpcutil.StreamSetUserAgent(),
+		grpcutil.StreamSetTraceID(),
 		grpcutil.StreamSetRealPeerAddress(),
 	)
 }
@@ -46,13 +46,18 @@ type TalosBackend struct {
 	conn         *grpc.ClientConn
 	nodeResolver NodeResolver
 	verifier     grpc.UnaryServerInterceptor
+	policy       TalosAPIPolicy
 	name         string
 	clusterName  string
 	authEnabled  bool
 }
 
 // NewTalosBackend builds new Talos API backend.
-func NewTalosBackend(name, clusterName string, nodeResolver NodeResolver, conn *grpc.ClientConn, authEnabled bool, verifier grpc.UnaryServerInterceptor) *TalosBackend {
+//
+// The policy is optional, if set, it is evaluated for each call after the authentication.
+func NewTalosBackend(name, clusterName string, nodeResolver NodeResolver, conn *grpc.ClientConn, authEnabled bool, verifier grpc.UnaryServerInterceptor,
+	policy TalosAPIPolicy,
+) *TalosBackend {
 	backend := &TalosBackend{
 		name:         name,
 		clusterName:  clusterName,
@@ -60,6 +65,7 @@ func NewTalosBackend(name, clusterName string, nodeResolver NodeResolver, conn *
 		conn:         conn,
 		authEnabled:  authEnabled,
 		verifier:     verifier,
+		policy:       policy,
 	}
 
 	return backend
@@ -125,6 +131,12 @@ func (backend *TalosBackend) GetConnection(ctx context.Context, fullMethodName s
 	// overwrite the node headers with the resolved ones
 	resolved := resolveNodes(backend.nodeResolver, md)
 
+	if backend.policy != nil {
+		if err = backend.checkPolicy(ctx, fullMethodName, resolved); err != nil {
+			return ctx, nil, err
+		}
+	}
+
 	if resolved.nodeOk {
 		md = md.Copy()
 
@@ -148,6 +160,18 @@ func (backend *TalosBackend) GetConnection(ctx context.Context, fullMethodName s
 	return outCtx, backend.conn, nil
 }
 
+func (backend *TalosBackend) checkPolicy(ctx context.Context, fullMethodName string, info resolvedNodeInfo) error {
+	nodes := info.nodes
+
+	if info.nodeOk {
+		nodes = append([]dns.Info{info.node}, nodes...)
+	}
+
+	// machine backends serve the requests for the nodes addressed without the cluster name,
+	// the policy checks such requests against the cluster of each node
+	return backend.policy.CheckTalosAPIAccess(ctx, fullMethodName, backend.clusterName, nodes)
+}
+
 func (backend *TalosBackend) setRoleHeaders(ctx context.Context, md metadata.MD, fullMethodName string, info resolvedNodeInfo, hasModifyAccess bool) {
 	if !hasModifyAccess {
 		setHeaderData(ctx, md, constants.APIAuthzRoleMetadataKey, talosrole.MakeSet(talosrole.Reader).Strings()...)
@@ -78,7 +78,7 @@ func TestNodeResolution(t *testing.T) {
 	noOpVerifier := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		return handler(ctx, req)
 	}
-	talosBackend := router.NewTalosBackend("test-backend", "test-backend", resolver, nil, false, noOpVerifier)
+	talosBackend := router.NewTalosBackend("test-backend", "test-backend", resolver, nil, false, noOpVerifier, nil)
 
 	testCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	t.Cleanup(cancel)
@@ -187,6 +187,7 @@ func (t *testDirector) Director(context.Context, string) (proxy.Mode, []proxy.Ba
 		func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 			return handler(ctx, req)
 		},
+		nil,
 	)
 
 	return proxy.One2One, []proxy.Backend{backend}, nil
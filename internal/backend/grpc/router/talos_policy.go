@@ -0,0 +1,200 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package router
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/dns"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+// TalosAPIPolicy decides whether the authenticated caller is allowed to call the Talos API method on the target nodes.
+type TalosAPIPolicy interface {
+	CheckTalosAPIAccess(ctx context.Context, fullMethodName, clusterName string, nodes []dns.Info) error
+}
+
+// AccessPolicyTalosAPI evaluates the Talos API rules of the access policy resource.
+//
+// Each call is recorded in the audit log once, the denied calls are recorded with the deny reason.
+type AccessPolicyTalosAPI struct {
+	state   state.State
+	auditor TalosAuditor
+}
+
+// NewAccessPolicyTalosAPI creates a new AccessPolicyTalosAPI.
+func NewAccessPolicyTalosAPI(st state.State, auditor TalosAuditor) *AccessPolicyTalosAPI {
+	return &AccessPolicyTalosAPI{
+		state:   st,
+		auditor: auditor,
+	}
+}
+
+// CheckTalosAPIAccess implements TalosAPIPolicy.
+//
+// If the cluster name is empty, the call is checked against the cluster of each target node.
+func (p *AccessPolicyTalosAPI) CheckTalosAPIAccess(ctx context.Context, fullMethodName, clusterName string, nodes []dns.Info) error {
+	denyReason, err := p.check(ctx, fullMethodName, clusterName, nodes)
+	if err != nil {
+		return err
+	}
+
+	nodeIDs := make([]string, 0, len(nodes))
+
+	for _, node := range nodes {
+		nodeIDs = append(nodeIDs, node.GetAddress())
+	}
+
+	slices.Sort(nodeIDs)
+
+	if err = p.auditor.AuditTalosAccess(ctx, strings.TrimLeft(fullMethodName, "/"), clusterName, strings.Join(nodeIDs, ","), denyReason); err != nil {
+		return err
+	}
+
+	if denyReason == "" {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "Talos API method %q is denied by the access policy: %s", fullMethodName, denyReason)
+}
+
+// check returns the deny reason, empty if the call is allowed.
+func (p *AccessPolicyTalosAPI) check(ctx context.Context, fullMethodName, clusterName string, nodes []dns.Info) (string, error) {
+	internalCtx := actor.MarkContextAsInternalActor(ctx)
+
+	accessPolicy, err := safe.StateGet[*authres.AccessPolicy](internalCtx, p.state, authres.NewAccessPolicy().Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	rules := accessPolicy.TypedSpec().Value.GetTalosApiRules()
+	if len(rules) == 0 {
+		return "", nil
+	}
+
+	needMachines := slices.ContainsFunc(rules, func(rule *specs.AccessPolicyTalosAPIRule) bool { return len(rule.GetMachineLabelSelectors()) != 0 })
+
+	for _, target := range clusterTargets(clusterName, nodes) {
+		request := accesspolicy.TalosAPIRequest{
+			FullMethodName: fullMethodName,
+			Role:           role.None,
+		}
+
+		if target.cluster != "" {
+			request.ClusterMD = omni.NewCluster(resources.DefaultNamespace, target.cluster).Metadata()
+
+			// the cluster-scoped roles of the access policy rules raise the role of the caller
+			if request.Role, _, err = accesspolicy.RoleForCluster(ctx, target.cluster, p.state); err != nil {
+				return "", err
+			}
+		} else if val, ok := ctxstore.Value[auth.RoleContextKey](ctx); ok {
+			request.Role = val.Role
+		}
+
+		if needMachines {
+			if request.MachineMDs, request.UnknownMachines, err = p.machineMetadata(internalCtx, target.nodes); err != nil {
+				return "", err
+			}
+		}
+
+		result, err := accesspolicy.CheckTalosAPI(accessPolicy, request)
+		if err != nil {
+			return "", err
+		}
+
+		if !result.Allowed {
+			if target.cluster != "" && clusterName == "" {
+				return fmt.Sprintf("cluster %q: %s", target.cluster, result.Reason), nil
+			}
+
+			return result.Reason, nil
+		}
+	}
+
+	return "", nil
+}
+
+type clusterTarget struct {
+	cluster string
+	nodes   []dns.Info
+}
+
+// clusterTargets groups the target nodes by their clusters.
+//
+// The nodes addressed through the cluster backend all belong to that cluster, the machine backends
+// might get the nodes of the different clusters in a single call.
+func clusterTargets(clusterName string, nodes []dns.Info) []clusterTarget {
+	if clusterName != "" || len(nodes) == 0 {
+		return []clusterTarget{{cluster: clusterName, nodes: nodes}}
+	}
+
+	var targets []clusterTarget
+
+	for _, node := range nodes {
+		idx := slices.IndexFunc(targets, func(target clusterTarget) bool { return target.cluster == node.Cluster })
+		if idx == -1 {
+			targets = append(targets, clusterTarget{cluster: node.Cluster})
+
+			idx = len(targets) - 1
+		}
+
+		targets[idx].nodes = append(targets[idx].nodes, node)
+	}
+
+	return targets
+}
+
+// machineMetadata returns the metadata of the target machines, and whether some of the targets are unknown.
+//
+// The call without the node headers goes to the endpoint node, which is also unknown.
+func (p *AccessPolicyTalosAPI) machineMetadata(ctx context.Context, nodes []dns.Info) ([]*resource.Metadata, bool, error) {
+	machineMDs := make([]*resource.Metadata, 0, len(nodes))
+	unknown := len(nodes) == 0
+
+	for _, node := range nodes {
+		if node.ID == "" {
+			unknown = true
+
+			continue
+		}
+
+		machineStatus, err := safe.StateGet[*omni.MachineStatus](ctx, p.state, omni.NewMachineStatus(resources.DefaultNamespace, node.ID).Metadata())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				unknown = true
+
+				continue
+			}
+
+			return nil, false, err
+		}
+
+		machineMDs = append(machineMDs, machineStatus.Metadata())
+	}
+
+	return machineMDs, unknown, nil
+}
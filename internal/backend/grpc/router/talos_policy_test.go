@@ -0,0 +1,209 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package router_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/dns"
+	"github.com/siderolabs/omni/internal/backend/grpc/router"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+type talosAccessEvent struct {
+	fullMethodName string
+	clusterID      string
+	nodeID         string
+	denyReason     string
+}
+
+type testTalosAuditor struct {
+	events []talosAccessEvent
+}
+
+func (a *testTalosAuditor) AuditTalosAccess(_ context.Context, fullMethodName, clusterID, nodeID, denyReason string) error {
+	a.events = append(a.events, talosAccessEvent{
+		fullMethodName: fullMethodName,
+		clusterID:      clusterID,
+		nodeID:         nodeID,
+		denyReason:     denyReason,
+	})
+
+	return nil
+}
+
+func TestTalosAPIPolicy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	accessPolicy := authres.NewAccessPolicy()
+	accessPolicy.TypedSpec().Value.TalosApiRules = []*specs.AccessPolicyTalosAPIRule{
+		{
+			Roles:         []string{string(role.Operator)},
+			Clusters:      []string{"cluster-1"},
+			DeniedMethods: []string{"/machine.MachineService/Reset"},
+		},
+		{
+			MachineLabelSelectors: []string{"locked"},
+			AllowedMethods:        []string{"/machine.MachineService/Version"},
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, accessPolicy))
+
+	lockedMachine := omni.NewMachineStatus(resources.DefaultNamespace, "machine-2")
+	lockedMachine.Metadata().Labels().Set("locked", "")
+
+	require.NoError(t, st.Create(ctx, lockedMachine))
+	require.NoError(t, st.Create(ctx, omni.NewMachineStatus(resources.DefaultNamespace, "machine-1")))
+
+	resolver := &mockResolver{
+		db: map[string]map[string]dns.Info{
+			"cluster-1": {
+				"node-1": dns.NewInfo("cluster-1", "machine-1", "node-1", "1.1.1.1"),
+				"node-2": dns.NewInfo("cluster-1", "machine-2", "node-2", "2.2.2.2"),
+			},
+		},
+	}
+
+	noOpVerifier := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		return handler(ctx, req)
+	}
+
+	auditor := &testTalosAuditor{}
+
+	talosBackend := router.NewTalosBackend("cluster-cluster-1", "cluster-1", resolver, nil, false, noOpVerifier, router.NewAccessPolicyTalosAPI(st, auditor))
+
+	getConnection := func(r role.Role, node, fullMethodName string) error {
+		callCtx := ctxstore.WithValue(ctx, auth.RoleContextKey{Role: r})
+		callCtx = metadata.NewIncomingContext(callCtx, metadata.Pairs("cluster", "cluster-1", "node", node))
+
+		_, _, err := talosBackend.GetConnection(callCtx, "/machine.MachineService/"+fullMethodName)
+
+		return err
+	}
+
+	deniedEvents := func() []talosAccessEvent {
+		return xslices.Filter(auditor.events, func(event talosAccessEvent) bool { return event.denyReason != "" })
+	}
+
+	err := getConnection(role.Operator, "node-1", "Reset")
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the denied call is recorded once, with the deny reason
+	require.Len(t, auditor.events, 1)
+	assert.Equal(t, "machine.MachineService/Reset", auditor.events[0].fullMethodName)
+	assert.Equal(t, "cluster-1", auditor.events[0].clusterID)
+	assert.Equal(t, "1.1.1.1", auditor.events[0].nodeID)
+	assert.NotEmpty(t, auditor.events[0].denyReason)
+
+	require.NoError(t, getConnection(role.Admin, "node-1", "Reset"))
+	require.NoError(t, getConnection(role.Operator, "node-1", "Reboot"))
+
+	// the allowed calls are recorded without the deny reason
+	require.Len(t, auditor.events, 3)
+	assert.Empty(t, auditor.events[2].denyReason)
+
+	// the locked machine only allows reading the version
+	require.NoError(t, getConnection(role.Admin, "node-2", "Version"))
+
+	err = getConnection(role.Admin, "node-2", "Reboot")
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	assert.Len(t, deniedEvents(), 2)
+	assert.Len(t, auditor.events, 5)
+}
+
+func TestTalosAPIPolicyClusterRoles(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	accessPolicy := authres.NewAccessPolicy()
+	accessPolicy.TypedSpec().Value.Rules = []*specs.AccessPolicyRule{
+		{
+			Users:    []string{"user@example.com"},
+			Clusters: []string{"cluster-1"},
+			Role:     string(role.Operator),
+		},
+	}
+	accessPolicy.TypedSpec().Value.TalosApiRules = []*specs.AccessPolicyTalosAPIRule{
+		{
+			Roles:         []string{string(role.Operator)},
+			Clusters:      []string{"cluster-1"},
+			DeniedMethods: []string{"/machine.MachineService/Reset"},
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, accessPolicy))
+	require.NoError(t, st.Create(ctx, authres.NewIdentity(resources.DefaultNamespace, "user@example.com")))
+
+	resolver := &mockResolver{
+		db: map[string]map[string]dns.Info{
+			"cluster-1": {
+				"node-1": dns.NewInfo("cluster-1", "machine-1", "node-1", "1.1.1.1"),
+			},
+			"": {
+				"node-1": dns.NewInfo("cluster-1", "machine-1", "node-1", "1.1.1.1"),
+				"node-2": dns.NewInfo("cluster-2", "machine-2", "node-2", "2.2.2.2"),
+			},
+		},
+	}
+
+	noOpVerifier := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		return handler(ctx, req)
+	}
+
+	auditor := &testTalosAuditor{}
+	policy := router.NewAccessPolicyTalosAPI(st, auditor)
+
+	clusterBackend := router.NewTalosBackend("cluster-cluster-1", "cluster-1", resolver, nil, false, noOpVerifier, policy)
+	machineBackend := router.NewTalosBackend("machine-machine-2", "", resolver, nil, false, noOpVerifier, policy)
+
+	callCtx := ctxstore.WithValue(ctx, auth.RoleContextKey{Role: role.Reader})
+	callCtx = ctxstore.WithValue(callCtx, auth.IdentityContextKey{Identity: "user@example.com"})
+
+	// the user is a Reader globally, but an Operator in the cluster, so the Operator rules apply
+	_, _, err := clusterBackend.GetConnection(metadata.NewIncomingContext(callCtx, metadata.Pairs("cluster", "cluster-1", "node", "node-1")), "/machine.MachineService/Reset")
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the call to the nodes of the different clusters is checked against each cluster
+	_, _, err = machineBackend.GetConnection(metadata.NewIncomingContext(callCtx, metadata.Pairs("nodes", "node-2", "nodes", "node-1")), "/machine.MachineService/Reset")
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), `cluster "cluster-1"`)
+
+	_, _, err = machineBackend.GetConnection(metadata.NewIncomingContext(callCtx, metadata.Pairs("nodes", "node-2")), "/machine.MachineService/Reset")
+	require.NoError(t, err)
+
+	require.Len(t, auditor.events, 3)
+	assert.Equal(t, "1.1.1.1,2.2.2.2", auditor.events[1].nodeID)
+}
@@ -8,18 +8,22 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/go-api-signature/pkg/pgp"
+	"github.com/siderolabs/go-pointer"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
@@ -31,6 +35,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/grpc/router"
 	"github.com/siderolabs/omni/internal/backend/oidc/external"
+	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
@@ -226,7 +231,8 @@ func (s *managementServer) DestroyServiceAccount(ctx context.Context, req *manag
 }
 
 func (s *managementServer) serviceAccountKubeconfig(ctx context.Context, req *management.KubeconfigRequest) (*management.KubeconfigResponse, error) {
-	if _, err := auth.CheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
+	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Operator))
+	if err != nil {
 		return nil, err
 	}
 
@@ -241,7 +247,28 @@ func (s *managementServer) serviceAccountKubeconfig(ctx context.Context, req *ma
 		return nil, fmt.Errorf("failed to get cluster UUID: %w", err)
 	}
 
-	signedToken, err := s.generateServiceAccountJWT(ctx, req, cluster, clusterUUID.TypedSpec().Value.GetUuid())
+	tokenID := uuid.NewString()
+	now := time.Now()
+
+	issuedKubeconfig := authres.NewIssuedKubeconfig(resources.DefaultNamespace, tokenID)
+	issuedKubeconfig.Metadata().Labels().Set(omni.LabelCluster, cluster)
+
+	issuedKubeconfig.TypedSpec().Value.Cluster = cluster
+	issuedKubeconfig.TypedSpec().Value.IssuedBy = authResult.Identity
+	issuedKubeconfig.TypedSpec().Value.Subject = req.GetServiceAccountUser()
+	issuedKubeconfig.TypedSpec().Value.Groups = req.GetServiceAccountGroups()
+	issuedKubeconfig.TypedSpec().Value.Namespaces = req.GetServiceAccountNamespaces()
+	issuedKubeconfig.TypedSpec().Value.IssuedAt = timestamppb.New(now)
+	issuedKubeconfig.TypedSpec().Value.ExpiresAt = timestamppb.New(now.Add(req.GetServiceAccountTtl().AsDuration()))
+
+	// the token is tracked before it is signed, so that it is never accepted without the record
+	if err = s.omniState.Create(actor.MarkContextAsInternalActor(ctx), issuedKubeconfig,
+		state.WithCreateOwner(pointer.To(omniCtrl.IssuedKubeconfigPrunerController{}).Name()),
+	); err != nil {
+		return nil, fmt.Errorf("failed to record the issued kubeconfig: %w", err)
+	}
+
+	signedToken, err := s.generateServiceAccountJWT(ctx, req, tokenID, now, cluster, clusterUUID.TypedSpec().Value.GetUuid())
 	if err != nil {
 		return nil, err
 	}
@@ -279,10 +306,68 @@ func (s *managementServer) validateServiceAccountRequest(cluster string, req *ma
 		return status.Errorf(codes.InvalidArgument, "service account ttl is too long (max allowed: %s)", external.ServiceAccountTokenLifetime)
 	}
 
+	for _, namespace := range req.GetServiceAccountNamespaces() {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid namespace %q: %s", namespace, strings.Join(errs, ", "))
+		}
+	}
+
 	return nil
 }
 
-func (s *managementServer) generateServiceAccountJWT(ctx context.Context, req *management.KubeconfigRequest, clusterName, clusterUUID string) (string, error) {
+// RevokeKubeconfig revokes the service account kubeconfig, the Kubernetes proxy rejects its token from then on.
+func (s *managementServer) RevokeKubeconfig(ctx context.Context, req *management.RevokeKubeconfigRequest) (*emptypb.Empty, error) {
+	if _, err := auth.CheckGRPC(ctx, auth.WithValidSignature(true)); err != nil {
+		return nil, err
+	}
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "kubeconfig id is not set")
+	}
+
+	issuedKubeconfig, err := safe.StateGetByID[*authres.IssuedKubeconfig](actor.MarkContextAsInternalActor(ctx), s.omniState, req.GetId())
+	if state.IsNotFoundError(err) {
+		return nil, status.Errorf(codes.NotFound, "kubeconfig %q not found", req.GetId())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.applyClusterAccessPolicy(ctx, issuedKubeconfig.TypedSpec().Value.GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Operator))
+	if err != nil {
+		return nil, err
+	}
+
+	if issuedKubeconfig.TypedSpec().Value.GetRevoked() {
+		return &emptypb.Empty{}, nil
+	}
+
+	_, err = safe.StateUpdateWithConflicts(actor.MarkContextAsInternalActor(ctx), s.omniState, issuedKubeconfig.Metadata(), func(res *authres.IssuedKubeconfig) error {
+		res.TypedSpec().Value.Revoked = true
+		res.TypedSpec().Value.RevokedBy = authResult.Identity
+		res.TypedSpec().Value.RevokedAt = timestamppb.Now()
+
+		return nil
+	}, state.WithUpdateOwner(pointer.To(omniCtrl.IssuedKubeconfigPrunerController{}).Name()))
+	if state.IsNotFoundError(err) {
+		// the kubeconfig has expired and was pruned in the meantime
+		return &emptypb.Empty{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *managementServer) generateServiceAccountJWT(ctx context.Context, req *management.KubeconfigRequest, tokenID string, now time.Time, clusterName, clusterUUID string) (string, error) {
 	signingKey, err := s.jwtSigningKeyProvider.SigningKey(ctx)
 	if err != nil {
 		return "", err
@@ -290,8 +375,8 @@ func (s *managementServer) generateServiceAccountJWT(ctx context.Context, req *m
 
 	signingMethod := jwt.GetSigningMethod(string(signingKey.SignatureAlgorithm()))
 
-	now := time.Now()
 	token := jwt.NewWithClaims(signingMethod, jwt.MapClaims{
+		"jti":          tokenID,
 		"iat":          now.Unix(),
 		"iss":          fmt.Sprintf("omni-%s-service-account-issuer", config.Config.Name),
 		"exp":          now.Add(req.GetServiceAccountTtl().AsDuration()).Unix(),
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+const sessionRecordingChunkSize = 32 * 1024
+
+// ListSessionRecordings lists the recorded Talos and Kubernetes sessions.
+func (s *managementServer) ListSessionRecordings(ctx context.Context, _ *emptypb.Empty) (*management.ListSessionRecordingsResponse, error) {
+	if _, err := auth.CheckGRPC(ctx, auth.WithRole(role.Admin)); err != nil {
+		return nil, err
+	}
+
+	if s.sessionRecorder == nil {
+		return nil, status.Error(codes.FailedPrecondition, "session recording is not enabled")
+	}
+
+	recordings, err := s.sessionRecorder.List()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &management.ListSessionRecordingsResponse{
+		Recordings: make([]*management.SessionRecording, 0, len(recordings)),
+	}
+
+	for _, md := range recordings {
+		resp.Recordings = append(resp.Recordings, sessionRecordingToProto(md))
+	}
+
+	return resp, nil
+}
+
+// ReadSessionRecording streams the encrypted events of the recorded session.
+//
+// The events are encrypted for the session recording recipient, so they are decrypted by the client.
+func (s *managementServer) ReadSessionRecording(req *management.ReadSessionRecordingRequest, srv grpc.ServerStreamingServer[management.ReadSessionRecordingResponse]) error {
+	if _, err := auth.CheckGRPC(srv.Context(), auth.WithRole(role.Admin)); err != nil {
+		return err
+	}
+
+	if s.sessionRecorder == nil {
+		return status.Error(codes.FailedPrecondition, "session recording is not enabled")
+	}
+
+	md, reader, err := s.sessionRecorder.Open(req.GetId())
+	if err != nil {
+		if errors.Is(err, sessionrecording.ErrNotFound) {
+			return status.Errorf(codes.NotFound, "session recording %q not found", req.GetId())
+		}
+
+		return err
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	if err = srv.Send(&management.ReadSessionRecordingResponse{Recording: sessionRecordingToProto(md)}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, sessionRecordingChunkSize)
+
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if sendErr := srv.Send(&management.ReadSessionRecordingResponse{Data: buf[:n]}); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
+
+func sessionRecordingToProto(md sessionrecording.Metadata) *management.SessionRecording {
+	return &management.SessionRecording{
+		Id:        md.ID,
+		Type:      md.Type,
+		Cluster:   md.Cluster,
+		Identity:  md.Identity,
+		Method:    md.Method,
+		Nodes:     md.Nodes,
+		StartTime: timestamppb.New(md.StartTime),
+		Size:      md.Size,
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/workloadproxy"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+const workloadProxyTunnelBufferSize = 32 * 1024
+
+// WorkloadProxyDialer opens raw connections to the exposed services of the workload clusters.
+type WorkloadProxyDialer interface {
+	DialService(ctx context.Context, alias string) (net.Conn, resource.ID, error)
+}
+
+// WorkloadProxyTunnel tunnels a raw TCP connection to an exposed service of a workload cluster over the bidirectional stream.
+func (s *managementServer) WorkloadProxyTunnel(srv grpc.BidiStreamingServer[management.WorkloadProxyTunnelRequest, management.WorkloadProxyTunnelResponse]) error {
+	ctx := srv.Context()
+
+	if _, err := auth.CheckGRPC(ctx, auth.WithValidSignature(true)); err != nil {
+		return err
+	}
+
+	if s.workloadProxyDialer == nil {
+		return status.Error(codes.Unavailable, "workload proxying is not enabled")
+	}
+
+	initReq, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	init := initReq.GetInit()
+	if init == nil || init.GetCluster() == "" || init.GetService() == "" {
+		return status.Error(codes.InvalidArgument, "the first message must contain the cluster and the service")
+	}
+
+	ctx, err = s.applyClusterAccessPolicy(ctx, init.GetCluster())
+	if err != nil {
+		return err
+	}
+
+	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader))
+	if err != nil {
+		return err
+	}
+
+	exposedService, err := s.findExposedService(ctx, init.GetCluster(), init.GetService())
+	if err != nil {
+		return err
+	}
+
+	if err = workloadproxy.CheckAccessRules(actor.MarkContextAsInternalActor(ctx), s.omniState, exposedService, authResult.Role, authResult.Identity); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	alias, _ := exposedService.Metadata().Labels().Get(omni.LabelExposedServiceAlias)
+
+	conn, _, err := s.workloadProxyDialer.DialService(ctx, alias)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	if conn == nil {
+		return status.Errorf(codes.NotFound, "service %q is not exposed in the cluster %q", init.GetService(), init.GetCluster())
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	s.logger.Info("workload proxy tunnel opened",
+		zap.String("cluster", init.GetCluster()),
+		zap.String("service", exposedService.Metadata().ID()),
+		zap.String("identity", authResult.Identity),
+	)
+
+	recvErrCh := make(chan error, 1)
+
+	// when the client is done sending, the receiving side half-closes the connection, so that the upstream can still send its reply,
+	// the connection is closed on errors, or if it can't be half-closed, which terminates the sending side as well
+	panichandler.Go(func() {
+		recvErr := tunnelToConn(srv, conn)
+
+		recvErrCh <- recvErr
+
+		if closeWriter, ok := conn.(interface{ CloseWrite() error }); ok && recvErr == nil {
+			if closeWriter.CloseWrite() == nil {
+				return
+			}
+		}
+
+		conn.Close() //nolint:errcheck
+	}, s.logger)
+
+	// when the upstream closes the connection, the handler returns, which cancels the stream and terminates the receiving side
+	if err = tunnelFromConn(srv, conn); err != nil {
+		return err
+	}
+
+	select {
+	case err = <-recvErrCh:
+		return err
+	default:
+		return nil
+	}
+}
+
+func tunnelToConn(srv grpc.BidiStreamingServer[management.WorkloadProxyTunnelRequest, management.WorkloadProxyTunnelResponse], conn net.Conn) error {
+	for {
+		req, err := srv.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if _, err = conn.Write(req.GetData()); err != nil {
+			return err
+		}
+	}
+}
+
+func tunnelFromConn(srv grpc.BidiStreamingServer[management.WorkloadProxyTunnelRequest, management.WorkloadProxyTunnelResponse], conn net.Conn) error {
+	buf := make([]byte, workloadProxyTunnelBufferSize)
+
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			if sendErr := srv.Send(&management.WorkloadProxyTunnelResponse{Data: buf[:n]}); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
+
+// findExposedService finds the exposed service in the cluster either by its <name>.<namespace> or by its alias.
+func (s *managementServer) findExposedService(ctx context.Context, cluster, service string) (*omni.ExposedService, error) {
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	exposedService, err := safe.StateGet[*omni.ExposedService](ctx, s.omniState, omni.NewExposedService(resources.DefaultNamespace, cluster+"/"+service).Metadata())
+	if err == nil {
+		return exposedService, nil
+	}
+
+	if !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	exposedServices, err := safe.StateListAll[*omni.ExposedService](ctx, s.omniState,
+		state.WithLabelQuery(
+			resource.LabelEqual(omni.LabelCluster, cluster),
+			resource.LabelEqual(omni.LabelExposedServiceAlias, service),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if exposedServices.Len() == 0 {
+		return nil, status.Errorf(codes.NotFound, "service %q is not exposed in the cluster %q", service, cluster)
+	}
+
+	return exposedServices.Get(0), nil
+}
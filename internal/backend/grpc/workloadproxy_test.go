@@ -0,0 +1,238 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+type mockWorkloadProxyDialer struct {
+	addresses map[string]string
+}
+
+func (d *mockWorkloadProxyDialer) DialService(ctx context.Context, alias string) (net.Conn, resource.ID, error) {
+	address, ok := d.addresses[alias]
+	if !ok {
+		return nil, "", nil
+	}
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+
+	return conn, "cluster1", err
+}
+
+func TestWorkloadProxyTunnel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for _, svc := range []struct {
+		id           string
+		alias        string
+		allowedUsers []string
+	}{
+		{id: "cluster1/echo.default", alias: "echo"},
+		{id: "cluster1/reply.default", alias: "reply"},
+		{id: "cluster1/restricted.default", alias: "restricted", allowedUsers: []string{"admin@example.com"}},
+	} {
+		exposedService := omni.NewExposedService(resources.DefaultNamespace, svc.id)
+
+		exposedService.Metadata().Labels().Set(omni.LabelCluster, "cluster1")
+		exposedService.Metadata().Labels().Set(omni.LabelExposedServiceAlias, svc.alias)
+
+		exposedService.TypedSpec().Value.Port = 8080
+		exposedService.TypedSpec().Value.AllowedUsers = svc.allowedUsers
+
+		require.NoError(t, st.Create(ctx, exposedService))
+	}
+
+	managementServer := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t))
+	managementServer.SetWorkloadProxyDialer(&mockWorkloadProxyDialer{
+		addresses: map[string]string{
+			"echo":  runTestServer(t, echo),
+			"reply": runTestServer(t, replyOnEOF),
+		},
+	})
+
+	address := runManagementServer(t, managementServer, grpc.StreamInterceptor(
+		func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx := ctxstore.WithValue(ss.Context(), auth.EnabledAuthContextKey{Enabled: true})
+
+			md, _ := metadata.FromIncomingContext(ctx)
+
+			if r := md.Get("role"); len(r) > 0 {
+				parsed, err := role.Parse(r[0])
+				if err != nil {
+					return err
+				}
+
+				ctx = ctxstore.WithValue(ctx, auth.RoleContextKey{Role: parsed})
+			}
+
+			if identity := md.Get("identity"); len(identity) > 0 {
+				ctx = ctxstore.WithValue(ctx, auth.IdentityContextKey{Identity: identity[0]})
+			}
+
+			return handler(srv, &grpc_middleware.WrappedServerStream{
+				ServerStream:   ss,
+				WrappedContext: ctx,
+			})
+		},
+	))
+
+	c, err := client.New(address)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, c.Close())
+	})
+
+	readerCtx := metadata.AppendToOutgoingContext(ctx, "role", string(role.Reader), "identity", "user@example.com")
+
+	tunnelError := func(ctx context.Context, service string) error {
+		tunnel, err := c.Management().WorkloadProxyTunnel(ctx, "cluster1", service)
+		require.NoError(t, err)
+
+		_, err = tunnel.Read(make([]byte, 1))
+
+		return err
+	}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		err := tunnelError(ctx, "echo")
+
+		require.Equal(t, codes.Unauthenticated, status.Code(err), err)
+	})
+
+	t.Run("access denied", func(t *testing.T) {
+		err := tunnelError(readerCtx, "restricted")
+
+		require.Equal(t, codes.PermissionDenied, status.Code(err), err)
+	})
+
+	t.Run("not exposed", func(t *testing.T) {
+		err := tunnelError(readerCtx, "unknown.default")
+
+		require.Equal(t, codes.NotFound, status.Code(err), err)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, service := range []string{"echo.default", "echo"} {
+			tunnel, err := c.Management().WorkloadProxyTunnel(readerCtx, "cluster1", service)
+			require.NoError(t, err)
+
+			_, err = tunnel.Write([]byte("ping"))
+			require.NoError(t, err)
+
+			buf := make([]byte, 4)
+
+			_, err = io.ReadFull(tunnel, buf)
+			require.NoError(t, err)
+
+			require.Equal(t, "ping", string(buf))
+
+			require.NoError(t, tunnel.Close())
+
+			_, err = tunnel.Read(buf)
+			require.ErrorIs(t, err, io.EOF)
+		}
+	})
+
+	t.Run("half-close", func(t *testing.T) {
+		tunnel, err := c.Management().WorkloadProxyTunnel(readerCtx, "cluster1", "reply")
+		require.NoError(t, err)
+
+		_, err = tunnel.Write([]byte("ping"))
+		require.NoError(t, err)
+
+		// the upstream replies only after the request is fully sent
+		require.NoError(t, tunnel.Close())
+
+		reply, err := io.ReadAll(tunnel)
+		require.NoError(t, err)
+
+		require.Equal(t, "reply: ping", string(reply))
+	})
+}
+
+func echo(conn net.Conn) error {
+	_, err := io.Copy(conn, conn)
+
+	return err
+}
+
+func replyOnEOF(conn net.Conn) error {
+	request, err := io.ReadAll(conn)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Write(append([]byte("reply: "), request...))
+
+	return err
+}
+
+func runTestServer(t *testing.T, handle func(net.Conn) error) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	var eg errgroup.Group
+
+	eg.Go(func() error {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				if errors.Is(err, net.ErrClosed) {
+					return nil
+				}
+
+				return err
+			}
+
+			eg.Go(func() error {
+				defer conn.Close() //nolint:errcheck
+
+				return handle(conn)
+			})
+		}
+	})
+
+	t.Cleanup(func() {
+		require.NoError(t, listener.Close())
+		require.NoError(t, eg.Wait())
+	})
+
+	return listener.Addr().String()
+}
@@ -9,12 +9,14 @@ package imagefactory
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/image-factory/pkg/client"
 	"github.com/siderolabs/image-factory/pkg/schematic"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
@@ -29,7 +31,9 @@ type Client struct {
 
 // NewClient creates a new image factory client.
 func NewClient(omniState state.State, imageFactoryBaseURL string) (*Client, error) {
-	factoryClient, err := client.New(imageFactoryBaseURL)
+	factoryClient, err := client.New(imageFactoryBaseURL, client.WithClient(http.Client{
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}))
 	if err != nil {
 		return nil, err
 	}
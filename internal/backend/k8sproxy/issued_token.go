@@ -0,0 +1,121 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package k8sproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// serviceAccountIssuerSuffix is the suffix of the issuer of the service account tokens, see the management server.
+const serviceAccountIssuerSuffix = "-service-account-issuer"
+
+// IssuedToken describes the tracked service account token.
+type IssuedToken struct {
+	Cluster string
+	Subject string
+
+	// Namespaces is the list of the namespaces the token is limited to, all namespaces are allowed if empty.
+	Namespaces []string
+
+	Revoked bool
+}
+
+// IssuedTokenResolver resolves the ID of the service account token to its record.
+type IssuedTokenResolver func(ctx context.Context, tokenID string) (*IssuedToken, error)
+
+var errNamespaceNotAllowed = errors.New("namespace is not allowed")
+
+// checkUntrackedToken rejects the service account tokens without the token ID if the cutoff has passed.
+//
+// Such tokens were issued before the service account kubeconfigs were tracked, so they can't be revoked.
+// The user tokens don't carry the token ID, they are always accepted.
+func checkUntrackedToken(claims *claims, cutoff, now time.Time) error {
+	if cutoff.IsZero() || now.Before(cutoff) || !strings.HasSuffix(claims.Issuer, serviceAccountIssuerSuffix) {
+		return nil
+	}
+
+	return fmt.Errorf("service account tokens without the token ID are not accepted since %s, the kubeconfig has to be issued again", cutoff.Format(time.RFC3339))
+}
+
+// checkIssuedToken verifies that the token is not revoked and that the request is within the namespaces the token is limited to.
+func checkIssuedToken(ctx context.Context, resolver IssuedTokenResolver, claims *claims, path string) error {
+	issuedToken, err := resolver(ctx, claims.ID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve token %q: %w", claims.ID, err)
+	}
+
+	if issuedToken.Revoked {
+		return fmt.Errorf("token %q is revoked", claims.ID)
+	}
+
+	if issuedToken.Cluster != claims.Cluster || issuedToken.Subject != claims.Subject {
+		return fmt.Errorf("token %q doesn't match its record", claims.ID)
+	}
+
+	if len(issuedToken.Namespaces) == 0 {
+		return nil
+	}
+
+	namespace, isResource := requestNamespace(path)
+	if !isResource {
+		return nil
+	}
+
+	if namespace == "" {
+		return fmt.Errorf("%w: the token is limited to the namespaces %s, cluster-wide requests are not allowed", errNamespaceNotAllowed, strings.Join(issuedToken.Namespaces, ", "))
+	}
+
+	if !slices.Contains(issuedToken.Namespaces, namespace) {
+		return fmt.Errorf("%w: the token is limited to the namespaces %s, %q is not allowed", errNamespaceNotAllowed, strings.Join(issuedToken.Namespaces, ", "), namespace)
+	}
+
+	return nil
+}
+
+// requestNamespace returns the namespace of the Kubernetes API resource request.
+//
+// The discovery and the non-resource requests are reported as not being resource requests.
+// The namespace is empty for the requests to the cluster-scoped resources and for the requests across all namespaces.
+func requestNamespace(path string) (namespace string, isResource bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	var rest []string
+
+	switch parts[0] {
+	case "api":
+		// /api/{version}/...
+		if len(parts) <= 2 {
+			return "", false
+		}
+
+		rest = parts[2:]
+	case "apis":
+		// /apis/{group}/{version}/...
+		if len(parts) <= 3 {
+			return "", false
+		}
+
+		rest = parts[3:]
+	default:
+		return "", false
+	}
+
+	// the deprecated watch requests, e.g. /api/v1/watch/namespaces/{namespace}/pods
+	if rest[0] == "watch" {
+		rest = rest[1:]
+	}
+
+	if len(rest) >= 2 && rest[0] == "namespaces" {
+		return rest[1], true
+	}
+
+	return "", true
+}
@@ -8,12 +8,14 @@ package k8sproxy
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
 )
 
 // clusterContextKey is a type for cluster name.
@@ -36,13 +38,21 @@ type MiddlewareWrapper interface {
 }
 
 // NewHandler creates a new Handler.
-func NewHandler(keyFunc KeyProvider, clusterUUIDResolver ClusterUUIDResolver, wrapper MiddlewareWrapper, logger *zap.Logger) (*Handler, error) {
+func NewHandler(
+	keyFunc KeyProvider,
+	clusterUUIDResolver ClusterUUIDResolver,
+	issuedTokenResolver IssuedTokenResolver,
+	untrackedTokensCutoff time.Time,
+	wrapper MiddlewareWrapper,
+	recorder *sessionrecording.Recorder,
+	logger *zap.Logger,
+) (*Handler, error) {
 	multiplexer := newMultiplexer()
-	proxy := wrapper.Wrap(newProxyHandler(multiplexer, logger))
+	proxy := wrapper.Wrap(newProxyHandler(multiplexer, recorder, logger))
 
 	handler := &Handler{
 		multiplexer: multiplexer,
-		chain:       AuthorizeRequest(proxy, keyFunc, clusterUUIDResolver),
+		chain:       AuthorizeRequest(proxy, keyFunc, clusterUUIDResolver, issuedTokenResolver, untrackedTokensCutoff),
 	}
 
 	type kubeRuntime interface {
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/golang-jwt/jwt/v4"
@@ -32,7 +33,16 @@ type KeyProvider func(ctx context.Context, keyID string) (any, error)
 type ClusterUUIDResolver func(ctx context.Context, clusterID resource.ID) (string, error)
 
 // AuthorizeRequest checks for valid token in the request.
-func AuthorizeRequest(next http.Handler, keyFunc KeyProvider, clusterUUIDResolver ClusterUUIDResolver) http.Handler {
+//
+// The tokens which carry an ID are tracked, they are checked against their records resolved with the issuedTokenResolver.
+// The service account tokens without an ID can't be revoked, they are rejected after the untrackedTokensCutoff, if it is set.
+func AuthorizeRequest(
+	next http.Handler,
+	keyFunc KeyProvider,
+	clusterUUIDResolver ClusterUUIDResolver,
+	issuedTokenResolver IssuedTokenResolver,
+	untrackedTokensCutoff time.Time,
+) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		ctx := req.Context()
 
@@ -109,6 +119,30 @@ func AuthorizeRequest(next http.Handler, keyFunc KeyProvider, clusterUUIDResolve
 			}
 		}
 
+		if claims.ID == "" {
+			if err = checkUntrackedToken(claims, untrackedTokensCutoff, time.Now()); err != nil {
+				ctxzap.Error(ctx, "token is not allowed", zap.Error(err))
+
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(err.Error())) //nolint:errcheck
+
+				return
+			}
+		} else {
+			if err = checkIssuedToken(ctx, issuedTokenResolver, claims, req.URL.Path); err != nil {
+				ctxzap.Error(ctx, "token is not allowed", zap.String("token_id", claims.ID), zap.Error(err))
+
+				if errors.Is(err, errNamespaceNotAllowed) {
+					w.WriteHeader(http.StatusForbidden)
+					w.Write([]byte(err.Error())) //nolint:errcheck
+				} else {
+					w.WriteHeader(http.StatusUnauthorized)
+				}
+
+				return
+			}
+		}
+
 		// clone the request before modifying it
 		req = req.WithContext(ctxstore.WithValue(ctx, clusterContextKey{ClusterName: clusterName}))
 
@@ -38,7 +38,22 @@ var mockClusterUUIDResolver = func(_ context.Context, clusterID resource.ID) (st
 	return upper, nil
 }
 
+var mockIssuedTokenResolver = func(_ context.Context, tokenID string) (*k8sproxy.IssuedToken, error) {
+	switch tokenID {
+	case "token1":
+		return &k8sproxy.IssuedToken{Cluster: "cluster1", Subject: "user1"}, nil
+	case "token2":
+		return &k8sproxy.IssuedToken{Cluster: "cluster1", Subject: "user1", Namespaces: []string{"apps", "monitoring"}}, nil
+	case "revoked":
+		return &k8sproxy.IssuedToken{Cluster: "cluster1", Subject: "user1", Revoked: true}, nil
+	default:
+		return nil, fmt.Errorf("token %q not found", tokenID)
+	}
+}
+
 type mockClaims struct {
+	ID          string           `json:"jti,omitempty"`
+	Issuer      string           `json:"iss,omitempty"`
 	ExpiresAt   *jwt.NumericDate `json:"exp,omitempty"`
 	Cluster     string           `json:"cluster,omitempty"`
 	ClusterUUID string           `json:"cluster_uuid,omitempty"`
@@ -74,7 +89,7 @@ func TestAuthorize(t *testing.T) {
 		}
 	}
 
-	ts := httptest.NewServer(k8sproxy.AuthorizeRequest(coreHandler, keyFunc, mockClusterUUIDResolver))
+	ts := httptest.NewServer(k8sproxy.AuthorizeRequest(coreHandler, keyFunc, mockClusterUUIDResolver, mockIssuedTokenResolver, time.Now().Add(-time.Hour)))
 	defer ts.Close()
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -89,6 +104,7 @@ func TestAuthorize(t *testing.T) {
 
 		claims       mockClaims
 		kid          string
+		path         string
 		signingKey   *rsa.PrivateKey
 		extraHeaders map[string]string
 
@@ -237,11 +253,258 @@ func TestAuthorize(t *testing.T) {
 
 			expectedCode: http.StatusUnauthorized,
 		},
+		{
+			name: "tracked token",
+			claims: mockClaims{
+				ID:          "token1",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/api/v1/nodes",
+
+			expectedCode: http.StatusOK,
+
+			expectedImpersonateUser:   "user1",
+			expectedImpersonateGroups: []string{"group1"},
+			expectedCluster:           "cluster1",
+		},
+		{
+			name: "revoked token",
+			claims: mockClaims{
+				ID:          "revoked",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/api/v1/namespaces/apps/pods",
+
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "unknown token",
+			claims: mockClaims{
+				ID:          "token3",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/api/v1/namespaces/apps/pods",
+
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "subject mismatch",
+			claims: mockClaims{
+				ID:          "token1",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user2",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/api/v1/namespaces/apps/pods",
+
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "allowed namespace",
+			claims: mockClaims{
+				ID:          "token2",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/api/v1/namespaces/apps/pods/echo/log",
+
+			expectedCode: http.StatusOK,
+
+			expectedImpersonateUser:   "user1",
+			expectedImpersonateGroups: []string{"group1"},
+			expectedCluster:           "cluster1",
+		},
+		{
+			name: "allowed namespace watch",
+			claims: mockClaims{
+				ID:          "token2",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/apis/apps/v1/watch/namespaces/monitoring/deployments",
+
+			expectedCode: http.StatusOK,
+
+			expectedImpersonateUser:   "user1",
+			expectedImpersonateGroups: []string{"group1"},
+			expectedCluster:           "cluster1",
+		},
+		{
+			name: "discovery with namespaces",
+			claims: mockClaims{
+				ID:          "token2",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/apis/apps/v1",
+
+			expectedCode: http.StatusOK,
+
+			expectedImpersonateUser:   "user1",
+			expectedImpersonateGroups: []string{"group1"},
+			expectedCluster:           "cluster1",
+		},
+		{
+			name: "non-resource with namespaces",
+			claims: mockClaims{
+				ID:          "token2",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/version",
+
+			expectedCode: http.StatusOK,
+
+			expectedImpersonateUser:   "user1",
+			expectedImpersonateGroups: []string{"group1"},
+			expectedCluster:           "cluster1",
+		},
+		{
+			name: "denied namespace",
+			claims: mockClaims{
+				ID:          "token2",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/apis/apps/v1/namespaces/kube-system/deployments",
+
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "denied cluster-scoped",
+			claims: mockClaims{
+				ID:          "token2",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/api/v1/nodes",
+
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "denied all namespaces",
+			claims: mockClaims{
+				ID:          "token2",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/api/v1/pods",
+
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "denied namespace list",
+			claims: mockClaims{
+				ID:          "token2",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+			path:       "/api/v1/namespaces",
+
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "untracked service account token after the cutoff",
+			claims: mockClaims{
+				Issuer:      "omni-test-service-account-issuer",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "tracked service account token after the cutoff",
+			claims: mockClaims{
+				ID:          "token1",
+				Issuer:      "omni-test-service-account-issuer",
+				Cluster:     "cluster1",
+				ClusterUUID: "CLUSTER1",
+				Subject:     "user1",
+				Groups:      []string{"group1"},
+				ExpiresAt:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			kid:        "1",
+			signingKey: key1,
+
+			expectedCode: http.StatusOK,
+
+			expectedImpersonateUser:   "user1",
+			expectedImpersonateGroups: []string{"group1"},
+			expectedCluster:           "cluster1",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+tc.path, nil)
 			require.NoError(t, err)
 
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, &tc.claims)
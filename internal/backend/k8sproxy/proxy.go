@@ -10,9 +10,11 @@ import (
 	"net/http/httputil"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/omni/internal/backend/logging"
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
 	"github.com/siderolabs/omni/internal/pkg/ctxstore"
 )
 
@@ -22,16 +24,27 @@ type proxyHandler struct {
 	proxy       *httputil.ReverseProxy
 }
 
-func newProxyHandler(m *multiplexer, logger *zap.Logger) *proxyHandler {
+func newProxyHandler(m *multiplexer, recorder *sessionrecording.Recorder, logger *zap.Logger) *proxyHandler {
 	p := &proxyHandler{
 		multiplexer: m,
 	}
 
 	logger = logger.With(logging.Component("k8s_proxy"))
 
+	var rt http.RoundTripper = m
+
+	if recorder != nil {
+		rt = &recordingTransport{
+			rt:       m,
+			recorder: recorder,
+		}
+	}
+
+	rt = otelhttp.NewTransport(rt)
+
 	p.proxy = &httputil.ReverseProxy{
 		Director:  p.director,
-		Transport: m,
+		Transport: rt,
 		ErrorLog:  zap.NewStdLog(logger),
 		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
 			ctxzap.Error(r.Context(), "proxy handling error", zap.Error(err))
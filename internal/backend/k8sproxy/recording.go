@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package k8sproxy
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+
+	"k8s.io/client-go/transport"
+
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+// interactivePathRegexp matches the pod subresources which open an interactive stream.
+var interactivePathRegexp = regexp.MustCompile(`^/api/v1/namespaces/[^/]+/pods/[^/]+/(exec|attach|portforward)$`)
+
+// recordingTransport records the interactive pod sessions passing through the proxy.
+type recordingTransport struct {
+	rt       http.RoundTripper
+	recorder *sessionrecording.Recorder
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	clusterNameVal, ok := ctxstore.Value[clusterContextKey](req.Context())
+	if !ok || !interactivePathRegexp.MatchString(req.URL.Path) || !t.recorder.ShouldRecord(clusterNameVal.ClusterName) {
+		return t.rt.RoundTrip(req)
+	}
+
+	method := req.Method + " " + req.URL.Path
+	if req.URL.RawQuery != "" {
+		method += "?" + req.URL.RawQuery
+	}
+
+	session, err := t.recorder.Start(sessionrecording.Metadata{
+		Type:     sessionrecording.TypeKubernetes,
+		Cluster:  clusterNameVal.ClusterName,
+		Identity: req.Header.Get(transport.ImpersonateUserHeader),
+		Method:   method,
+	})
+	if err != nil {
+		// the session is not allowed if it can't be recorded
+		return nil, fmt.Errorf("failed to start session recording: %w", err)
+	}
+
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil {
+		session.Close(err) //nolint:errcheck
+
+		return nil, err
+	}
+
+	stream, ok := resp.Body.(io.ReadWriteCloser)
+	if resp.StatusCode != http.StatusSwitchingProtocols || !ok {
+		session.Close(fmt.Errorf("upgrade failed: %s", resp.Status)) //nolint:errcheck
+
+		return resp, nil
+	}
+
+	resp.Body = sessionrecording.NewStreamRecorder(stream, session)
+
+	return resp, nil
+}
@@ -14,6 +14,8 @@ import (
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/omni/internal/pkg/tracing"
 )
 
 // Handler adds structured logging to each request going through a wrapped handler.
@@ -36,7 +38,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		zap.String("request_url", r.RequestURI),
 		zap.String("method", r.Method),
 		zap.String("remote_addr", remoteAddr),
-	).With(h.fields...)
+	).With(h.fields...).With(tracing.LogFields(r.Context())...)
 
 	// inject empty ctxtags and logger into request context
 	r = r.WithContext(
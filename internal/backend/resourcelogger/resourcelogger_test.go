@@ -186,9 +186,9 @@ func TestResourceLogger(t *testing.T) {
          addresses:
              - 1.2.3.4
              - 5.6.7.8
-@@ -25,10 +17,10 @@
-               linkup: true
+@@ -26,10 +18,10 @@
                description: hello
+               statechanges: 0
      lasterror: ""
 -    managementaddress: some-address
 +    managementaddress: some-address-updated
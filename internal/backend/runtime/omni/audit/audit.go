@@ -95,8 +95,8 @@ func (l *Log) LogUpdateWithConflicts(ptr resource.Pointer) UpdateWithConflictsHo
 	return l.updateWithConflictsHooks[ptr.Type()]
 }
 
-// AuditTalosAccess logs the talos access event.
-func (l *Log) AuditTalosAccess(ctx context.Context, fullMethodName string, clusterID string, nodeID string) error {
+// AuditTalosAccess logs the talos access event. Non-empty deny reason marks the access as denied.
+func (l *Log) AuditTalosAccess(ctx context.Context, fullMethodName string, clusterID string, nodeID string, denyReason string) error {
 	data := extractData(ctx, options{
 		userAgent:     internalAgent,
 		newDataIfNone: true,
@@ -112,6 +112,8 @@ func (l *Log) AuditTalosAccess(ctx context.Context, fullMethodName string, clust
 	data.TalosAccess.FullMethodName = fullMethodName
 	data.TalosAccess.ClusterName = clusterID
 	data.TalosAccess.MachineIP = nodeID
+	data.TalosAccess.Denied = denyReason != ""
+	data.TalosAccess.DenyReason = denyReason
 
 	return l.logFile.Dump(event{
 		Type: "talos_access",
@@ -29,6 +29,7 @@ type Data struct {
 	MachineSet     *MachineSet     `json:"machine_set,omitempty"`
 	MachineSetNode *MachineSetNode `json:"machine_set_node,omitempty"`
 	ConfigPatch    *ConfigPatch    `json:"config_patch,omitempty"`
+	ChangeRequest  *ChangeRequest  `json:"change_request,omitempty"`
 	TalosAccess    *TalosAccess    `json:"talos_access,omitempty"`
 	K8SAccess      *K8SAccess      `json:"k8s_access,omitempty"`
 	Session        Session         `json:"session,omitempty"`
@@ -74,6 +75,7 @@ type AccessPolicy struct {
 	UserGroups    map[string]*specs.AccessPolicyUserGroup    `json:"user_groups,omitempty"`
 	Rules         []*specs.AccessPolicyRule                  `json:"rules,omitempty"`
 	Tests         []*specs.AccessPolicyTest                  `json:"tests,omitempty"`
+	TalosAPIRules []*specs.AccessPolicyTalosAPIRule          `json:"talos_api_rules,omitempty"`
 }
 
 // Cluster struct contains information about the cluster.
@@ -111,11 +113,24 @@ type ConfigPatch struct {
 	Data   string            `json:"data,omitempty"`
 }
 
+// ChangeRequest struct contains information about the change request.
+type ChangeRequest struct {
+	ID           string `json:"id,omitempty"`
+	Operation    string `json:"operation,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	RequestedBy  string `json:"requested_by,omitempty"`
+	ReviewedBy   string `json:"reviewed_by,omitempty"`
+	Phase        string `json:"phase,omitempty"`
+}
+
 // TalosAccess struct contains information about the access to the Talos node.
 type TalosAccess struct {
 	FullMethodName string `json:"full_method_name,omitempty"`
 	ClusterName    string `json:"cluster_name,omitempty"`
 	MachineIP      string `json:"machine_ip,omitempty"`
+	DenyReason     string `json:"deny_reason,omitempty"`
+	Denied         bool   `json:"denied,omitempty"`
 }
 
 // K8SAccess struct contains information about the access to the Kubernetes cluster.
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"maps"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
@@ -70,6 +71,10 @@ func Init(a *audit.Log) {
 	audit.ShouldLogUpdate(a, configPatchUpdate, audit.WithInternalAgent())
 	audit.ShouldLogUpdateWithConflicts(a, configPatchUpdate, audit.WithInternalAgent())
 	audit.ShouldLogDestroy(a, omni.ConfigPatchType, configPatchDestroy, audit.WithInternalAgent())
+
+	audit.ShouldLogCreate(a, changeRequestCreate, audit.WithInternalAgent())
+	audit.ShouldLogUpdate(a, changeRequestUpdate, audit.WithInternalAgent())
+	audit.ShouldLogUpdateWithConflicts(a, changeRequestUpdate, audit.WithInternalAgent())
 }
 
 func publicKeyCreate(_ context.Context, data *audit.Data, res *auth.PublicKey, _ ...state.CreateOption) error {
@@ -250,6 +255,7 @@ func handleAccessPolicy(data *audit.Data, res *auth.AccessPolicy) error {
 	data.AccessPolicy.UserGroups = res.TypedSpec().Value.GetUserGroups()
 	data.AccessPolicy.Rules = res.TypedSpec().Value.GetRules()
 	data.AccessPolicy.Tests = res.TypedSpec().Value.GetTests()
+	data.AccessPolicy.TalosAPIRules = res.TypedSpec().Value.GetTalosApiRules()
 
 	return nil
 }
@@ -389,6 +395,32 @@ func configPatchDestroy(_ context.Context, data *audit.Data, ptr resource.Pointe
 	return nil
 }
 
+func changeRequestCreate(_ context.Context, data *audit.Data, res *omni.ChangeRequest, _ ...state.CreateOption) error {
+	handleChangeRequest(data, res)
+
+	return nil
+}
+
+func changeRequestUpdate(_ context.Context, data *audit.Data, _, newRes *omni.ChangeRequest, _ ...state.UpdateOption) error {
+	handleChangeRequest(data, newRes)
+
+	return nil
+}
+
+func handleChangeRequest(data *audit.Data, res *omni.ChangeRequest) {
+	initPtrField(&data.ChangeRequest)
+
+	spec := res.TypedSpec().Value
+
+	data.ChangeRequest.ID = res.Metadata().ID()
+	data.ChangeRequest.Operation = spec.Operation
+	data.ChangeRequest.ResourceType = spec.ResourceType
+	data.ChangeRequest.ResourceID = spec.ResourceId
+	data.ChangeRequest.RequestedBy = spec.RequestedBy
+	data.ChangeRequest.ReviewedBy = spec.ReviewedBy
+	data.ChangeRequest.Phase = strings.ToLower(spec.Phase.String())
+}
+
 func initPtrField[T any](v **T) {
 	if *v == nil {
 		*v = new(T)
@@ -0,0 +1,395 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+
+	"github.com/siderolabs/omni/client/api/common"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
+	"github.com/siderolabs/omni/internal/pkg/addon"
+	"github.com/siderolabs/omni/internal/pkg/addon/helm"
+)
+
+// AddonStatusControllerName is the name of the AddonStatusController.
+const AddonStatusControllerName = "AddonStatusController"
+
+// AddonSyncInterval is the interval of the addon sync, which reverts the changes made to the addon objects in the cluster.
+const AddonSyncInterval = 5 * time.Minute
+
+// AddonApplier applies the addon objects to the workload clusters.
+type AddonApplier interface {
+	APIVersions(ctx context.Context, clusterID string) ([]string, error)
+	Apply(ctx context.Context, clusterID, namespace string, objects []*unstructured.Unstructured) (int, error)
+	ApplyHooks(ctx context.Context, clusterID, namespace string, hooks []*unstructured.Unstructured) error
+	Delete(ctx context.Context, clusterID, addonID string, objects []*unstructured.Unstructured) error
+}
+
+// KubernetesAddonApplier applies the addon objects using the Kubernetes runtime.
+type KubernetesAddonApplier struct {
+	Logger *zap.Logger
+}
+
+func (applier *KubernetesAddonApplier) config(ctx context.Context, clusterID string) (*rest.Config, error) {
+	type kubernetesConfigurator interface {
+		GetKubeconfig(ctx context.Context, context *common.Context) (*rest.Config, error)
+	}
+
+	kubernetesRuntime, err := runtime.LookupInterface[kubernetesConfigurator](kubernetes.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetesRuntime.GetKubeconfig(ctx, &common.Context{Name: clusterID})
+}
+
+// APIVersions implements AddonApplier.
+func (applier *KubernetesAddonApplier) APIVersions(ctx context.Context, clusterID string) ([]string, error) {
+	config, err := applier.config(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return addon.APIVersions(config)
+}
+
+// Apply implements AddonApplier.
+func (applier *KubernetesAddonApplier) Apply(ctx context.Context, clusterID, namespace string, objects []*unstructured.Unstructured) (int, error) {
+	config, err := applier.config(ctx, clusterID)
+	if err != nil {
+		return 0, err
+	}
+
+	return addon.Apply(ctx, config, namespace, objects, applier.Logger)
+}
+
+// ApplyHooks implements AddonApplier.
+func (applier *KubernetesAddonApplier) ApplyHooks(ctx context.Context, clusterID, namespace string, hooks []*unstructured.Unstructured) error {
+	config, err := applier.config(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	return addon.ApplyHooks(ctx, config, namespace, hooks, applier.Logger)
+}
+
+// Delete implements AddonApplier.
+func (applier *KubernetesAddonApplier) Delete(ctx context.Context, clusterID, addonID string, objects []*unstructured.Unstructured) error {
+	config, err := applier.config(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	return addon.Delete(ctx, config, addonID, objects)
+}
+
+// AddonStatusController installs the addons into the matching clusters and keeps them in sync.
+//
+// The addons are synced periodically, the changes made to the addon objects in the cluster are reverted and reported as out of sync.
+// The Helm hooks are run only when the addon is installed or changed, the same as Helm runs them on the installation and the upgrades of the release.
+// The objects are pruned only if the addon has pruning enabled, the objects are never removed when the cluster is destroyed.
+type AddonStatusController struct {
+	applier  AddonApplier
+	renderer *addon.Renderer
+	generic.NamedController
+}
+
+// NewAddonStatusController initializes AddonStatusController.
+func NewAddonStatusController(applier AddonApplier, fetcher *helm.Fetcher) *AddonStatusController {
+	return &AddonStatusController{
+		NamedController: generic.NamedController{
+			ControllerName: AddonStatusControllerName,
+		},
+		applier:  applier,
+		renderer: addon.NewRenderer(fetcher),
+	}
+}
+
+// Settings implements controller.QController interface.
+func (ctrl *AddonStatusController) Settings() controller.QSettings {
+	return controller.QSettings{
+		Inputs: []controller.Input{
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ClusterType,
+				Kind:      controller.InputQPrimary,
+			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ClusterStatusType,
+				Kind:      controller.InputQMapped,
+			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.AddonType,
+				Kind:      controller.InputQMapped,
+			},
+		},
+		Outputs: []controller.Output{
+			{
+				Kind: controller.OutputExclusive,
+				Type: omni.AddonStatusType,
+			},
+		},
+		Concurrency: optional.Some[uint](4),
+	}
+}
+
+// MapInput implements controller.QController interface.
+func (ctrl *AddonStatusController) MapInput(ctx context.Context, _ *zap.Logger, r controller.QRuntime, ptr resource.Pointer) ([]resource.Pointer, error) {
+	switch ptr.Type() {
+	case omni.ClusterStatusType:
+		return []resource.Pointer{omni.NewCluster(resources.DefaultNamespace, ptr.ID()).Metadata()}, nil
+	case omni.AddonType:
+		clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, r)
+		if err != nil {
+			return nil, err
+		}
+
+		pointers := make([]resource.Pointer, 0, clusters.Len())
+
+		for cluster := range clusters.All() {
+			pointers = append(pointers, cluster.Metadata())
+		}
+
+		return pointers, nil
+	}
+
+	return nil, fmt.Errorf("unexpected resource type %q", ptr.Type())
+}
+
+// Reconcile implements controller.QController interface.
+func (ctrl *AddonStatusController) Reconcile(ctx context.Context, logger *zap.Logger, r controller.QRuntime, ptr resource.Pointer) error {
+	statuses, err := safe.ReaderListAll[*omni.AddonStatus](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, ptr.ID())))
+	if err != nil {
+		return err
+	}
+
+	cluster, err := safe.ReaderGet[*omni.Cluster](ctx, r, omni.NewCluster(resources.DefaultNamespace, ptr.ID()).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	// the objects are not pruned from the clusters being destroyed
+	if cluster == nil || cluster.Metadata().Phase() == resource.PhaseTearingDown {
+		for status := range statuses.All() {
+			if err = r.Destroy(ctx, status.Metadata()); err != nil && !state.IsNotFoundError(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	clusterStatus, err := safe.ReaderGet[*omni.ClusterStatus](ctx, r, omni.NewClusterStatus(resources.DefaultNamespace, ptr.ID()).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	if clusterStatus == nil || !clusterStatus.TypedSpec().Value.Ready || clusterStatus.TypedSpec().Value.Phase != specs.ClusterStatusSpec_RUNNING {
+		return nil
+	}
+
+	addons, err := safe.ReaderListAll[*omni.Addon](ctx, r)
+	if err != nil {
+		return err
+	}
+
+	matched := map[resource.ID]struct{}{}
+
+	for addonRes := range addons.All() {
+		if addonRes.Metadata().Phase() == resource.PhaseTearingDown {
+			continue
+		}
+
+		matches, err := omni.AddonMatchesCluster(addonRes, cluster)
+		if err != nil {
+			logger.Warn("invalid addon", zap.String("addon", addonRes.Metadata().ID()), zap.Error(err))
+
+			continue
+		}
+
+		if !matches {
+			continue
+		}
+
+		matched[omni.AddonStatusID(cluster.Metadata().ID(), addonRes.Metadata().ID())] = struct{}{}
+
+		if err = ctrl.sync(ctx, logger, r, cluster, addonRes); err != nil {
+			return err
+		}
+	}
+
+	for status := range statuses.All() {
+		if _, ok := matched[status.Metadata().ID()]; ok {
+			continue
+		}
+
+		if status.TypedSpec().Value.Prune {
+			addonID, _ := status.Metadata().Labels().Get(omni.LabelAddon)
+
+			if err = ctrl.prune(ctx, cluster.Metadata().ID(), addonID, status.TypedSpec().Value.Objects); err != nil {
+				return fmt.Errorf("failed to prune the objects of the removed addon: %w", err)
+			}
+		}
+
+		if err = r.Destroy(ctx, status.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+	}
+
+	if len(matched) == 0 {
+		return nil
+	}
+
+	return controller.NewRequeueInterval(AddonSyncInterval)
+}
+
+func (ctrl *AddonStatusController) sync(ctx context.Context, logger *zap.Logger, r controller.QRuntime, cluster *omni.Cluster, addonRes *omni.Addon) error {
+	clusterID := cluster.Metadata().ID()
+	spec := addonRes.TypedSpec().Value
+
+	status, err := safe.ReaderGet[*omni.AddonStatus](ctx, r, omni.NewAddonStatus(resources.DefaultNamespace, omni.AddonStatusID(clusterID, addonRes.Metadata().ID())).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	var (
+		previous        []*specs.AddonStatusSpec_Object
+		previousVersion string
+		previousPhase   specs.AddonStatusSpec_Phase
+	)
+
+	if status != nil {
+		previous = status.TypedSpec().Value.Objects
+		previousVersion = status.TypedSpec().Value.AddonVersion
+		previousPhase = status.TypedSpec().Value.Phase
+	}
+
+	// the addon is upgraded if it was applied before, the hooks are run until the changed addon is applied successfully
+	upgrade := previousVersion != ""
+	runHooks := previousPhase != specs.AddonStatusSpec_APPLIED || previousVersion != addonRes.Metadata().Version().String()
+
+	objects, changed, syncErr := ctrl.apply(ctx, cluster, addonRes, upgrade, runHooks)
+
+	refs := make([]*specs.AddonStatusSpec_Object, 0, len(objects))
+
+	for _, obj := range objects {
+		refs = append(refs, addon.ObjectRef(obj))
+	}
+
+	if syncErr == nil && spec.Prune {
+		syncErr = ctrl.prune(ctx, clusterID, addonRes.Metadata().ID(), addon.RemovedObjects(previous, refs))
+	}
+
+	if syncErr != nil {
+		logger.Warn("failed to sync the addon", zap.String("cluster", clusterID), zap.String("addon", addonRes.Metadata().ID()), zap.Error(syncErr))
+	}
+
+	return safe.WriterModify(ctx, r, omni.NewAddonStatus(resources.DefaultNamespace, omni.AddonStatusID(clusterID, addonRes.Metadata().ID())),
+		func(res *omni.AddonStatus) error {
+			res.Metadata().Labels().Set(omni.LabelCluster, clusterID)
+			res.Metadata().Labels().Set(omni.LabelAddon, addonRes.Metadata().ID())
+
+			res.TypedSpec().Value.Prune = spec.Prune
+			res.TypedSpec().Value.LastSync = timestamppb.Now()
+
+			if syncErr != nil {
+				res.TypedSpec().Value.Phase = specs.AddonStatusSpec_FAILED
+				res.TypedSpec().Value.Error = syncErr.Error()
+
+				return nil
+			}
+
+			res.TypedSpec().Value.OutOfSync = 0
+
+			// the changes are reported as out of sync only if the addon itself didn't change since the last sync
+			if previousPhase == specs.AddonStatusSpec_APPLIED && previousVersion == addonRes.Metadata().Version().String() {
+				res.TypedSpec().Value.OutOfSync = uint32(changed) //nolint:gosec
+			}
+
+			res.TypedSpec().Value.Phase = specs.AddonStatusSpec_APPLIED
+			res.TypedSpec().Value.Error = ""
+			res.TypedSpec().Value.Objects = refs
+			res.TypedSpec().Value.AddonVersion = addonRes.Metadata().Version().String()
+
+			return nil
+		},
+	)
+}
+
+func (ctrl *AddonStatusController) apply(ctx context.Context, cluster *omni.Cluster, addonRes *omni.Addon, upgrade, runHooks bool) ([]*unstructured.Unstructured, int, error) {
+	capabilities := helm.Capabilities{
+		KubeVersion: cluster.TypedSpec().Value.KubernetesVersion,
+	}
+
+	if addonRes.TypedSpec().Value.HelmChart != nil {
+		apiVersions, err := ctrl.applier.APIVersions(ctx, cluster.Metadata().ID())
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get the API versions: %w", err)
+		}
+
+		capabilities.APIVersions = apiVersions
+	}
+
+	objects, err := ctrl.renderer.Render(ctx, addonRes, capabilities, upgrade)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	namespace := addon.Namespace(addonRes.TypedSpec().Value)
+
+	if runHooks {
+		if err = ctrl.applier.ApplyHooks(ctx, cluster.Metadata().ID(), namespace, objects.PreHooks); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	changed, err := ctrl.applier.Apply(ctx, cluster.Metadata().ID(), namespace, objects.Objects)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if runHooks {
+		if err = ctrl.applier.ApplyHooks(ctx, cluster.Metadata().ID(), namespace, objects.PostHooks); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return objects.Objects, changed, nil
+}
+
+// prune deletes the objects removed from the addon, the objects which are no longer labeled with the addon ID are kept.
+func (ctrl *AddonStatusController) prune(ctx context.Context, clusterID, addonID string, refs []*specs.AddonStatusSpec_Object) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	objects := make([]*unstructured.Unstructured, 0, len(refs))
+
+	for _, ref := range refs {
+		objects = append(objects, addon.ObjectFromRef(ref))
+	}
+
+	return ctrl.applier.Delete(ctx, clusterID, addonID, objects)
+}
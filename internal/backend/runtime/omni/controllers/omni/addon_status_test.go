@@ -0,0 +1,352 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/addon/helm"
+)
+
+type fakeAddonApplier struct {
+	applied map[string][]string
+	deleted map[string][]string
+	hooks   map[string][]string
+	changed int
+	mu      sync.Mutex
+}
+
+func (applier *fakeAddonApplier) APIVersions(context.Context, string) ([]string, error) {
+	return nil, nil
+}
+
+func (applier *fakeAddonApplier) Apply(_ context.Context, clusterID, namespace string, objects []*unstructured.Unstructured) (int, error) {
+	applier.mu.Lock()
+	defer applier.mu.Unlock()
+
+	applier.applied[clusterID] = nil
+
+	for _, obj := range objects {
+		applier.applied[clusterID] = append(applier.applied[clusterID], namespace+"/"+obj.GetKind()+"/"+obj.GetName())
+	}
+
+	return applier.changed, nil
+}
+
+func (applier *fakeAddonApplier) ApplyHooks(_ context.Context, clusterID, namespace string, hooks []*unstructured.Unstructured) error {
+	applier.mu.Lock()
+	defer applier.mu.Unlock()
+
+	for _, hook := range hooks {
+		applier.hooks[clusterID] = append(applier.hooks[clusterID], namespace+"/"+hook.GetKind()+"/"+hook.GetName())
+	}
+
+	return nil
+}
+
+func (applier *fakeAddonApplier) Delete(_ context.Context, clusterID, addonID string, objects []*unstructured.Unstructured) error {
+	applier.mu.Lock()
+	defer applier.mu.Unlock()
+
+	for _, obj := range objects {
+		applier.deleted[clusterID] = append(applier.deleted[clusterID], addonID+":"+obj.GetKind()+"/"+obj.GetName())
+	}
+
+	return nil
+}
+
+func (applier *fakeAddonApplier) setChanged(changed int) {
+	applier.mu.Lock()
+	defer applier.mu.Unlock()
+
+	applier.changed = changed
+}
+
+func (applier *fakeAddonApplier) get(clusterID string) (applied, deleted []string) {
+	applier.mu.Lock()
+	defer applier.mu.Unlock()
+
+	return applier.applied[clusterID], applier.deleted[clusterID]
+}
+
+func (applier *fakeAddonApplier) getHooks(clusterID string) []string {
+	applier.mu.Lock()
+	defer applier.mu.Unlock()
+
+	return slices.Clone(applier.hooks[clusterID])
+}
+
+type AddonStatusSuite struct {
+	OmniSuite
+}
+
+func (suite *AddonStatusSuite) createReadyCluster(id string, labels map[string]string) {
+	cluster := omni.NewCluster(resources.DefaultNamespace, id)
+	cluster.TypedSpec().Value.KubernetesVersion = "1.31.1"
+
+	for k, v := range labels {
+		cluster.Metadata().Labels().Set(k, v)
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, id)
+	clusterStatus.TypedSpec().Value.Ready = true
+	clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+}
+
+func (suite *AddonStatusSuite) TestSync() {
+	suite.startRuntime()
+
+	applier := &fakeAddonApplier{
+		applied: map[string][]string{},
+		deleted: map[string][]string{},
+		hooks:   map[string][]string{},
+		changed: 2,
+	}
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewAddonStatusController(applier, helm.NewFetcher(nil))))
+
+	suite.createReadyCluster("prod", map[string]string{"env": "prod"})
+	suite.createReadyCluster("dev", map[string]string{"env": "dev"})
+
+	addon := omni.NewAddon(resources.DefaultNamespace, "echo")
+	addon.TypedSpec().Value.ClusterSelector = "env=prod"
+	addon.TypedSpec().Value.Namespace = "apps"
+	addon.TypedSpec().Value.Prune = true
+	addon.TypedSpec().Value.Manifests = `apiVersion: v1
+kind: Secret
+metadata:
+  name: echo
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: echo
+`
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, addon))
+
+	statusID := omni.AddonStatusID("prod", "echo")
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{statusID}, func(res *omni.AddonStatus, assert *assert.Assertions) {
+		assert.Equal(specs.AddonStatusSpec_APPLIED, res.TypedSpec().Value.Phase)
+		assert.Len(res.TypedSpec().Value.Objects, 2)
+		assert.Zero(res.TypedSpec().Value.OutOfSync)
+
+		cluster, _ := res.Metadata().Labels().Get(omni.LabelCluster)
+		assert.Equal("prod", cluster)
+	})
+
+	rtestutils.AssertNoResource[*omni.AddonStatus](suite.ctx, suite.T(), suite.state, omni.AddonStatusID("dev", "echo"))
+
+	applied, _ := applier.get("prod")
+	suite.Assert().Equal([]string{"apps/Secret/echo", "apps/ConfigMap/echo"}, applied)
+
+	// the changes made in the cluster are reported on the next sync
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewClusterStatus(resources.DefaultNamespace, "prod").Metadata(), func(res *omni.ClusterStatus) error {
+		res.TypedSpec().Value.Machines = &specs.Machines{Total: 1}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{statusID}, func(res *omni.AddonStatus, assert *assert.Assertions) {
+		assert.EqualValues(2, res.TypedSpec().Value.OutOfSync)
+	})
+
+	applier.setChanged(0)
+
+	// the objects removed from the addon are pruned
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, addon.Metadata(), func(res *omni.Addon) error {
+		res.TypedSpec().Value.Manifests = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: echo
+`
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{statusID}, func(res *omni.AddonStatus, assert *assert.Assertions) {
+		assert.Len(res.TypedSpec().Value.Objects, 1)
+		assert.Zero(res.TypedSpec().Value.OutOfSync)
+	})
+
+	_, deleted := applier.get("prod")
+	suite.Assert().Equal([]string{"echo:Secret/echo"}, deleted)
+
+	// all objects are pruned when the cluster no longer matches the addon
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewCluster(resources.DefaultNamespace, "prod").Metadata(), func(res *omni.Cluster) error {
+		res.Metadata().Labels().Set("env", "staging")
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertNoResource[*omni.AddonStatus](suite.ctx, suite.T(), suite.state, statusID)
+
+	_, deleted = applier.get("prod")
+	suite.Assert().Equal([]string{"echo:Secret/echo", "echo:ConfigMap/echo"}, deleted)
+}
+
+func (suite *AddonStatusSuite) serveChart(chartName string, files map[string]string) string {
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, data := range files {
+		suite.Require().NoError(tw.WriteHeader(&tar.Header{
+			Name:     chartName + "/" + name,
+			Mode:     0o644,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}))
+
+		_, err := tw.Write([]byte(data))
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(tw.Close())
+	suite.Require().NoError(gz.Close())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/index.yaml", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, "apiVersion: v1\nentries:\n  %s:\n    - version: 0.1.0\n      urls: [%s-0.1.0.tgz]\n", chartName, chartName)
+	})
+	mux.HandleFunc("/"+chartName+"-0.1.0.tgz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(buf.Bytes()) //nolint:errcheck
+	})
+
+	server := httptest.NewServer(mux)
+	suite.T().Cleanup(server.Close)
+
+	return server.URL
+}
+
+func (suite *AddonStatusSuite) TestHooks() {
+	suite.startRuntime()
+
+	applier := &fakeAddonApplier{
+		applied: map[string][]string{},
+		deleted: map[string][]string{},
+		hooks:   map[string][]string{},
+	}
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewAddonStatusController(applier, helm.NewFetcher(nil))))
+
+	repository := suite.serveChart("hooks", map[string]string{
+		"Chart.yaml": "apiVersion: v2\nname: hooks\nversion: 0.1.0\n",
+		"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  value: "{{ .Values.value }}"
+`,
+		"templates/hooks.yaml": `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install,pre-upgrade
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: welcome
+  annotations:
+    helm.sh/hook: post-install
+`,
+	})
+
+	suite.createReadyCluster("prod", nil)
+
+	addon := omni.NewAddon(resources.DefaultNamespace, "hooks")
+	addon.TypedSpec().Value.Clusters = []string{"prod"}
+	addon.TypedSpec().Value.Namespace = "apps"
+	addon.TypedSpec().Value.HelmChart = &specs.AddonSpec_HelmChart{
+		Repository: repository,
+		Name:       "hooks",
+		Version:    "0.1.0",
+		Values:     "value: a",
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, addon))
+
+	statusID := omni.AddonStatusID("prod", "hooks")
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{statusID}, func(res *omni.AddonStatus, assert *assert.Assertions) {
+		assert.Equal(specs.AddonStatusSpec_APPLIED, res.TypedSpec().Value.Phase)
+		assert.Equal(addon.Metadata().Version().String(), res.TypedSpec().Value.AddonVersion)
+	})
+
+	// the hooks are not part of the addon objects
+	applied, _ := applier.get("prod")
+	suite.Assert().Equal([]string{"apps/ConfigMap/config"}, applied)
+	suite.Assert().Equal([]string{"apps/Job/migrate", "apps/Job/welcome"}, applier.getHooks("prod"))
+
+	status, err := safe.StateGetByID[*omni.AddonStatus](suite.ctx, suite.state, statusID)
+	suite.Require().NoError(err)
+
+	// the periodic syncs don't run the hooks
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewClusterStatus(resources.DefaultNamespace, "prod").Metadata(), func(res *omni.ClusterStatus) error {
+		res.TypedSpec().Value.Machines = &specs.Machines{Total: 1}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{statusID}, func(res *omni.AddonStatus, assert *assert.Assertions) {
+		assert.True(res.TypedSpec().Value.LastSync.AsTime().After(status.TypedSpec().Value.LastSync.AsTime()))
+	})
+
+	suite.Assert().Equal([]string{"apps/Job/migrate", "apps/Job/welcome"}, applier.getHooks("prod"))
+
+	// the changed addon is upgraded with the upgrade hooks
+	updated, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, addon.Metadata(), func(res *omni.Addon) error {
+		res.TypedSpec().Value.HelmChart.Values = "value: b"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{statusID}, func(res *omni.AddonStatus, assert *assert.Assertions) {
+		assert.Equal(updated.Metadata().Version().String(), res.TypedSpec().Value.AddonVersion)
+	})
+
+	suite.Assert().Equal([]string{"apps/Job/migrate", "apps/Job/welcome", "apps/Job/migrate"}, applier.getHooks("prod"))
+}
+
+func TestAddonStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(AddonStatusSuite))
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/admission"
+)
+
+// AdmissionPolicyStatusController reports whether the admission policies are enforced.
+//
+// The policies are validated on write, but they might stop compiling after the upgrade, e.g. if the resource types they refer to are gone.
+// Such policies are skipped on the resource writes, and the compilation error is reported in the status.
+type AdmissionPolicyStatusController = qtransform.QController[*omni.AdmissionPolicy, *omni.AdmissionPolicyStatus]
+
+// NewAdmissionPolicyStatusController initializes AdmissionPolicyStatusController.
+func NewAdmissionPolicyStatusController() *AdmissionPolicyStatusController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.AdmissionPolicy, *omni.AdmissionPolicyStatus]{
+			Name: "AdmissionPolicyStatusController",
+			MapMetadataFunc: func(policy *omni.AdmissionPolicy) *omni.AdmissionPolicyStatus {
+				return omni.NewAdmissionPolicyStatus(resources.DefaultNamespace, policy.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(status *omni.AdmissionPolicyStatus) *omni.AdmissionPolicy {
+				return omni.NewAdmissionPolicy(resources.DefaultNamespace, status.Metadata().ID())
+			},
+			TransformFunc: func(_ context.Context, _ controller.Reader, logger *zap.Logger, policy *omni.AdmissionPolicy, status *omni.AdmissionPolicyStatus) error {
+				status.TypedSpec().Value.Error = ""
+
+				if _, err := admission.Compile(policy); err != nil {
+					logger.Warn("admission policy is invalid and is not enforced", zap.String("policy", policy.Metadata().ID()), zap.Error(err))
+
+					status.TypedSpec().Value.Error = err.Error()
+				}
+
+				return nil
+			},
+		},
+	)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type AdmissionPolicyStatusSuite struct {
+	OmniSuite
+}
+
+func (suite *AdmissionPolicyStatusSuite) TestReconcile() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewAdmissionPolicyStatusController()))
+
+	valid := omni.NewAdmissionPolicy(resources.DefaultNamespace, "valid")
+	valid.TypedSpec().Value.ResourceTypes = []string{omni.ClusterType}
+	valid.TypedSpec().Value.Rules = []*specs.AdmissionPolicySpec_Rule{{Expression: "spec.features.disk_encryption", Message: "disk encryption is required"}}
+
+	// the policy referring to the resource type which is gone
+	invalid := omni.NewAdmissionPolicy(resources.DefaultNamespace, "invalid")
+	invalid.TypedSpec().Value.ResourceTypes = []string{"Gone.omni.sidero.dev"}
+	invalid.TypedSpec().Value.Rules = []*specs.AdmissionPolicySpec_Rule{{Expression: "true", Message: "m"}}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, valid))
+	suite.Require().NoError(suite.state.Create(suite.ctx, invalid))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{valid.Metadata().ID()},
+		func(status *omni.AdmissionPolicyStatus, assert *assert.Assertions) {
+			assert.Empty(status.TypedSpec().Value.Error)
+		},
+	)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{invalid.Metadata().ID()},
+		func(status *omni.AdmissionPolicyStatus, assert *assert.Assertions) {
+			assert.Contains(status.TypedSpec().Value.Error, "Gone.omni.sidero.dev")
+		},
+	)
+
+	rtestutils.Destroy[*omni.AdmissionPolicy](suite.ctx, suite.T(), suite.state, []resource.ID{invalid.Metadata().ID()})
+
+	rtestutils.AssertNoResource[*omni.AdmissionPolicyStatus](suite.ctx, suite.T(), suite.state, invalid.Metadata().ID())
+}
+
+func TestAdmissionPolicyStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(AdmissionPolicyStatusSuite))
+}
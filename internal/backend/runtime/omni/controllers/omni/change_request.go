@@ -0,0 +1,172 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// ChangeRequestControllerName is the name of the ChangeRequestController.
+const ChangeRequestControllerName = "ChangeRequestController"
+
+// ChangeRequestController applies the approved change requests.
+//
+// The writes are validated with the given validation options, as the state might have changed since the change was requested,
+// the change request fails if the validations fail.
+type ChangeRequestController struct {
+	state      state.State
+	applyState state.State
+	generic.NamedController
+}
+
+// NewChangeRequestController initializes ChangeRequestController.
+func NewChangeRequestController(st state.State, validationOptions ...validated.StateOption) *ChangeRequestController {
+	return &ChangeRequestController{
+		NamedController: generic.NamedController{
+			ControllerName: ChangeRequestControllerName,
+		},
+		state:      st,
+		applyState: state.WrapCore(validated.NewState(st, validationOptions...)),
+	}
+}
+
+// Settings implements controller.QController interface.
+func (ctrl *ChangeRequestController) Settings() controller.QSettings {
+	return controller.QSettings{
+		Inputs: []controller.Input{
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ChangeRequestType,
+				Kind:      controller.InputQPrimary,
+			},
+		},
+		Concurrency: optional.Some[uint](4),
+	}
+}
+
+// MapInput implements controller.QController interface.
+func (ctrl *ChangeRequestController) MapInput(context.Context, *zap.Logger,
+	controller.QRuntime, resource.Pointer,
+) ([]resource.Pointer, error) {
+	return nil, nil
+}
+
+// Reconcile implements controller.QController interface.
+func (ctrl *ChangeRequestController) Reconcile(ctx context.Context,
+	logger *zap.Logger, r controller.QRuntime, ptr resource.Pointer,
+) error {
+	request, err := safe.ReaderGet[*omni.ChangeRequest](ctx, r, omni.NewChangeRequest(resources.DefaultNamespace, ptr.ID()).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if request.Metadata().Phase() == resource.PhaseTearingDown || request.TypedSpec().Value.Phase != specs.ChangeRequestSpec_APPROVED {
+		return nil
+	}
+
+	applyErr := ctrl.apply(ctx, request.TypedSpec().Value)
+
+	var requeueErr *controller.RequeueError
+
+	if errors.As(applyErr, &requeueErr) {
+		return applyErr
+	}
+
+	phase := specs.ChangeRequestSpec_APPLIED
+	errorMessage := ""
+
+	if applyErr != nil {
+		phase = specs.ChangeRequestSpec_FAILED
+		errorMessage = applyErr.Error()
+
+		logger.Warn("failed to apply the change request", zap.String("request", request.Metadata().ID()), zap.Error(applyErr))
+	}
+
+	_, err = safe.StateUpdateWithConflicts(ctx, ctrl.state, request.Metadata(), func(res *omni.ChangeRequest) error {
+		res.TypedSpec().Value.Phase = phase
+		res.TypedSpec().Value.Error = errorMessage
+
+		return nil
+	})
+
+	if state.IsNotFoundError(err) || state.IsPhaseConflictError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (ctrl *ChangeRequestController) apply(ctx context.Context, spec *specs.ChangeRequestSpec) error {
+	// the change is applied on behalf of the user who requested it, so it is not exempt from the admission policies
+	ctx = actor.UnmarkContextAsInternalActor(ctx)
+
+	res, err := omni.DecodeChangeRequestResource(spec.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to decode the requested resource: %w", err)
+	}
+
+	if spec.Operation == omni.ChangeRequestOperationCreate {
+		res.Metadata().SetVersion(resource.VersionUndefined)
+
+		return ctrl.applyState.Create(ctx, res)
+	}
+
+	existing, err := ctrl.applyState.Get(ctx, res.Metadata())
+	if err != nil {
+		return err
+	}
+
+	if spec.Operation == omni.ChangeRequestOperationDestroy {
+		return ctrl.destroy(ctx, existing, spec.ResourceVersion)
+	}
+
+	if existing.Metadata().Version().String() != spec.ResourceVersion {
+		return fmt.Errorf("the resource was modified after the change was requested: version %s, requested %s", existing.Metadata().Version(), spec.ResourceVersion)
+	}
+
+	res.Metadata().SetVersion(existing.Metadata().Version())
+
+	return ctrl.applyState.Update(ctx, res)
+}
+
+func (ctrl *ChangeRequestController) destroy(ctx context.Context, existing resource.Resource, requestedVersion string) error {
+	// the resource might be already torn down by the previous reconcile
+	if existing.Metadata().Phase() == resource.PhaseRunning && existing.Metadata().Version().String() != requestedVersion {
+		return fmt.Errorf("the resource was modified after the change was requested: version %s, requested %s", existing.Metadata().Version(), requestedVersion)
+	}
+
+	ready, err := ctrl.applyState.Teardown(ctx, existing.Metadata())
+	if err != nil {
+		return err
+	}
+
+	if !ready {
+		return controller.NewRequeueInterval(time.Second * 5)
+	}
+
+	return ctrl.applyState.Destroy(ctx, existing.Metadata())
+}
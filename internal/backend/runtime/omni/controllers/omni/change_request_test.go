@@ -0,0 +1,135 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+type ChangeRequestSuite struct {
+	OmniSuite
+}
+
+func (suite *ChangeRequestSuite) createRequest(id, operation string, res resource.Resource, phase specs.ChangeRequestSpec_Phase) {
+	data, err := omni.EncodeChangeRequestResource(res)
+	suite.Require().NoError(err)
+
+	request := omni.NewChangeRequest(resources.DefaultNamespace, id)
+	request.TypedSpec().Value.Operation = operation
+	request.TypedSpec().Value.ResourceNamespace = res.Metadata().Namespace()
+	request.TypedSpec().Value.ResourceType = res.Metadata().Type()
+	request.TypedSpec().Value.ResourceId = res.Metadata().ID()
+	request.TypedSpec().Value.Resource = data
+	request.TypedSpec().Value.ResourceVersion = res.Metadata().Version().String()
+	request.TypedSpec().Value.Phase = phase
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, request))
+}
+
+func (suite *ChangeRequestSuite) assertPhase(id string, phase specs.ChangeRequestSpec_Phase, errorMessage string) {
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{id}, func(request *omni.ChangeRequest, assert *assert.Assertions) {
+		assert.Equal(phase, request.TypedSpec().Value.Phase)
+		assert.Contains(request.TypedSpec().Value.Error, errorMessage)
+	})
+}
+
+func (suite *ChangeRequestSuite) TestApply() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewChangeRequestController(suite.state)))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "cluster")
+	cluster.TypedSpec().Value.KubernetesVersion = "1.30.1"
+
+	// pending requests are not applied
+	suite.createRequest("pending", omni.ChangeRequestOperationCreate, cluster, specs.ChangeRequestSpec_PENDING)
+	suite.createRequest("create", omni.ChangeRequestOperationCreate, cluster, specs.ChangeRequestSpec_APPROVED)
+
+	suite.assertPhase("create", specs.ChangeRequestSpec_APPLIED, "")
+	suite.assertPhase("pending", specs.ChangeRequestSpec_PENDING, "")
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{cluster.Metadata().ID()}, func(res *omni.Cluster, assert *assert.Assertions) {
+		assert.Equal("1.30.1", res.TypedSpec().Value.KubernetesVersion)
+	})
+
+	existing, err := suite.state.Get(suite.ctx, cluster.Metadata())
+	suite.Require().NoError(err)
+
+	updated := existing.DeepCopy()
+	updated.(*omni.Cluster).TypedSpec().Value.KubernetesVersion = "1.31.0" //nolint:forcetypeassert,errcheck
+
+	suite.createRequest("update", omni.ChangeRequestOperationUpdate, updated, specs.ChangeRequestSpec_APPROVED)
+	suite.assertPhase("update", specs.ChangeRequestSpec_APPLIED, "")
+
+	// the resource was modified after the request was created
+	suite.createRequest("stale", omni.ChangeRequestOperationUpdate, updated, specs.ChangeRequestSpec_APPROVED)
+	suite.assertPhase("stale", specs.ChangeRequestSpec_FAILED, "the resource was modified after the change was requested")
+
+	existing, err = suite.state.Get(suite.ctx, cluster.Metadata())
+	suite.Require().NoError(err)
+
+	suite.createRequest("destroy", omni.ChangeRequestOperationDestroy, existing, specs.ChangeRequestSpec_APPROVED)
+	suite.assertPhase("destroy", specs.ChangeRequestSpec_APPLIED, "")
+
+	rtestutils.AssertNoResource[*omni.Cluster](suite.ctx, suite.T(), suite.state, cluster.Metadata().ID())
+}
+
+func (suite *ChangeRequestSuite) TestApplyValidation() {
+	// the controllers run as the internal actor
+	suite.ctx = actor.MarkContextAsInternalActor(suite.ctx)
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewChangeRequestController(suite.state,
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.Cluster, _ ...state.CreateOption) error {
+			if actor.ContextIsInternalActor(ctx) {
+				return errors.New("the change is applied as the internal actor")
+			}
+
+			if res.TypedSpec().Value.KubernetesVersion == "1.29.0" {
+				return errors.New("kubernetes version 1.29.0 is denied")
+			}
+
+			return nil
+		})),
+	)))
+
+	denied := omni.NewCluster(resources.DefaultNamespace, "denied")
+	denied.TypedSpec().Value.KubernetesVersion = "1.29.0"
+
+	allowed := omni.NewCluster(resources.DefaultNamespace, "allowed")
+	allowed.TypedSpec().Value.KubernetesVersion = "1.30.1"
+
+	suite.createRequest("denied", omni.ChangeRequestOperationCreate, denied, specs.ChangeRequestSpec_APPROVED)
+	suite.createRequest("allowed", omni.ChangeRequestOperationCreate, allowed, specs.ChangeRequestSpec_APPROVED)
+
+	suite.assertPhase("denied", specs.ChangeRequestSpec_FAILED, "kubernetes version 1.29.0 is denied")
+	suite.assertPhase("allowed", specs.ChangeRequestSpec_APPLIED, "")
+
+	rtestutils.AssertNoResource[*omni.Cluster](suite.ctx, suite.T(), suite.state, denied.Metadata().ID())
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{allowed.Metadata().ID()}, func(*omni.Cluster, *assert.Assertions) {})
+}
+
+func TestChangeRequestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(ChangeRequestSuite))
+}
@@ -13,6 +13,7 @@ import (
 	"strconv"
 
 	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
@@ -24,20 +25,39 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 )
 
+// ClusterLoadBalancerOption is a functional option for ClusterLoadBalancerController.
+type ClusterLoadBalancerOption func(*ClusterLoadBalancerController)
+
+// WithLoadBalancerSNI makes the clusters which opt in to the SNI multiplexing reachable through the shared SNI port as <cluster>.<domain>.
+func WithLoadBalancerSNI(domain string, port int) ClusterLoadBalancerOption {
+	return func(ctrl *ClusterLoadBalancerController) {
+		ctrl.sniDomain = domain
+		ctrl.sniPort = port
+	}
+}
+
 // NewClusterLoadBalancerController creates new ClusterLoadBalancerController.
-func NewClusterLoadBalancerController(minPort, maxPort int) *ClusterLoadBalancerController {
-	return &ClusterLoadBalancerController{
+func NewClusterLoadBalancerController(minPort, maxPort int, opts ...ClusterLoadBalancerOption) *ClusterLoadBalancerController {
+	ctrl := &ClusterLoadBalancerController{
 		minPort: minPort,
 		maxPort: maxPort,
 	}
+
+	for _, opt := range opts {
+		opt(ctrl)
+	}
+
+	return ctrl
 }
 
 // ClusterLoadBalancerController manages ClusterStatus resource lifecycle.
 //
 // ClusterLoadBalancerController applies the generated machine config  on each corresponding machine.
 type ClusterLoadBalancerController struct {
-	minPort int
-	maxPort int
+	sniDomain string
+	minPort   int
+	maxPort   int
+	sniPort   int
 }
 
 // Name implements controller.Controller interface.
@@ -80,7 +100,7 @@ func (ctrl *ClusterLoadBalancerController) Outputs() []controller.Output {
 // Run implements controller.Controller interface.
 //
 //nolint:gocognit
-func (ctrl *ClusterLoadBalancerController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+func (ctrl *ClusterLoadBalancerController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -107,9 +127,19 @@ func (ctrl *ClusterLoadBalancerController) Run(ctx context.Context, r controller
 			return err
 		}
 
-		allocatedPorts := map[string]struct{}{}
+		// allocatedPorts maps the ports to the clusters which use them
+		allocatedPorts := map[string]resource.ID{}
 		for val := range configs.All() {
-			allocatedPorts[val.TypedSpec().Value.BindPort] = struct{}{}
+			allocatedPorts[val.TypedSpec().Value.BindPort] = val.Metadata().ID()
+		}
+
+		// reserve the requested ports, so that they are not allocated to the other clusters
+		for cluster := range list.All() {
+			if requestedPort := requestedBindPort(cluster); requestedPort != "" {
+				if _, allocated := allocatedPorts[requestedPort]; !allocated {
+					allocatedPorts[requestedPort] = cluster.Metadata().ID()
+				}
+			}
 		}
 
 		tracker := trackResource(r, resources.DefaultNamespace, omni.LoadBalancerConfigType)
@@ -129,16 +159,41 @@ func (ctrl *ClusterLoadBalancerController) Run(ctx context.Context, r controller
 
 				spec.Endpoints = endpoints
 
+				if requestedPort := requestedBindPort(cluster); requestedPort != "" && requestedPort != spec.BindPort {
+					if owner := allocatedPorts[requestedPort]; owner != cluster.Metadata().ID() {
+						logger.Error("requested load balancer port is already in use by another cluster",
+							zap.String("cluster", cluster.Metadata().ID()), zap.String("port", requestedPort), zap.String("owner", owner))
+					} else {
+						spec.BindPort = requestedPort
+					}
+				}
+
 				if spec.BindPort == "" {
-					spec.BindPort, err = ctrl.getPort(allocatedPorts)
+					spec.BindPort, err = ctrl.getPort(allocatedPorts, cluster.Metadata().ID())
 					if err != nil {
 						return err
 					}
 				}
 
+				spec.BindAddress = cluster.TypedSpec().Value.GetLoadBalancer().GetBindAddress()
+
+				endpointHost, endpointPort := siderolink.TypedSpec().Value.ServerAddress, spec.BindPort
+
+				if spec.BindAddress != "" {
+					endpointHost = spec.BindAddress
+				}
+
+				spec.SniHost = ""
+
+				// the clusters opt in to the SNI multiplexing, as it changes the endpoint the cluster machines are configured with
+				if ctrl.sniDomain != "" && ctrl.sniPort != 0 && cluster.TypedSpec().Value.GetLoadBalancer().GetSni() {
+					spec.SniHost = cluster.Metadata().ID() + "." + ctrl.sniDomain
+					endpointHost, endpointPort = spec.SniHost, strconv.Itoa(ctrl.sniPort)
+				}
+
 				lbEndpoint := url.URL{
 					Scheme: "https",
-					Host:   net.JoinHostPort(siderolink.TypedSpec().Value.ServerAddress, spec.BindPort),
+					Host:   net.JoinHostPort(endpointHost, endpointPort),
 				}
 
 				spec.SiderolinkEndpoint = lbEndpoint.String()
@@ -173,7 +228,7 @@ func (ctrl *ClusterLoadBalancerController) getEndpoints(ctx context.Context, r c
 	}), nil
 }
 
-func (ctrl *ClusterLoadBalancerController) getPort(allocatedPorts map[string]struct{}) (string, error) {
+func (ctrl *ClusterLoadBalancerController) getPort(allocatedPorts map[string]resource.ID, clusterID resource.ID) (string, error) {
 	for i := ctrl.minPort; i <= ctrl.maxPort; i++ {
 		port := strconv.FormatInt(int64(i), 10)
 
@@ -181,10 +236,20 @@ func (ctrl *ClusterLoadBalancerController) getPort(allocatedPorts map[string]str
 			continue
 		}
 
-		allocatedPorts[port] = struct{}{}
+		allocatedPorts[port] = clusterID
 
 		return port, nil
 	}
 
 	return "", errors.New("no more ports available for the loadbalancer to allocate")
 }
+
+// requestedBindPort returns the stable load balancer port requested in the cluster spec, if any.
+func requestedBindPort(cluster *omni.Cluster) string {
+	port := cluster.TypedSpec().Value.GetLoadBalancer().GetBindPort()
+	if port == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(port), 10)
+}
@@ -11,11 +11,13 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-retry/retry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
@@ -118,6 +120,62 @@ func (suite *ClusterLoadBalancerSuite) TestReconcile() {
 	suite.destroyCluster(cluster)
 }
 
+func (suite *ClusterLoadBalancerSuite) TestRequestedPortAndSNI() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterLoadBalancerController(5000, 6000,
+		omnictrl.WithLoadBalancerSNI("k8s.example.org", 8443),
+	)))
+
+	siderolinkConfig := siderolink.NewConfig(resources.DefaultNamespace)
+	siderolinkConfig.TypedSpec().Value.ServerAddress = "fdae:41e4:649b:9303::1"
+	suite.Require().NoError(suite.state.Create(suite.ctx, siderolinkConfig))
+
+	// the requested port is reserved, so it's not allocated to the cluster without the requested port
+	dynamicCluster := omni.NewCluster(resources.DefaultNamespace, "dynamic")
+	suite.Require().NoError(suite.state.Create(suite.ctx, dynamicCluster))
+
+	stableCluster := omni.NewCluster(resources.DefaultNamespace, "stable")
+	stableCluster.TypedSpec().Value.LoadBalancer = &specs.ClusterSpec_LoadBalancer{
+		BindPort:    5000,
+		BindAddress: "10.5.0.1",
+		Sni:         true,
+	}
+	suite.Require().NoError(suite.state.Create(suite.ctx, stableCluster))
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, stableCluster.Metadata().ID(), func(res *omni.LoadBalancerConfig, assertions *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		assertions.Equal("5000", spec.BindPort)
+		assertions.Equal("10.5.0.1", spec.BindAddress)
+		assertions.Equal("stable.k8s.example.org", spec.SniHost)
+		assertions.Equal("https://stable.k8s.example.org:8443", spec.SiderolinkEndpoint)
+	})
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, dynamicCluster.Metadata().ID(), func(res *omni.LoadBalancerConfig, assertions *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		assertions.Equal("5001", spec.BindPort)
+		assertions.Empty(spec.BindAddress)
+
+		// the cluster didn't opt in to the SNI multiplexing, so its endpoint is kept
+		assertions.Empty(spec.SniHost)
+		assertions.Equal("https://[fdae:41e4:649b:9303::1]:5001", spec.SiderolinkEndpoint)
+	})
+
+	// the cluster can be moved to a stable port later
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, dynamicCluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.LoadBalancer = &specs.ClusterSpec_LoadBalancer{BindPort: 7000}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, dynamicCluster.Metadata().ID(), func(res *omni.LoadBalancerConfig, assertions *assert.Assertions) {
+		assertions.Equal("7000", res.TypedSpec().Value.BindPort)
+	})
+}
+
 func TestClusterLoadbalancerSuite(t *testing.T) {
 	t.Parallel()
 
@@ -96,7 +96,6 @@ func NewClusterMachineConfigController(imageFactoryHost string, defaultGenOption
 	)
 }
 
-//nolint:gocognit,cyclop,gocyclo
 func reconcileClusterMachineConfig(
 	ctx context.Context,
 	r controller.Reader,
@@ -107,17 +106,8 @@ func reconcileClusterMachineConfig(
 	eventSinkPort int,
 	imageFactoryHost string,
 ) error {
-	clusterName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
-	if !ok {
-		return fmt.Errorf("missing cluster label on %s", clusterMachine.Metadata().ID())
-	}
-
-	cluster, err := safe.ReaderGet[*omni.Cluster](ctx, r, omni.NewCluster(resources.DefaultNamespace, clusterName).Metadata())
+	inputs, err := getClusterMachineConfigInputs(ctx, r, clusterMachine)
 	if err != nil {
-		if state.IsNotFoundError(err) {
-			return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
-		}
-
 		return err
 	}
 
@@ -135,11 +125,15 @@ func reconcileClusterMachineConfig(
 		return xerrors.NewTagged[qtransform.SkipReconcileTag](errors.New("machine is being torn down"))
 	}
 
-	if clusterLabel, ok := machineSetNode.Metadata().Labels().Get(omni.LabelCluster); !ok || clusterLabel != clusterName {
+	if clusterLabel, ok := machineSetNode.Metadata().Labels().Get(omni.LabelCluster); !ok || clusterLabel != inputs.cluster.Metadata().ID() {
 		return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("cluster label on %s doesn't match", machineSetNode.Metadata().ID())
 	}
 
-	secrets, err := safe.ReaderGet[*omni.ClusterSecrets](ctx, r, omni.NewClusterSecrets(resources.DefaultNamespace, clusterName).Metadata())
+	clusterMachineConfigPatches, err := safe.ReaderGet[*omni.ClusterMachineConfigPatches](
+		ctx,
+		r,
+		omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, clusterMachine.Metadata().ID()).Metadata(),
+	)
 	if err != nil {
 		if state.IsNotFoundError(err) {
 			return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
@@ -148,43 +142,69 @@ func reconcileClusterMachineConfig(
 		return err
 	}
 
-	loadBalancerConfig, err := safe.ReaderGet[*omni.LoadBalancerConfig](ctx, r, omni.NewLoadBalancerConfig(resources.DefaultNamespace, clusterName).Metadata())
-	if err != nil {
-		if state.IsNotFoundError(err) {
-			return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
-		}
+	if !helpers.UpdateInputsVersions(machineConfig, []resource.Resource{
+		inputs.secrets,
+		clusterMachine,
+		inputs.loadBalancerConfig,
+		inputs.cluster,
+		clusterMachineConfigPatches,
+		inputs.machineConfigGenOptions,
+	}...) {
+		return xerrors.NewTagged[qtransform.SkipReconcileTag](errors.New("config inputs not changed"))
+	}
+
+	helpers.CopyLabels(clusterMachine, machineConfig, omni.LabelMachineSet, omni.LabelCluster, omni.LabelControlPlaneRole, omni.LabelWorkerRole)
 
+	if err = inputs.check(clusterMachine, logger); err != nil {
 		return err
 	}
 
-	clusterConfigVersion, err := safe.ReaderGet[*omni.ClusterConfigVersion](ctx, r, omni.NewClusterConfigVersion(resources.DefaultNamespace, clusterName).Metadata())
+	patchList, err := clusterMachineConfigPatches.TypedSpec().Value.GetUncompressedPatches()
 	if err != nil {
-		if state.IsNotFoundError(err) {
-			return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
-		}
-
 		return err
 	}
 
-	clusterMachineConfigPatches, err := safe.ReaderGet[*omni.ClusterMachineConfigPatches](
-		ctx,
-		r,
-		omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, clusterMachine.Metadata().ID()).Metadata(),
-	)
+	helper := clusterMachineConfigControllerHelper{
+		imageFactoryHost: imageFactoryHost,
+	}
+
+	data, err := helper.generateConfig(clusterMachine, patchList, inputs, defaultGenOptions, eventSinkPort)
 	if err != nil {
-		if state.IsNotFoundError(err) {
-			return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
-		}
+		machineConfig.TypedSpec().Value.GenerationError = err.Error()
+
+		return nil //nolint:nilerr
+	}
 
+	if err = machineConfig.TypedSpec().Value.SetUncompressedData(data); err != nil {
 		return err
 	}
 
-	machineConfigGenOptions, err := safe.ReaderGet[*omni.MachineConfigGenOptions](
-		ctx,
-		r,
-		omni.NewMachineConfigGenOptions(resources.DefaultNamespace, clusterMachine.Metadata().ID()).Metadata(),
-	)
-	if err != nil {
+	machineConfig.TypedSpec().Value.ClusterMachineVersion = clusterMachine.Metadata().Version().String()
+	machineConfig.TypedSpec().Value.GenerationError = ""
+
+	return nil
+}
+
+// clusterMachineConfigInputs are the resources the machine config of the cluster machine is generated from, except the config patches.
+type clusterMachineConfigInputs struct {
+	cluster                 *omni.Cluster
+	secrets                 *omni.ClusterSecrets
+	loadBalancerConfig      *omni.LoadBalancerConfig
+	clusterConfigVersion    *omni.ClusterConfigVersion
+	machineConfigGenOptions *omni.MachineConfigGenOptions
+	connectionParams        *siderolink.ConnectionParams
+}
+
+// getClusterMachineConfigInputs reads the inputs of the cluster machine config, the missing inputs skip the reconcile.
+func getClusterMachineConfigInputs(ctx context.Context, r controller.Reader, clusterMachine *omni.ClusterMachine) (clusterMachineConfigInputs, error) {
+	var inputs clusterMachineConfigInputs
+
+	clusterName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+	if !ok {
+		return inputs, fmt.Errorf("missing cluster label on %s", clusterMachine.Metadata().ID())
+	}
+
+	skipNotFound := func(err error) error {
 		if state.IsNotFoundError(err) {
 			return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
 		}
@@ -192,27 +212,43 @@ func reconcileClusterMachineConfig(
 		return err
 	}
 
-	inputs := []resource.Resource{
-		secrets,
-		clusterMachine,
-		loadBalancerConfig,
-		cluster,
-		clusterMachineConfigPatches,
-		machineConfigGenOptions,
+	var err error
+
+	if inputs.cluster, err = safe.ReaderGetByID[*omni.Cluster](ctx, r, clusterName); err != nil {
+		return inputs, skipNotFound(err)
 	}
 
-	if !helpers.UpdateInputsVersions(machineConfig, inputs...) {
-		return xerrors.NewTagged[qtransform.SkipReconcileTag](errors.New("config inputs not changed"))
+	if inputs.secrets, err = safe.ReaderGetByID[*omni.ClusterSecrets](ctx, r, clusterName); err != nil {
+		return inputs, skipNotFound(err)
 	}
 
-	helpers.CopyLabels(clusterMachine, machineConfig, omni.LabelMachineSet, omni.LabelCluster, omni.LabelControlPlaneRole, omni.LabelWorkerRole)
+	if inputs.loadBalancerConfig, err = safe.ReaderGetByID[*omni.LoadBalancerConfig](ctx, r, clusterName); err != nil {
+		return inputs, skipNotFound(err)
+	}
 
+	if inputs.clusterConfigVersion, err = safe.ReaderGetByID[*omni.ClusterConfigVersion](ctx, r, clusterName); err != nil {
+		return inputs, skipNotFound(err)
+	}
+
+	if inputs.machineConfigGenOptions, err = safe.ReaderGetByID[*omni.MachineConfigGenOptions](ctx, r, clusterMachine.Metadata().ID()); err != nil {
+		return inputs, skipNotFound(err)
+	}
+
+	if inputs.connectionParams, err = safe.ReaderGetByID[*siderolink.ConnectionParams](ctx, r, siderolink.ConfigID); err != nil {
+		return inputs, err
+	}
+
+	return inputs, nil
+}
+
+// check skips the reconcile if the machine config can't be generated yet.
+func (inputs clusterMachineConfigInputs) check(clusterMachine *omni.ClusterMachine, logger *zap.Logger) error {
 	// TODO: temporary transition code, remove in the future
 	if clusterMachine.TypedSpec().Value.KubernetesVersion == "" {
 		return xerrors.NewTagged[qtransform.SkipReconcileTag](errors.New("kubernetes version is not set yet"))
 	}
 
-	installImage := machineConfigGenOptions.TypedSpec().Value.InstallImage
+	installImage := inputs.machineConfigGenOptions.TypedSpec().Value.InstallImage
 	if installImage == nil {
 		logger.Error("install image is not set, skip reconcile")
 
@@ -229,33 +265,9 @@ func reconcileClusterMachineConfig(
 	if installImage.SecureBootStatus == nil {
 		logger.Error("secure boot status is not detected, skip reconcile")
 
-		return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("secure boot status for machine %q is not yet set", machineConfigGenOptions.Metadata().ID())
+		return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("secure boot status for machine %q is not yet set", inputs.machineConfigGenOptions.Metadata().ID())
 	}
 
-	connectionParams, err := safe.ReaderGetByID[*siderolink.ConnectionParams](ctx, r, siderolink.ConfigID)
-	if err != nil {
-		return err
-	}
-
-	helper := clusterMachineConfigControllerHelper{
-		imageFactoryHost: imageFactoryHost,
-	}
-
-	data, err := helper.generateConfig(clusterMachine, clusterMachineConfigPatches, secrets, loadBalancerConfig,
-		cluster, clusterConfigVersion, machineConfigGenOptions, defaultGenOptions, connectionParams, eventSinkPort)
-	if err != nil {
-		machineConfig.TypedSpec().Value.GenerationError = err.Error()
-
-		return nil //nolint:nilerr
-	}
-
-	if err = machineConfig.TypedSpec().Value.SetUncompressedData(data); err != nil {
-		return err
-	}
-
-	machineConfig.TypedSpec().Value.ClusterMachineVersion = clusterMachine.Metadata().Version().String()
-	machineConfig.TypedSpec().Value.GenerationError = ""
-
 	return nil
 }
 
@@ -263,11 +275,12 @@ type clusterMachineConfigControllerHelper struct {
 	imageFactoryHost string
 }
 
-func (helper clusterMachineConfigControllerHelper) generateConfig(clusterMachine *omni.ClusterMachine, clusterMachineConfigPatches *omni.ClusterMachineConfigPatches, secrets *omni.ClusterSecrets,
-	loadbalancer *omni.LoadBalancerConfig, cluster *omni.Cluster, clusterConfigVersion *omni.ClusterConfigVersion, configGenOptions *omni.MachineConfigGenOptions, extraGenOptions []generate.Option,
-	connectionParams *siderolink.ConnectionParams, eventSinkPort int,
+func (helper clusterMachineConfigControllerHelper) generateConfig(clusterMachine *omni.ClusterMachine, patchList []string, inputs clusterMachineConfigInputs,
+	extraGenOptions []generate.Option, eventSinkPort int,
 ) ([]byte, error) {
-	clusterName := cluster.Metadata().ID()
+	clusterName := inputs.cluster.Metadata().ID()
+	clusterConfigVersion := inputs.clusterConfigVersion
+	configGenOptions := inputs.machineConfigGenOptions
 
 	talosVersion := clusterConfigVersion.TypedSpec().Value.Version
 	kubernetesVersion := clusterMachine.TypedSpec().Value.KubernetesVersion
@@ -313,7 +326,7 @@ func (helper clusterMachineConfigControllerHelper) generateConfig(clusterMachine
 
 	genOptions = append(genOptions, generate.WithAdditionalSubjectAltNames([]string{apiHost}))
 
-	secretBundle, err := omni.ToSecretsBundle(secrets)
+	secretBundle, err := omni.ToSecretsBundle(inputs.secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +335,7 @@ func (helper clusterMachineConfigControllerHelper) generateConfig(clusterMachine
 
 	input, err := generate.NewInput(
 		clusterName,
-		loadbalancer.TypedSpec().Value.SiderolinkEndpoint,
+		inputs.loadBalancerConfig.TypedSpec().Value.SiderolinkEndpoint,
 		kubernetesVersion,
 		genOptions...,
 	)
@@ -341,15 +354,10 @@ func (helper clusterMachineConfigControllerHelper) generateConfig(clusterMachine
 		return nil, err
 	}
 
-	patchList, err := clusterMachineConfigPatches.TypedSpec().Value.GetUncompressedPatches()
-	if err != nil {
-		return nil, err
-	}
-
 	if quirks.New(talosVersion).SupportsMultidoc() {
 		var siderolinkConfig []byte
 
-		siderolinkConfig, err = renderSiderolinkJoinConfig(connectionParams, eventSinkPort)
+		siderolinkConfig, err = renderSiderolinkJoinConfig(inputs.connectionParams, eventSinkPort)
 		if err != nil {
 			return nil, err
 		}
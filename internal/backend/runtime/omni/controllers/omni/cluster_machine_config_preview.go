@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/configpatch"
+)
+
+// ClusterMachineConfigPreview generates the machine configs of the cluster machines the same way ClusterMachineConfigController does,
+// but with the config patch write which is not done yet.
+type ClusterMachineConfigPreview struct {
+	imageFactoryHost  string
+	defaultGenOptions []generate.Option
+	eventSinkPort     int
+}
+
+// NewClusterMachineConfigPreview creates a new ClusterMachineConfigPreview with the settings of ClusterMachineConfigController.
+func NewClusterMachineConfigPreview(imageFactoryHost string, defaultGenOptions []generate.Option, eventSinkPort int) *ClusterMachineConfigPreview {
+	return &ClusterMachineConfigPreview{
+		imageFactoryHost:  imageFactoryHost,
+		defaultGenOptions: defaultGenOptions,
+		eventSinkPort:     eventSinkPort,
+	}
+}
+
+// Generate generates the machine configs of the cluster machines with the config patch created or updated, or without it if remove is set.
+//
+// The config patch templates are rendered for each machine. The machines which don't have all config inputs yet are skipped.
+func (p *ClusterMachineConfigPreview) Generate(ctx context.Context, r controller.Reader, clusterMachines []*omni.ClusterMachine, patch *omni.ConfigPatch, remove bool) ([][]byte, error) {
+	opt := configpatch.WithConfigPatch(patch)
+
+	if remove {
+		opt = configpatch.WithoutConfigPatch(patch.Metadata().ID())
+	}
+
+	patchHelper, err := configpatch.NewHelper(ctx, r, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	helper := clusterMachineConfigControllerHelper{
+		imageFactoryHost: p.imageFactoryHost,
+	}
+
+	result := make([][]byte, 0, len(clusterMachines))
+
+	for _, clusterMachine := range clusterMachines {
+		inputs, err := getClusterMachineConfigInputs(ctx, r, clusterMachine)
+		if err == nil {
+			err = inputs.check(clusterMachine, zap.NewNop())
+		}
+
+		if err != nil {
+			if xerrors.TagIs[qtransform.SkipReconcileTag](err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		machineSetID, _ := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+
+		machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		patches, err := patchHelper.Get(clusterMachine, machineSet)
+		if err != nil {
+			return nil, err
+		}
+
+		patchList := make([]string, 0, len(patches))
+
+		for _, configPatch := range patches {
+			buffer, err := configPatch.TypedSpec().Value.GetUncompressedData()
+			if err != nil {
+				return nil, err
+			}
+
+			if data := string(buffer.Data()); strings.TrimSpace(data) != "" {
+				patchList = append(patchList, data)
+			}
+
+			buffer.Free()
+		}
+
+		data, err := helper.generateConfig(clusterMachine, patchList, inputs, p.defaultGenOptions, p.eventSinkPort)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate the machine config of %q: %w", clusterMachine.Metadata().ID(), err)
+		}
+
+		result = append(result, data)
+	}
+
+	return result, nil
+}
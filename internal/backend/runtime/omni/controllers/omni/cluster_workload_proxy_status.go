@@ -23,12 +23,13 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 )
 
 // WorkloadProxyReconciler reconciles the workload proxies for a cluster.
 type WorkloadProxyReconciler interface {
 	// Reconcile reconciles the workload proxies for a cluster.
-	Reconcile(cluster resource.ID, aliasToUpstreamAddresses map[string][]string) error
+	Reconcile(cluster resource.ID, aliasToUpstream map[string]workloadproxy.Upstream) error
 }
 
 // ClusterWorkloadProxyStatusControllerName is the name of the controller.
@@ -128,7 +129,7 @@ func (helper *clusterWorkloadProxyStatusControllerHelper) transform(ctx context.
 		return fmt.Errorf("failed to list exposed services: %w", err)
 	}
 
-	aliasToUpstreamAddresses := make(map[string][]string, svcList.Len())
+	aliasToUpstream := make(map[string]workloadproxy.Upstream, svcList.Len())
 
 	for svc := range svcList.All() {
 		if svc.Metadata().Phase() == resource.PhaseTearingDown {
@@ -150,16 +151,19 @@ func (helper *clusterWorkloadProxyStatusControllerHelper) transform(ctx context.
 			continue
 		}
 
-		aliasToUpstreamAddresses[alias] = xslices.Map(healthyTargetHosts, func(host string) string {
-			return net.JoinHostPort(host, strconv.Itoa(int(svc.TypedSpec().Value.Port)))
-		})
+		aliasToUpstream[alias] = workloadproxy.Upstream{
+			Addresses: xslices.Map(healthyTargetHosts, func(host string) string {
+				return net.JoinHostPort(host, strconv.Itoa(int(svc.TypedSpec().Value.Port)))
+			}),
+			Protocol: svc.TypedSpec().Value.Protocol,
+		}
 	}
 
-	if err = helper.workloadProxyReconciler.Reconcile(cluster.Metadata().ID(), aliasToUpstreamAddresses); err != nil {
+	if err = helper.workloadProxyReconciler.Reconcile(cluster.Metadata().ID(), aliasToUpstream); err != nil {
 		return fmt.Errorf("failed to reconcile load balancers: %w", err)
 	}
 
-	status.TypedSpec().Value.NumExposedServices = uint32(len(aliasToUpstreamAddresses))
+	status.TypedSpec().Value.NumExposedServices = uint32(len(aliasToUpstream))
 
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 )
 
 type ClusterWorkloadProxyStatusSuite struct {
@@ -200,11 +201,11 @@ type mockWorkloadProxyReconciler struct {
 	mu   sync.Mutex
 }
 
-func (m *mockWorkloadProxyReconciler) Reconcile(cluster resource.ID, aliasToUpstreamAddresses map[string][]string) error {
+func (m *mockWorkloadProxyReconciler) Reconcile(cluster resource.ID, aliasToUpstream map[string]workloadproxy.Upstream) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if len(aliasToUpstreamAddresses) == 0 {
+	if len(aliasToUpstream) == 0 {
 		delete(m.data, cluster)
 
 		if len(m.data) == 0 {
@@ -218,7 +219,11 @@ func (m *mockWorkloadProxyReconciler) Reconcile(cluster resource.ID, aliasToUpst
 		m.data = map[resource.ID]map[string][]string{}
 	}
 
-	m.data[cluster] = aliasToUpstreamAddresses
+	m.data[cluster] = make(map[string][]string, len(aliasToUpstream))
+
+	for alias, upstream := range aliasToUpstream {
+		m.data[cluster][alias] = upstream.Addresses
+	}
 
 	return nil
 }
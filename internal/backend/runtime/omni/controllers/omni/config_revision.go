@@ -0,0 +1,353 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+)
+
+// ConfigRevisionControllerName is the name of the ConfigRevisionController.
+const ConfigRevisionControllerName = "ConfigRevisionController"
+
+// ConfigRevisionController keeps the bounded revision history of the config patches and the generated machine configs.
+//
+// A new revision is created each time the content changes, the oldest revisions are removed once the limit is reached.
+// The revisions of the removed sources are kept for the retention period, so that the removed config patches can be restored.
+// The machine configs are kept redacted, so the history doesn't keep any secrets.
+type ConfigRevisionController struct {
+	generic.NamedController
+	limit     int
+	retention time.Duration
+}
+
+// NewConfigRevisionController initializes ConfigRevisionController.
+func NewConfigRevisionController(limit int, retention time.Duration) *ConfigRevisionController {
+	return &ConfigRevisionController{
+		NamedController: generic.NamedController{
+			ControllerName: ConfigRevisionControllerName,
+		},
+		limit:     limit,
+		retention: retention,
+	}
+}
+
+// Settings implements controller.QController interface.
+func (ctrl *ConfigRevisionController) Settings() controller.QSettings {
+	return controller.QSettings{
+		Inputs: []controller.Input{
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ConfigPatchType,
+				Kind:      controller.InputQPrimary,
+			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ClusterMachineConfigType,
+				Kind:      controller.InputQPrimary,
+			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ConfigPatchRevisionType,
+				Kind:      controller.InputQMapped,
+			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ClusterMachineConfigRevisionType,
+				Kind:      controller.InputQMapped,
+			},
+		},
+		Outputs: []controller.Output{
+			{
+				Kind: controller.OutputExclusive,
+				Type: omni.ConfigPatchRevisionType,
+			},
+			{
+				Kind: controller.OutputExclusive,
+				Type: omni.ClusterMachineConfigRevisionType,
+			},
+		},
+		Concurrency: optional.Some[uint](4),
+	}
+}
+
+// MapInput implements controller.QController interface.
+//
+// The revisions are mapped to their sources, so that the revisions of the sources removed while the controller wasn't running are cleaned up.
+func (ctrl *ConfigRevisionController) MapInput(ctx context.Context, _ *zap.Logger, r controller.QRuntime, ptr resource.Pointer) ([]resource.Pointer, error) {
+	var (
+		label  string
+		source func(id resource.ID) resource.Pointer
+	)
+
+	switch ptr.Type() {
+	case omni.ConfigPatchRevisionType:
+		label = omni.LabelConfigPatch
+		source = func(id resource.ID) resource.Pointer {
+			return omni.NewConfigPatch(resources.DefaultNamespace, id).Metadata()
+		}
+	case omni.ClusterMachineConfigRevisionType:
+		label = omni.LabelClusterMachine
+		source = func(id resource.ID) resource.Pointer {
+			return omni.NewClusterMachineConfig(resources.DefaultNamespace, id).Metadata()
+		}
+	default:
+		return nil, fmt.Errorf("unexpected resource type %q", ptr.Type())
+	}
+
+	revision, err := r.Get(ctx, ptr)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	id, ok := revision.Metadata().Labels().Get(label)
+	if !ok {
+		return nil, nil
+	}
+
+	return []resource.Pointer{source(id)}, nil
+}
+
+// Reconcile implements controller.QController interface.
+func (ctrl *ConfigRevisionController) Reconcile(ctx context.Context, _ *zap.Logger, r controller.QRuntime, ptr resource.Pointer) error {
+	switch ptr.Type() {
+	case omni.ConfigPatchType:
+		return ctrl.reconcileConfigPatch(ctx, r, ptr.ID())
+	case omni.ClusterMachineConfigType:
+		return ctrl.reconcileClusterMachineConfig(ctx, r, ptr.ID())
+	}
+
+	return fmt.Errorf("unexpected resource type %q", ptr.Type())
+}
+
+func (ctrl *ConfigRevisionController) reconcileConfigPatch(ctx context.Context, r controller.QRuntime, id resource.ID) error {
+	revisions, err := safe.ReaderListAll[*omni.ConfigPatchRevision](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelConfigPatch, id)))
+	if err != nil {
+		return err
+	}
+
+	configPatch, err := safe.ReaderGetByID[*omni.ConfigPatch](ctx, r, id)
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	var data []byte
+
+	if configPatch != nil {
+		buffer, bufferErr := configPatch.TypedSpec().Value.GetUncompressedData()
+		if bufferErr != nil {
+			return bufferErr
+		}
+
+		data = bytes.Clone(buffer.Data())
+
+		buffer.Free()
+	}
+
+	return syncRevisions(ctx, r, ctrl.limit, ctrl.retention, slices.Collect(revisions.All()), configPatch != nil, data,
+		func(revision *omni.ConfigPatchRevision) revisionSpec { return revision.TypedSpec().Value },
+		func(revision *omni.ConfigPatchRevision, deleted *timestamppb.Timestamp) {
+			revision.TypedSpec().Value.Deleted = deleted
+		},
+		func(number uint64) (*omni.ConfigPatchRevision, error) {
+			revision := omni.NewConfigPatchRevision(resources.DefaultNamespace, omni.ConfigPatchRevisionID(id, number))
+
+			// the labels and the annotations are kept to restore the removed config patch
+			helpers.CopyLabels(configPatch, revision, omni.LabelCluster, omni.LabelMachineSet, omni.LabelClusterMachine, omni.LabelMachine)
+			helpers.CopyAnnotations(configPatch, revision, omni.ConfigPatchName, omni.ConfigPatchDescription)
+			revision.Metadata().Labels().Set(omni.LabelConfigPatch, id)
+
+			revision.TypedSpec().Value.Revision = number
+			revision.TypedSpec().Value.ConfigPatchVersion = configPatch.Metadata().Version().String()
+			revision.TypedSpec().Value.Created = timestamppb.Now()
+
+			return revision, revision.TypedSpec().Value.SetUncompressedData(data)
+		},
+	)
+}
+
+func (ctrl *ConfigRevisionController) reconcileClusterMachineConfig(ctx context.Context, r controller.QRuntime, id resource.ID) error {
+	revisions, err := safe.ReaderListAll[*omni.ClusterMachineConfigRevision](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelClusterMachine, id)))
+	if err != nil {
+		return err
+	}
+
+	config, err := safe.ReaderGetByID[*omni.ClusterMachineConfig](ctx, r, id)
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	var data []byte
+
+	if config != nil {
+		buffer, bufferErr := config.TypedSpec().Value.GetUncompressedData()
+		if bufferErr != nil {
+			return bufferErr
+		}
+
+		defer buffer.Free()
+
+		// the config is not generated, keep the history as is
+		if len(buffer.Data()) > 0 {
+			if data, err = redactMachineConfig(buffer.Data()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return syncRevisions(ctx, r, ctrl.limit, ctrl.retention, slices.Collect(revisions.All()), config != nil, data,
+		func(revision *omni.ClusterMachineConfigRevision) revisionSpec { return revision.TypedSpec().Value },
+		func(revision *omni.ClusterMachineConfigRevision, deleted *timestamppb.Timestamp) {
+			revision.TypedSpec().Value.Deleted = deleted
+		},
+		func(number uint64) (*omni.ClusterMachineConfigRevision, error) {
+			revision := omni.NewClusterMachineConfigRevision(resources.DefaultNamespace, omni.ClusterMachineConfigRevisionID(id, number))
+
+			helpers.CopyLabels(config, revision, omni.LabelCluster, omni.LabelMachineSet)
+			revision.Metadata().Labels().Set(omni.LabelClusterMachine, id)
+
+			revision.TypedSpec().Value.Revision = number
+			revision.TypedSpec().Value.ClusterMachineConfigVersion = config.Metadata().Version().String()
+			revision.TypedSpec().Value.Created = timestamppb.Now()
+
+			return revision, revision.TypedSpec().Value.SetUncompressedData(data)
+		},
+	)
+}
+
+type revisionSpec interface {
+	GetRevision() uint64
+	GetDeleted() *timestamppb.Timestamp
+	GetUncompressedData(opts ...specs.CompressionOption) (specs.Buffer, error)
+}
+
+// syncRevisions creates a new revision if the data differs from the latest revision and removes the revisions over the limit.
+//
+// If the source doesn't exist anymore, the latest revision is marked as deleted, and all revisions are removed after the retention period.
+func syncRevisions[R resource.Resource](
+	ctx context.Context,
+	r controller.QRuntime,
+	limit int,
+	retention time.Duration,
+	revisions []R,
+	exists bool,
+	data []byte,
+	spec func(R) revisionSpec,
+	setDeleted func(R, *timestamppb.Timestamp),
+	newRevision func(number uint64) (R, error),
+) error {
+	slices.SortFunc(revisions, func(a, b R) int {
+		return cmp.Compare(spec(a).GetRevision(), spec(b).GetRevision())
+	})
+
+	keep := limit
+
+	var requeueAfter time.Duration
+
+	if len(revisions) > 0 {
+		last := revisions[len(revisions)-1]
+		deleted := spec(last).GetDeleted()
+
+		switch {
+		case exists && deleted != nil:
+			// the source was re-created
+			if err := safe.WriterModify(ctx, r, last, func(res R) error {
+				setDeleted(res, nil)
+
+				return nil
+			}); err != nil {
+				return err
+			}
+		case !exists && deleted == nil:
+			deleted = timestamppb.Now()
+
+			if err := safe.WriterModify(ctx, r, last, func(res R) error {
+				setDeleted(res, deleted)
+
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		if !exists {
+			requeueAfter = time.Until(deleted.AsTime().Add(retention))
+
+			if requeueAfter <= 0 {
+				keep = 0
+			}
+		}
+	}
+
+	if keep > 0 && data != nil {
+		var latest uint64
+
+		changed := true
+
+		if len(revisions) > 0 {
+			last := spec(revisions[len(revisions)-1])
+			latest = last.GetRevision()
+
+			buffer, err := last.GetUncompressedData()
+			if err != nil {
+				return err
+			}
+
+			changed = !bytes.Equal(buffer.Data(), data)
+
+			buffer.Free()
+		}
+
+		if changed {
+			revision, err := newRevision(latest + 1)
+			if err != nil {
+				return err
+			}
+
+			if err = r.Create(ctx, revision); err != nil {
+				return err
+			}
+
+			revisions = append(revisions, revision)
+		}
+	}
+
+	for len(revisions) > keep {
+		if err := r.Destroy(ctx, revisions[0].Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+
+		revisions = revisions[1:]
+	}
+
+	if len(revisions) > 0 && requeueAfter > 0 {
+		return controller.NewRequeueInterval(requeueAfter)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ConfigRevisionSuite struct {
+	OmniSuite
+}
+
+func (suite *ConfigRevisionSuite) TestConfigPatchRevisions() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewConfigRevisionController(2, time.Second*2)))
+
+	id := "400-patch"
+
+	patchData := func(hostname string) string {
+		return fmt.Sprintf("machine:\n  network:\n    hostname: %s\n", hostname)
+	}
+
+	patch := omni.NewConfigPatch(resources.DefaultNamespace, id)
+	patch.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+	patch.Metadata().Annotations().Set(omni.ConfigPatchName, "hostname")
+
+	suite.Require().NoError(patch.TypedSpec().Value.SetUncompressedData([]byte(patchData("first"))))
+	suite.Require().NoError(suite.state.Create(suite.ctx, patch))
+
+	assertRevision := func(number uint64, hostname string) {
+		rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{omni.ConfigPatchRevisionID(id, number)},
+			func(revision *omni.ConfigPatchRevision, assert *assert.Assertions) {
+				buffer, err := revision.TypedSpec().Value.GetUncompressedData()
+				assert.NoError(err)
+
+				defer buffer.Free()
+
+				assert.Equal(patchData(hostname), string(buffer.Data()))
+				assert.Equal(number, revision.TypedSpec().Value.Revision)
+
+				cluster, _ := revision.Metadata().Labels().Get(omni.LabelCluster)
+				assert.Equal("cluster", cluster)
+
+				source, _ := revision.Metadata().Labels().Get(omni.LabelConfigPatch)
+				assert.Equal(id, source)
+
+				name, _ := revision.Metadata().Annotations().Get(omni.ConfigPatchName)
+				assert.Equal("hostname", name)
+			},
+		)
+	}
+
+	assertRevision(1, "first")
+
+	for i, hostname := range []string{"second", "third"} {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, patch.Metadata(), func(res *omni.ConfigPatch) error {
+			return res.TypedSpec().Value.SetUncompressedData([]byte(patchData(hostname)))
+		})
+		suite.Require().NoError(err)
+
+		assertRevision(uint64(i+2), hostname)
+	}
+
+	// the oldest revision is removed once the limit is reached
+	rtestutils.AssertNoResource[*omni.ConfigPatchRevision](suite.ctx, suite.T(), suite.state, omni.ConfigPatchRevisionID(id, 1))
+	assertRevision(2, "second")
+
+	assertDeleted := func(deleted bool) {
+		rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{omni.ConfigPatchRevisionID(id, 3)},
+			func(revision *omni.ConfigPatchRevision, assert *assert.Assertions) {
+				assert.Equal(deleted, revision.TypedSpec().Value.Deleted != nil)
+			},
+		)
+	}
+
+	// the revisions are kept for the retention period after the config patch is removed
+	rtestutils.Destroy[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, []resource.ID{id})
+
+	assertDeleted(true)
+	assertRevision(2, "second")
+
+	// the config patch is restored
+	patch = omni.NewConfigPatch(resources.DefaultNamespace, id)
+	patch.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+	patch.Metadata().Annotations().Set(omni.ConfigPatchName, "hostname")
+
+	suite.Require().NoError(patch.TypedSpec().Value.SetUncompressedData([]byte(patchData("third"))))
+	suite.Require().NoError(suite.state.Create(suite.ctx, patch))
+
+	assertDeleted(false)
+
+	rtestutils.Destroy[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, []resource.ID{id})
+
+	assertDeleted(true)
+
+	rtestutils.AssertNoResource[*omni.ConfigPatchRevision](suite.ctx, suite.T(), suite.state, omni.ConfigPatchRevisionID(id, 2))
+	rtestutils.AssertNoResource[*omni.ConfigPatchRevision](suite.ctx, suite.T(), suite.state, omni.ConfigPatchRevisionID(id, 3))
+}
+
+func (suite *ConfigRevisionSuite) TestClusterMachineConfigRevisions() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewConfigRevisionController(10, 0)))
+
+	id := "test"
+
+	config := omni.NewClusterMachineConfig(resources.DefaultNamespace, id)
+	config.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+
+	input, err := generate.NewInput("cluster", "https://doesntmatter:6443", constants.DefaultKubernetesVersion)
+	suite.Require().NoError(err)
+
+	machineConfig, err := input.Config(machine.TypeWorker)
+	suite.Require().NoError(err)
+
+	data, err := machineConfig.Bytes()
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(config.TypedSpec().Value.SetUncompressedData(data))
+	suite.Require().NoError(suite.state.Create(suite.ctx, config))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{omni.ClusterMachineConfigRevisionID(id, 1)},
+		func(revision *omni.ClusterMachineConfigRevision, assert *assert.Assertions) {
+			buffer, err := revision.TypedSpec().Value.GetUncompressedData()
+			assert.NoError(err)
+
+			defer buffer.Free()
+
+			assert.Contains(string(buffer.Data()), x509.Redacted)
+
+			source, _ := revision.Metadata().Labels().Get(omni.LabelClusterMachine)
+			assert.Equal(id, source)
+		},
+	)
+
+	rtestutils.Destroy[*omni.ClusterMachineConfig](suite.ctx, suite.T(), suite.state, []resource.ID{id})
+
+	rtestutils.AssertNoResource[*omni.ClusterMachineConfigRevision](suite.ctx, suite.T(), suite.state, omni.ClusterMachineConfigRevisionID(id, 1))
+}
+
+func TestConfigRevisionSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(ConfigRevisionSuite))
+}
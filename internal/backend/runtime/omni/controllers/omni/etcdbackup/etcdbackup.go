@@ -8,6 +8,7 @@ package etcdbackup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strconv"
@@ -30,6 +31,29 @@ type Store interface {
 	Download(ctx context.Context, encryptionKey []byte, clusterUUID, snapshotName string) (BackupData, io.ReadCloser, error)
 }
 
+// ErrObjectStoreNotSupported is returned by the wrapping stores when the underlying store doesn't implement [ObjectStore].
+var ErrObjectStoreNotSupported = errors.New("the store doesn't support storing arbitrary objects")
+
+// ObjectStore is implemented by the stores which can keep arbitrary objects (e.g. support bundles) next to the etcd backups.
+//
+// The keys are slash-separated paths relative to the store root.
+type ObjectStore interface {
+	PutObject(ctx context.Context, key string, r io.Reader) error
+	GetObject(ctx context.Context, key string) (io.ReadCloser, error)
+	ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	DeleteObject(ctx context.Context, key string) error
+}
+
+// ErrObjectNotFound is returned by [ObjectStore.GetObject] when the object doesn't exist.
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectInfo describes an object stored in the [ObjectStore].
+type ObjectInfo struct {
+	Timestamp time.Time
+	Key       string
+	Size      int64
+}
+
 // Description is a data that is used to describe backup.
 type Description struct {
 	Timestamp      time.Time
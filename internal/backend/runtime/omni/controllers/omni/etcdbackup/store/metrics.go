@@ -126,6 +126,42 @@ func (s *storeWithMetrics) Download(ctx context.Context, encryptionKey []byte, c
 	return backupData, &metricsWrapper, err
 }
 
+func (s *storeWithMetrics) PutObject(ctx context.Context, key string, r io.Reader) error {
+	objectStore, ok := s.store.(etcdbackup.ObjectStore)
+	if !ok {
+		return etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	return objectStore.PutObject(ctx, key, r)
+}
+
+func (s *storeWithMetrics) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	objectStore, ok := s.store.(etcdbackup.ObjectStore)
+	if !ok {
+		return nil, etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	return objectStore.GetObject(ctx, key)
+}
+
+func (s *storeWithMetrics) ListObjects(ctx context.Context, prefix string) ([]etcdbackup.ObjectInfo, error) {
+	objectStore, ok := s.store.(etcdbackup.ObjectStore)
+	if !ok {
+		return nil, etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	return objectStore.ListObjects(ctx, prefix)
+}
+
+func (s *storeWithMetrics) DeleteObject(ctx context.Context, key string) error {
+	objectStore, ok := s.store.(etcdbackup.ObjectStore)
+	if !ok {
+		return etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	return objectStore.DeleteObject(ctx, key)
+}
+
 type readCloserWithMetrics struct {
 	readCloser io.ReadCloser
 	counter    prometheus.Counter
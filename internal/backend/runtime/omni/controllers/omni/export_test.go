@@ -10,6 +10,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/compatibility"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
 )
@@ -29,3 +30,7 @@ func GetMachineSetNodeSortFunction(machineStatuses map[resource.ID]*system.Resou
 func StripTalosAPIAccessOSAdminRole(cfg config.Provider) (config.Provider, error) {
 	return stripTalosAPIAccessOSAdminRole(cfg)
 }
+
+func ParseProtocol(protocol string) (specs.ExposedServiceSpec_Protocol, error) {
+	return (&KubernetesStatusController{}).parseProtocol(protocol)
+}
@@ -8,32 +8,120 @@ package configpatch
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
 )
 
 // Helper provides a way to lookup config patches by machine/machine-set.
 type Helper struct {
 	allConfigPatches safe.List[*omni.ConfigPatch]
+
+	// fleetPatches are the fleet config patches by the target.
+	fleetPatches map[string][]*omni.ConfigPatch
+
+	// clusters and machineStatuses are only loaded if there are fleet patches targeting them or config patch templates.
+	clusters        map[resource.ID]*omni.Cluster
+	machineStatuses map[resource.ID]*omni.MachineStatus
+}
+
+// HelperOption overrides the config patches read by the helper.
+type HelperOption func(patches []*omni.ConfigPatch) []*omni.ConfigPatch
+
+// WithConfigPatch replaces the config patch with the same ID, or adds it if it doesn't exist.
+func WithConfigPatch(patch *omni.ConfigPatch) HelperOption {
+	return func(patches []*omni.ConfigPatch) []*omni.ConfigPatch {
+		patches = slices.DeleteFunc(patches, func(p *omni.ConfigPatch) bool { return p.Metadata().ID() == patch.Metadata().ID() })
+
+		return append(patches, patch)
+	}
+}
+
+// WithoutConfigPatch removes the config patch with the given ID.
+func WithoutConfigPatch(id resource.ID) HelperOption {
+	return func(patches []*omni.ConfigPatch) []*omni.ConfigPatch {
+		return slices.DeleteFunc(patches, func(p *omni.ConfigPatch) bool { return p.Metadata().ID() == id })
+	}
 }
 
 // NewHelper creates a new config patch helper.
-func NewHelper(ctx context.Context, r controller.Reader) (*Helper, error) {
+//
+// The options are used to look up the config patches as they would be after the write which isn't done yet.
+func NewHelper(ctx context.Context, r controller.Reader, opts ...HelperOption) (*Helper, error) {
 	allConfigPatches, err := safe.ReaderListAll[*omni.ConfigPatch](ctx, r)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Helper{
+	if len(opts) > 0 {
+		patches := slices.Collect(allConfigPatches.All())
+
+		for _, opt := range opts {
+			patches = opt(patches)
+		}
+
+		// keep the patches sorted by ID as they are listed
+		slices.SortFunc(patches, func(a, b *omni.ConfigPatch) int { return strings.Compare(a.Metadata().ID(), b.Metadata().ID()) })
+
+		allConfigPatches = safe.NewList[*omni.ConfigPatch](resource.List{
+			Items: xslices.Map(patches, func(patch *omni.ConfigPatch) resource.Resource { return patch }),
+		})
+	}
+
+	helper := &Helper{
 		allConfigPatches: allConfigPatches,
-	}, nil
+		fleetPatches:     map[string][]*omni.ConfigPatch{},
+	}
+
+	hasTemplates := false
+
+	for patch := range allConfigPatches.All() {
+		if target, ok := patch.Metadata().Labels().Get(omni.LabelFleetPatchTarget); ok {
+			helper.fleetPatches[target] = append(helper.fleetPatches[target], patch)
+		}
+
+		hasTemplates = hasTemplates || omni.IsConfigPatchTemplate(patch)
+	}
+
+	if hasTemplates || len(helper.fleetPatches[omni.FleetPatchTargetCluster]) > 0 {
+		if helper.clusters, err = listByID[*omni.Cluster](ctx, r); err != nil {
+			return nil, err
+		}
+	}
+
+	if hasTemplates || len(helper.fleetPatches[omni.FleetPatchTargetMachine]) > 0 {
+		if helper.machineStatuses, err = listByID[*omni.MachineStatus](ctx, r); err != nil {
+			return nil, err
+		}
+	}
+
+	return helper, nil
+}
+
+func listByID[T meta.ResourceWithRD](ctx context.Context, r controller.Reader) (map[resource.ID]T, error) {
+	list, err := safe.ReaderListAll[T](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[resource.ID]T, list.Len())
+
+	for res := range list.All() {
+		result[res.Metadata().ID()] = res
+	}
+
+	return result, nil
 }
 
 // Get collects all machine config patches.
@@ -68,14 +156,143 @@ func (h *Helper) Get(machine *omni.ClusterMachine, machineSet *omni.MachineSet)
 		}
 	}
 
-	patches := make([]*omni.ConfigPatch, 0, clusterPatchList.Len()+machinePatchList.Len())
+	fleetPatches, err := h.getFleetPatches(clusterName, machine, machineSet)
+	if err != nil {
+		return nil, err
+	}
+
+	patches := make([]*omni.ConfigPatch, 0, len(fleetPatches)+clusterPatchList.Len()+machinePatchList.Len())
 
+	// the fleet patches have the lowest precedence, so they can be overridden on any of the specific levels
+	patches = append(patches, fleetPatches...)
 	patches = append(patches, clusterPatches...)
 	patches = append(patches, machineSetPatches...)
 	patches = append(patches, clusterMachinePatches...)
 	patches = slices.AppendSeq(patches, machinePatchList.All())
 
-	return xslices.Filter(patches, func(configPatch *omni.ConfigPatch) bool {
+	patches = xslices.Filter(patches, func(configPatch *omni.ConfigPatch) bool {
 		return configPatch.Metadata().Phase() == resource.PhaseRunning
-	}), nil
+	})
+
+	return h.render(clusterName, machine, machineSet, patches)
+}
+
+// render replaces the config patch templates with the copies having the data rendered for the machine.
+func (h *Helper) render(clusterName string, machine *omni.ClusterMachine, machineSet *omni.MachineSet, patches []*omni.ConfigPatch) ([]*omni.ConfigPatch, error) {
+	if !slices.ContainsFunc(patches, omni.IsConfigPatchTemplate) {
+		return patches, nil
+	}
+
+	cluster, ok := h.clusters[clusterName]
+	if !ok {
+		return nil, fmt.Errorf("cluster %q not found", clusterName)
+	}
+
+	data := omni.NewConfigPatchTemplateData(cluster, machineSet, machine, h.machineStatuses[machine.Metadata().ID()])
+
+	rendered := make([]*omni.ConfigPatch, 0, len(patches))
+
+	for _, patch := range patches {
+		if !omni.IsConfigPatchTemplate(patch) {
+			rendered = append(rendered, patch)
+
+			continue
+		}
+
+		renderedPatch, err := renderPatch(patch, data)
+		if err != nil {
+			return nil, fmt.Errorf("config patch %q: %w", patch.Metadata().ID(), err)
+		}
+
+		rendered = append(rendered, renderedPatch)
+	}
+
+	return rendered, nil
+}
+
+func renderPatch(patch *omni.ConfigPatch, data omni.ConfigPatchTemplateData) (*omni.ConfigPatch, error) {
+	buffer, err := patch.TypedSpec().Value.GetUncompressedData()
+	if err != nil {
+		return nil, err
+	}
+
+	defer buffer.Free()
+
+	renderedData, err := omni.RenderConfigPatchTemplate(buffer.Data(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := patch.DeepCopy().(*omni.ConfigPatch) //nolint:forcetypeassert,errcheck
+
+	if err = rendered.TypedSpec().Value.SetUncompressedData(renderedData); err != nil {
+		return nil, err
+	}
+
+	return rendered, nil
+}
+
+// UpdateInputsVersions updates the input versions annotation of the resource with the config patches and returns if it has changed.
+//
+// The rendered config patch templates are also versioned by their contents, as they change with the machine and the cluster.
+func UpdateInputsVersions(out resource.Resource, patches ...*omni.ConfigPatch) bool {
+	return helpers.UpdateInputsAnnotation(out, xslices.Map(patches, func(patch *omni.ConfigPatch) string {
+		version := fmt.Sprintf("%s/%s@%s", patch.Metadata().Type(), patch.Metadata().ID(), patch.Metadata().Version())
+
+		if !omni.IsConfigPatchTemplate(patch) {
+			return version
+		}
+
+		buffer, err := patch.TypedSpec().Value.GetUncompressedData()
+		if err != nil {
+			return version
+		}
+
+		defer buffer.Free()
+
+		hash := sha256.Sum256(buffer.Data())
+
+		return version + "#" + hex.EncodeToString(hash[:8])
+	})...)
+}
+
+// getFleetPatches returns the fleet patches matching the machine, ordered by the target: cluster, machine set, machine.
+//
+// The patches of the same target are ordered by ID.
+func (h *Helper) getFleetPatches(clusterName string, machine *omni.ClusterMachine, machineSet *omni.MachineSet) ([]*omni.ConfigPatch, error) {
+	var patches []*omni.ConfigPatch
+
+	for _, target := range omni.FleetPatchTargets {
+		var labels *resource.Labels
+
+		switch target {
+		case omni.FleetPatchTargetCluster:
+			if cluster, ok := h.clusters[clusterName]; ok {
+				labels = cluster.Metadata().Labels()
+			}
+		case omni.FleetPatchTargetMachineSet:
+			labels = machineSet.Metadata().Labels()
+		case omni.FleetPatchTargetMachine:
+			if machineStatus, ok := h.machineStatuses[machine.Metadata().ID()]; ok {
+				labels = machineStatus.Metadata().Labels()
+			}
+		}
+
+		for _, patch := range h.fleetPatches[target] {
+			if labels == nil {
+				break
+			}
+
+			query, err := omni.FleetPatchSelectorQuery(patch)
+			if err != nil {
+				return nil, fmt.Errorf("fleet config patch %q: %w", patch.Metadata().ID(), err)
+			}
+
+			if query.Matches(*labels) {
+				patches = append(patches, patch)
+			}
+		}
+	}
+
+	return patches, nil
 }
@@ -93,3 +93,43 @@ func (c *Store) Download(ctx context.Context, encryptionKey []byte, clusterUUID,
 func (c *Store) ListBackups(ctx context.Context, uuid string) (etcdbackup.InfoIterator, error) {
 	return c.wrapped.ListBackups(ctx, uuid)
 }
+
+// PutObject stores the object in the wrapped store as is. Implements [etcdbackup.ObjectStore].
+func (c *Store) PutObject(ctx context.Context, key string, r io.Reader) error {
+	objectStore, ok := c.wrapped.(etcdbackup.ObjectStore)
+	if !ok {
+		return etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	return objectStore.PutObject(ctx, key, r)
+}
+
+// GetObject returns the object from the wrapped store as is. Implements [etcdbackup.ObjectStore].
+func (c *Store) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	objectStore, ok := c.wrapped.(etcdbackup.ObjectStore)
+	if !ok {
+		return nil, etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	return objectStore.GetObject(ctx, key)
+}
+
+// ListObjects lists the objects in the wrapped store. Implements [etcdbackup.ObjectStore].
+func (c *Store) ListObjects(ctx context.Context, prefix string) ([]etcdbackup.ObjectInfo, error) {
+	objectStore, ok := c.wrapped.(etcdbackup.ObjectStore)
+	if !ok {
+		return nil, etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	return objectStore.ListObjects(ctx, prefix)
+}
+
+// DeleteObject removes the object from the wrapped store. Implements [etcdbackup.ObjectStore].
+func (c *Store) DeleteObject(ctx context.Context, key string) error {
+	objectStore, ok := c.wrapped.(etcdbackup.ObjectStore)
+	if !ok {
+		return etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	return objectStore.DeleteObject(ctx, key)
+}
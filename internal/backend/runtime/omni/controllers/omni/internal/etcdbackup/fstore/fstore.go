@@ -8,10 +8,13 @@ package fstore
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
 )
@@ -76,3 +79,103 @@ func (store *FileStore) ListBackups(_ context.Context, uuid string) (etcdbackup.
 		}, true, nil
 	}, nil
 }
+
+// PutObject stores the data from [io.Reader] under the key. Implements [etcdbackup.ObjectStore].
+func (store *FileStore) PutObject(_ context.Context, key string, r io.Reader) error {
+	fullpath, err := store.objectPath(key)
+	if err != nil {
+		return err
+	}
+
+	return putFile(fullpath, r)
+}
+
+// GetObject returns a reader for the object with the key. Implements [etcdbackup.ObjectStore].
+func (store *FileStore) GetObject(_ context.Context, key string) (io.ReadCloser, error) {
+	fullpath, err := store.objectPath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(fullpath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, etcdbackup.ErrObjectNotFound
+		}
+
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return f, nil
+}
+
+// ListObjects returns the objects with the keys starting with the prefix. Implements [etcdbackup.ObjectStore].
+func (store *FileStore) ListObjects(_ context.Context, prefix string) ([]etcdbackup.ObjectInfo, error) {
+	root, err := store.objectPath(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []etcdbackup.ObjectInfo
+
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if d.IsDir() || strings.HasSuffix(path, ".tmp") {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("failed to get file info: %w", err)
+		}
+
+		rel, err := filepath.Rel(store.dir, path)
+		if err != nil {
+			return err
+		}
+
+		objects = append(objects, etcdbackup.ObjectInfo{
+			Key:       filepath.ToSlash(rel),
+			Timestamp: info.ModTime(),
+			Size:      info.Size(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	return objects, nil
+}
+
+// DeleteObject removes the object with the key. Implements [etcdbackup.ObjectStore].
+func (store *FileStore) DeleteObject(_ context.Context, key string) error {
+	fullpath, err := store.objectPath(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(fullpath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove file: %w", err)
+	}
+
+	return nil
+}
+
+func (store *FileStore) objectPath(key string) (string, error) {
+	path := filepath.FromSlash(key)
+
+	if !filepath.IsLocal(path) {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+
+	return filepath.Join(store.dir, path), nil
+}
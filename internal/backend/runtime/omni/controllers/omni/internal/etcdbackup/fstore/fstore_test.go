@@ -6,6 +6,7 @@
 package fstore_test
 
 import (
+	"context"
 	"errors"
 	"io"
 	"os"
@@ -13,9 +14,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/gen/xtesting/check"
 	"github.com/stretchr/testify/require"
 
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/fstore"
 )
 
@@ -80,3 +83,45 @@ func (r *errReader) Read(p []byte) (int, error) {
 
 	return 0, r.err
 }
+
+func TestObjects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := fstore.NewFileStore(t.TempDir())
+
+	require.NoError(t, store.PutObject(ctx, "support-bundles/fleet/1.zip", strings.NewReader("fleet")))
+	require.NoError(t, store.PutObject(ctx, "support-bundles/clusters/test/2.zip", strings.NewReader("cluster")))
+	require.NoError(t, store.PutObject(ctx, "other/3.zip", strings.NewReader("other")))
+
+	require.Error(t, store.PutObject(ctx, "../escape.zip", strings.NewReader("escape")))
+
+	r, err := store.GetObject(ctx, "support-bundles/fleet/1.zip")
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "fleet", string(data))
+
+	_, err = store.GetObject(ctx, "support-bundles/fleet/missing.zip")
+	require.ErrorIs(t, err, etcdbackup.ErrObjectNotFound)
+
+	objects, err := store.ListObjects(ctx, "support-bundles")
+	require.NoError(t, err)
+
+	keys := xslices.Map(objects, func(object etcdbackup.ObjectInfo) string { return object.Key })
+
+	require.ElementsMatch(t, []string{"support-bundles/fleet/1.zip", "support-bundles/clusters/test/2.zip"}, keys)
+
+	require.NoError(t, store.DeleteObject(ctx, "support-bundles/fleet/1.zip"))
+	require.NoError(t, store.DeleteObject(ctx, "support-bundles/fleet/1.zip"))
+
+	objects, err = store.ListObjects(ctx, "support-bundles/fleet")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+
+	objects, err = store.ListObjects(ctx, "missing")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+}
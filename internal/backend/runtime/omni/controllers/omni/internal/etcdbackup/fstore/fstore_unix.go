@@ -38,6 +38,14 @@ func uploadFile(dir string, descr etcdbackup.Description, r io.Reader) error {
 	return atomicFileWrite(fullpath, r)
 }
 
+func putFile(fullpath string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(fullpath), 0o0755); err != nil {
+		return fmt.Errorf("failed to create dir: %w", err)
+	}
+
+	return atomicFileWrite(fullpath, r)
+}
+
 func downloadFile(dir string, snapshotName string) (io.ReadCloser, error) {
 	fullpath := filepath.Join(dir, snapshotName)
 
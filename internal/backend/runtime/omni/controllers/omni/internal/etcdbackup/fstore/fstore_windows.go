@@ -21,6 +21,10 @@ func uploadFile(dir string, descr etcdbackup.Description, r io.Reader) error {
 	return errors.New("file store is not supported on windows")
 }
 
+func putFile(fullpath string, r io.Reader) error {
+	return errors.New("file store is not supported on windows")
+}
+
 func downloadFile(dir string, snapshotName string) (io.ReadCloser, error) {
 	return nil, errors.New("file store is not supported on windows")
 }
@@ -8,6 +8,7 @@ package s3store
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"path"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/uuid"
 	"github.com/siderolabs/go-pointer"
 
@@ -123,3 +125,77 @@ func (s *Store) ListBackups(ctx context.Context, clusterUUID string) (etcdbackup
 		}
 	}, nil
 }
+
+// PutObject stores the data from [io.Reader] under the key. Implements [etcdbackup.ObjectStore].
+func (s *Store) PutObject(ctx context.Context, key string, r io.Reader) error {
+	uploader := manager.NewUploader(s.client)
+
+	_, err := uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket: pointer.To(s.bucket),
+		Key:    pointer.To(key),
+		Body:   r,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload object to s3: %w", err)
+	}
+
+	return nil
+}
+
+// GetObject returns a reader for the object with the key. Implements [etcdbackup.ObjectStore].
+func (s *Store) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	result, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: pointer.To(s.bucket),
+		Key:    pointer.To(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+
+		if errors.As(err, &noSuchKey) {
+			return nil, etcdbackup.ErrObjectNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	return result.Body, nil
+}
+
+// ListObjects returns the objects with the keys starting with the prefix. Implements [etcdbackup.ObjectStore].
+func (s *Store) ListObjects(ctx context.Context, prefix string) ([]etcdbackup.ObjectInfo, error) {
+	var objects []etcdbackup.ObjectInfo
+
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: pointer.To(s.bucket),
+		Prefix: pointer.To(prefix),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		for _, content := range page.Contents {
+			objects = append(objects, etcdbackup.ObjectInfo{
+				Key:       pointer.SafeDeref(content.Key),
+				Timestamp: pointer.SafeDeref(content.LastModified),
+				Size:      pointer.SafeDeref(content.Size),
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+// DeleteObject removes the object with the key. Implements [etcdbackup.ObjectStore].
+func (s *Store) DeleteObject(ctx context.Context, key string) error {
+	if _, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: pointer.To(s.bucket),
+		Key:    pointer.To(key),
+	}); err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package loadbalancer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// HealthCheckTCP checks that the TCP connection to the upstream can be established.
+	HealthCheckTCP = "tcp"
+
+	// HealthCheckReadyz checks that the kube-apiserver reports readiness on the /readyz HTTPS endpoint.
+	HealthCheckReadyz = "readyz"
+)
+
+// HealthChecker checks the health of a single upstream.
+type HealthChecker interface {
+	Check(ctx context.Context, address string) error
+}
+
+// NewHealthChecker returns the health checker of the given type.
+func NewHealthChecker(kind string) (HealthChecker, error) { //nolint:ireturn
+	switch kind {
+	case HealthCheckTCP, "":
+		return &TCPHealthChecker{}, nil
+	case HealthCheckReadyz:
+		return NewReadyzHealthChecker(), nil
+	default:
+		return nil, fmt.Errorf("unknown load balancer health check type %q", kind)
+	}
+}
+
+// TCPHealthChecker considers the upstream healthy if the TCP connection can be established.
+type TCPHealthChecker struct{}
+
+// Check implements HealthChecker.
+func (TCPHealthChecker) Check(ctx context.Context, address string) error {
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// ReadyzHealthChecker considers the upstream healthy if the kube-apiserver responds with 200 to the /readyz request.
+type ReadyzHealthChecker struct {
+	client *http.Client
+}
+
+// NewReadyzHealthChecker creates a new ReadyzHealthChecker.
+func NewReadyzHealthChecker() *ReadyzHealthChecker {
+	return &ReadyzHealthChecker{
+		client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					// the endpoint is only probed for the readiness, no sensitive data is transferred
+					InsecureSkipVerify: true, //nolint:gosec
+				},
+				DisableKeepAlives: true,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Check implements HealthChecker.
+func (checker *ReadyzHealthChecker) Check(ctx context.Context, address string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+address+"/readyz", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := checker.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected readyz status code %d: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// latencyTiers are the minimum health check latencies of the upstream tiers, faster upstreams are preferred.
+var latencyTiers = []time.Duration{
+	0,
+	time.Millisecond / 10,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+}
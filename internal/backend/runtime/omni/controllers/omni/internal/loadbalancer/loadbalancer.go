@@ -7,7 +7,6 @@
 package loadbalancer
 
 import (
-	"github.com/siderolabs/go-loadbalancer/controlplane"
 	"github.com/siderolabs/go-loadbalancer/upstream"
 	"go.uber.org/zap"
 
@@ -19,6 +18,7 @@ type LoadBalancer interface {
 	Start(upstreamCh <-chan []string) error
 	Shutdown() error
 	Healthy() (bool, error)
+	Upstreams() []UpstreamStatus
 }
 
 // NewFunc is a function type whose implementation should create a new load balancer.
@@ -26,14 +26,20 @@ type NewFunc func(bindAddress string, bindPort int, logger *zap.Logger) (LoadBal
 
 // DefaultNew returns a new load balancer with default settings.
 func DefaultNew(bindAddress string, bindPort int, logger *zap.Logger) (LoadBalancer, error) { //nolint:ireturn
-	return controlplane.NewLoadBalancer(
+	checker, err := NewHealthChecker(config.Config.LoadBalancer.HealthCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTCP(
 		bindAddress,
 		bindPort,
 		logger.WithOptions(zap.IncreaseLevel(zap.ErrorLevel)), // silence the load balancer logs
-		controlplane.WithDialTimeout(config.Config.LoadBalancer.DialTimeout),
-		controlplane.WithKeepAlivePeriod(config.Config.LoadBalancer.KeepAlivePeriod),
-		controlplane.WithTCPUserTimeout(config.Config.LoadBalancer.TCPUserTimeout),
-		controlplane.WithHealthCheckOptions(
+		WithDialTimeout(config.Config.LoadBalancer.DialTimeout),
+		WithKeepAlivePeriod(config.Config.LoadBalancer.KeepAlivePeriod),
+		WithTCPUserTimeout(config.Config.LoadBalancer.TCPUserTimeout),
+		WithHealthChecker(checker),
+		WithHealthCheckOptions(
 			upstream.WithHealthcheckInterval(config.Config.LoadBalancer.HealthCheckInterval),
 			upstream.WithHealthcheckTimeout(config.Config.LoadBalancer.HealthCheckTimeout),
 		),
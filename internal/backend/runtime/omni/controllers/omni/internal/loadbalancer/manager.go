@@ -8,7 +8,10 @@ package loadbalancer
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
@@ -23,6 +26,13 @@ type Manager struct {
 	running map[ID]wrapper
 	logger  *zap.Logger
 	newFunc NewFunc
+
+	metricUpstreamHealthy             *prometheus.Desc
+	metricUpstreamActiveConnections   *prometheus.Desc
+	metricUpstreamConnections         *prometheus.Desc
+	metricUpstreamHealthCheckFailures *prometheus.Desc
+
+	mu sync.Mutex
 }
 
 // Spec configures a loadbalancer.
@@ -31,25 +41,64 @@ type Spec struct {
 	BindPort    int
 }
 
+// Status is the health status of a loadbalancer.
+type Status struct {
+	Upstreams []UpstreamStatus
+	Healthy   bool
+}
+
+// equal compares the statuses ignoring the connection counters, which are only reported as metrics.
+func (s Status) equal(other Status) bool {
+	return s.Healthy == other.Healthy && slices.EqualFunc(s.Upstreams, other.Upstreams, func(a, b UpstreamStatus) bool {
+		return a.Address == b.Address && a.Healthy == b.Healthy && a.LastError == b.LastError
+	})
+}
+
 // wrapper wraps a loadbalancer and its upstream channel.
 type wrapper struct {
-	lb          LoadBalancer
-	upstreamCh  chan []string
-	spec        Spec
-	lastHealthy optional.Optional[bool]
+	lb         LoadBalancer
+	upstreamCh chan []string
+	spec       Spec
+	lastStatus optional.Optional[Status]
 }
 
 // NewManager returns a new loadbalancer manager.
 func NewManager(logger *zap.Logger, newFunc NewFunc) *Manager {
+	upstreamLabels := []string{"cluster", "upstream"}
+
 	return &Manager{
 		running: make(map[ID]wrapper),
 		logger:  logger,
 		newFunc: newFunc,
+
+		metricUpstreamHealthy: prometheus.NewDesc(
+			"omni_loadbalancer_upstream_healthy",
+			"Whether the last health check of the cluster load balancer upstream succeeded.",
+			upstreamLabels, nil,
+		),
+		metricUpstreamActiveConnections: prometheus.NewDesc(
+			"omni_loadbalancer_upstream_active_connections",
+			"Number of active connections proxied to the cluster load balancer upstream.",
+			upstreamLabels, nil,
+		),
+		metricUpstreamConnections: prometheus.NewDesc(
+			"omni_loadbalancer_upstream_connections_total",
+			"Number of connections proxied to the cluster load balancer upstream.",
+			upstreamLabels, nil,
+		),
+		metricUpstreamHealthCheckFailures: prometheus.NewDesc(
+			"omni_loadbalancer_upstream_health_check_failures_total",
+			"Number of failed health checks of the cluster load balancer upstream.",
+			upstreamLabels, nil,
+		),
 	}
 }
 
 // Stop stops all running loadbalancers.
 func (m *Manager) Stop() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	return errors.Join(xslices.Map(maps.Values(m.running), func(w wrapper) error {
 		return w.lb.Shutdown()
 	})...)
@@ -57,6 +106,9 @@ func (m *Manager) Stop() error {
 
 // Reconcile by starting/stopping/replacing loadbalancer as needed.
 func (m *Manager) Reconcile(specs map[ID]Spec) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	var allErrors []error
 
 	// stop all loadbalancers that should not be running
@@ -108,8 +160,11 @@ func (m *Manager) Reconcile(specs map[ID]Spec) error {
 }
 
 // GetHealthStatus compiles the health status of all loadbalancers, returning the diff with previous reported status.
-func (m *Manager) GetHealthStatus() map[ID]bool {
-	status := map[ID]bool{}
+func (m *Manager) GetHealthStatus() map[ID]Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	statuses := map[ID]Status{}
 
 	for id, w := range m.running {
 		healthy, err := w.lb.Healthy()
@@ -119,22 +174,61 @@ func (m *Manager) GetHealthStatus() map[ID]bool {
 			healthy = false
 		}
 
-		previousHealthy, exists := w.lastHealthy.Get()
-		if !exists || healthy != previousHealthy {
-			status[id] = healthy
+		status := Status{
+			Healthy:   healthy,
+			Upstreams: w.lb.Upstreams(),
+		}
+
+		previousStatus, exists := w.lastStatus.Get()
+		if !exists || !status.equal(previousStatus) {
+			statuses[id] = status
 		}
 
-		w.lastHealthy = optional.Some(healthy)
+		w.lastStatus = optional.Some(status)
+		m.running[id] = w
 	}
 
-	return status
+	return statuses
 }
 
 // UpdateUpstreams updates the upstreams of all loadbalancers.
 func (m *Manager) UpdateUpstreams(upstreams map[ID][]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	// running loadbalancers always consume from the channel
 	// also loadbalancers ignore if nothing changed, so we can always send
 	for id, w := range m.running {
 		w.upstreamCh <- upstreams[id]
 	}
 }
+
+// Describe implements prom.Collector interface.
+func (m *Manager) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.metricUpstreamHealthy
+	ch <- m.metricUpstreamActiveConnections
+	ch <- m.metricUpstreamConnections
+	ch <- m.metricUpstreamHealthCheckFailures
+}
+
+// Collect implements prom.Collector interface.
+func (m *Manager) Collect(ch chan<- prometheus.Metric) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, w := range m.running {
+		for _, upstream := range w.lb.Upstreams() {
+			healthy := 0.0
+			if upstream.Healthy {
+				healthy = 1
+			}
+
+			ch <- prometheus.MustNewConstMetric(m.metricUpstreamHealthy, prometheus.GaugeValue, healthy, id, upstream.Address)
+			ch <- prometheus.MustNewConstMetric(m.metricUpstreamActiveConnections, prometheus.GaugeValue, float64(upstream.ActiveConnections), id, upstream.Address)
+			ch <- prometheus.MustNewConstMetric(m.metricUpstreamConnections, prometheus.CounterValue, float64(upstream.TotalConnections), id, upstream.Address)
+			ch <- prometheus.MustNewConstMetric(m.metricUpstreamHealthCheckFailures, prometheus.CounterValue, float64(upstream.HealthCheckFailures), id, upstream.Address)
+		}
+	}
+}
+
+var _ prometheus.Collector = &Manager{}
@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package loadbalancer
+
+import (
+	"context"
+	"maps"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/siderolabs/tcpproxy"
+	"go.uber.org/zap"
+)
+
+// SNIRouter multiplexes the cluster load balancers on a single port, routing the connections by the TLS server name.
+//
+// The TLS connections are passed through as is, so the kube-apiserver certificates are served to the clients.
+type SNIRouter struct {
+	logger   *zap.Logger
+	routes   map[string]string
+	proxy    tcpproxy.Proxy
+	endpoint string
+	mu       sync.Mutex
+	started  bool
+}
+
+// NewSNIRouter creates a new SNIRouter listening on the given endpoint.
+func NewSNIRouter(endpoint string, logger *zap.Logger) *SNIRouter {
+	router := &SNIRouter{
+		endpoint: endpoint,
+		logger:   logger.With(zap.String("endpoint", endpoint)),
+		routes:   map[string]string{},
+	}
+
+	router.proxy.AddSNIRouteFunc(endpoint, router.route)
+
+	return router
+}
+
+// Start the router.
+func (router *SNIRouter) Start() error {
+	if err := router.proxy.Start(); err != nil {
+		return err
+	}
+
+	router.started = true
+
+	return nil
+}
+
+// SetRoutes replaces the routes of the router: TLS server name -> load balancer address.
+//
+// The existing connections are not affected.
+func (router *SNIRouter) SetRoutes(routes map[string]string) {
+	router.mu.Lock()
+	defer router.mu.Unlock()
+
+	router.routes = maps.Clone(routes)
+}
+
+// Shutdown stops the router and waits for the connections to be closed.
+func (router *SNIRouter) Shutdown() error {
+	if !router.started {
+		return nil
+	}
+
+	if err := router.proxy.Close(); err != nil {
+		return err
+	}
+
+	router.proxy.Wait() //nolint:errcheck
+
+	return nil
+}
+
+func (router *SNIRouter) route(_ context.Context, serverName string) (tcpproxy.Target, bool) {
+	router.mu.Lock()
+	address, ok := router.routes[strings.ToLower(serverName)]
+	router.mu.Unlock()
+
+	if !ok {
+		router.logger.Debug("no load balancer for the server name", zap.String("server_name", serverName))
+
+		return nil, false
+	}
+
+	target := tcpproxy.To(address)
+	target.OnDialError = func(src net.Conn, dstDialErr error) {
+		src.Close() //nolint:errcheck
+
+		router.logger.Warn("error dialing load balancer", zap.String("server_name", serverName), zap.String("address", address), zap.Error(dstDialErr))
+	}
+
+	return target, true
+}
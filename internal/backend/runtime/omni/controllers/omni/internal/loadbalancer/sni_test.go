@@ -0,0 +1,89 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package loadbalancer_test
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/loadbalancer"
+)
+
+func TestSNIRouter(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(name string) string {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte(name)) //nolint:errcheck
+		}))
+
+		t.Cleanup(server.Close)
+
+		return server.Listener.Addr().String()
+	}
+
+	endpoint := net.JoinHostPort("127.0.0.1", strconv.Itoa(freePort(t)))
+
+	router := loadbalancer.NewSNIRouter(endpoint, zaptest.NewLogger(t))
+
+	require.NoError(t, router.Start())
+
+	t.Cleanup(func() { require.NoError(t, router.Shutdown()) })
+
+	router.SetRoutes(map[string]string{
+		"cluster1.example.org": newServer("cluster1"),
+		"cluster2.example.org": newServer("cluster2"),
+	})
+
+	get := func(serverName string) (string, error) {
+		client := &http.Client{
+			Timeout: 5 * time.Second,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+					var d net.Dialer
+
+					return d.DialContext(ctx, network, endpoint)
+				},
+				TLSClientConfig: &tls.Config{
+					ServerName:         serverName,
+					InsecureSkipVerify: true, //nolint:gosec
+				},
+			},
+		}
+
+		resp, err := client.Get("https://" + serverName + "/")
+		if err != nil {
+			return "", err
+		}
+
+		defer resp.Body.Close() //nolint:errcheck
+
+		body, err := io.ReadAll(resp.Body)
+
+		return string(body), err
+	}
+
+	body, err := get("cluster1.example.org")
+	require.NoError(t, err)
+	require.Equal(t, "cluster1", body)
+
+	body, err = get("CLUSTER2.example.org")
+	require.NoError(t, err)
+	require.Equal(t, "cluster2", body)
+
+	_, err = get("cluster3.example.org")
+	require.Error(t, err)
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-loadbalancer/upstream"
+	"github.com/siderolabs/tcpproxy"
+	"go.uber.org/zap"
+)
+
+// UpstreamStatus describes the state of a single upstream of the load balancer.
+type UpstreamStatus struct {
+	Address   string
+	LastError string
+
+	TotalConnections    uint64
+	HealthCheckFailures uint64
+	ActiveConnections   uint32
+
+	Healthy bool
+}
+
+// backend is a single upstream of the TCP load balancer which tracks its own health and connections.
+type backend struct {
+	checker HealthChecker
+	address string
+
+	mu        sync.Mutex
+	lastError string
+	healthy   bool
+
+	totalConnections    atomic.Uint64
+	healthCheckFailures atomic.Uint64
+	activeConnections   atomic.Int32
+}
+
+func newBackend(address string, checker HealthChecker) *backend {
+	// upstreams are considered healthy until the first check, the same as the upstream list does
+	return &backend{
+		address: address,
+		checker: checker,
+		healthy: true,
+	}
+}
+
+// HealthCheck implements upstream.Backend.
+func (b *backend) HealthCheck(ctx context.Context) (upstream.Tier, error) {
+	start := time.Now()
+
+	err := b.checker.Check(ctx, b.address)
+
+	b.setResult(err)
+
+	if err != nil {
+		b.healthCheckFailures.Add(1)
+
+		// preserve the old tier
+		return -1, err
+	}
+
+	elapsed := time.Since(start)
+
+	for i := len(latencyTiers) - 1; i >= 0; i-- {
+		if elapsed >= latencyTiers[i] {
+			return upstream.Tier(i), nil
+		}
+	}
+
+	return 0, nil
+}
+
+func (b *backend) setResult(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.healthy = err == nil
+
+	if err != nil {
+		b.lastError = err.Error()
+	} else {
+		b.lastError = ""
+	}
+}
+
+func (b *backend) status() UpstreamStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return UpstreamStatus{
+		Address:             b.address,
+		Healthy:             b.healthy,
+		LastError:           b.lastError,
+		ActiveConnections:   uint32(max(b.activeConnections.Load(), 0)),
+		TotalConnections:    b.totalConnections.Load(),
+		HealthCheckFailures: b.healthCheckFailures.Load(),
+	}
+}
+
+// TCPOption configures the TCP load balancer.
+type TCPOption func(*TCP)
+
+// WithDialTimeout configures the dial timeout.
+func WithDialTimeout(timeout time.Duration) TCPOption {
+	return func(lb *TCP) {
+		lb.dialTimeout = timeout
+	}
+}
+
+// WithKeepAlivePeriod configures the keepalive period.
+func WithKeepAlivePeriod(period time.Duration) TCPOption {
+	return func(lb *TCP) {
+		lb.keepAlivePeriod = period
+	}
+}
+
+// WithTCPUserTimeout configures the TCP user timeout.
+func WithTCPUserTimeout(timeout time.Duration) TCPOption {
+	return func(lb *TCP) {
+		lb.tcpUserTimeout = timeout
+	}
+}
+
+// WithHealthChecker configures the health checker of the upstreams.
+func WithHealthChecker(checker HealthChecker) TCPOption {
+	return func(lb *TCP) {
+		lb.checker = checker
+	}
+}
+
+// WithHealthCheckOptions configures the health check options of the upstream list.
+func WithHealthCheckOptions(options ...upstream.ListOption) TCPOption {
+	return func(lb *TCP) {
+		lb.healthCheckOptions = append(lb.healthCheckOptions, options...)
+	}
+}
+
+// TCP is a Kubernetes control plane TCP load balancer which reports the state of each upstream.
+type TCP struct {
+	checker HealthChecker
+	logger  *zap.Logger
+	list    *upstream.List[*backend]
+	done    chan struct{}
+
+	backends map[string]*backend
+
+	endpoint           string
+	healthCheckOptions []upstream.ListOption
+	proxy              tcpproxy.Proxy
+
+	dialTimeout     time.Duration
+	keepAlivePeriod time.Duration
+	tcpUserTimeout  time.Duration
+
+	mu sync.Mutex
+}
+
+// NewTCP initializes the TCP load balancer.
+func NewTCP(bindAddress string, bindPort int, logger *zap.Logger, options ...TCPOption) (*TCP, error) {
+	lb := &TCP{
+		endpoint: net.JoinHostPort(bindAddress, strconv.Itoa(bindPort)),
+		checker:  &TCPHealthChecker{},
+		backends: map[string]*backend{},
+
+		// set aggressive timeouts to prevent proxying to unhealthy upstreams
+		dialTimeout:     5 * time.Second,
+		keepAlivePeriod: time.Second,
+		tcpUserTimeout:  5 * time.Second,
+	}
+
+	for _, option := range options {
+		option(lb)
+	}
+
+	lb.logger = logger.Named("controlplane-lb").With(zap.String("endpoint", lb.endpoint))
+
+	list, err := upstream.NewListWithCmp(nil, func(a, b *backend) bool {
+		return a.address == b.address
+	}, append([]upstream.ListOption{upstream.WithTiers(0, upstream.Tier(len(latencyTiers)-1), 1)}, lb.healthCheckOptions...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	lb.list = list
+
+	lb.proxy.AddRoute(lb.endpoint, lb)
+
+	return lb, nil
+}
+
+// Start the load balancer providing a channel which provides endpoint list updates.
+func (lb *TCP) Start(upstreamCh <-chan []string) error {
+	if err := lb.proxy.Start(); err != nil {
+		lb.list.Shutdown()
+
+		return err
+	}
+
+	lb.done = make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case upstreams := <-upstreamCh:
+				lb.reconcile(upstreams)
+			case <-lb.done:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (lb *TCP) reconcile(addresses []string) {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+
+	backends := make(map[string]*backend, len(addresses))
+
+	for _, address := range addresses {
+		b, ok := lb.backends[address]
+		if !ok {
+			b = newBackend(address, lb.checker)
+		}
+
+		backends[address] = b
+	}
+
+	lb.backends = backends
+
+	lb.list.Reconcile(xslices.Map(addresses, func(address string) *backend { return backends[address] }))
+}
+
+// Healthy returns true if at least one upstream is available.
+func (lb *TCP) Healthy() (bool, error) {
+	if _, err := lb.list.Pick(); err != nil {
+		if errors.Is(err, upstream.ErrNoUpstreams) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Upstreams returns the state of the upstreams sorted by the address.
+func (lb *TCP) Upstreams() []UpstreamStatus {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+
+	statuses := make([]UpstreamStatus, 0, len(lb.backends))
+
+	for _, b := range lb.backends {
+		statuses = append(statuses, b.status())
+	}
+
+	slices.SortFunc(statuses, func(a, b UpstreamStatus) int {
+		return strings.Compare(a.Address, b.Address)
+	})
+
+	return statuses
+}
+
+// Shutdown the load balancer listener and wait for the connections to be closed.
+func (lb *TCP) Shutdown() error {
+	lb.list.Shutdown()
+
+	if lb.done == nil { // not started
+		return nil
+	}
+
+	if err := lb.proxy.Close(); err != nil {
+		return err
+	}
+
+	close(lb.done)
+
+	lb.proxy.Wait() //nolint:errcheck
+
+	return nil
+}
+
+// HandleConn implements tcpproxy.Target.
+func (lb *TCP) HandleConn(conn net.Conn) {
+	b, err := lb.list.Pick()
+	if err != nil {
+		lb.logger.Warn("no upstreams available, closing connection", zap.String("remote_addr", conn.RemoteAddr().String()))
+
+		conn.Close() //nolint:errcheck
+
+		return
+	}
+
+	b.totalConnections.Add(1)
+	b.activeConnections.Add(1)
+
+	defer b.activeConnections.Add(-1)
+
+	upstreamTarget := tcpproxy.To(b.address)
+	upstreamTarget.DialTimeout = lb.dialTimeout
+	upstreamTarget.KeepAlivePeriod = lb.keepAlivePeriod
+	upstreamTarget.TCPUserTimeout = lb.tcpUserTimeout
+	upstreamTarget.OnDialError = func(src net.Conn, dstDialErr error) {
+		src.Close() //nolint:errcheck
+
+		lb.logger.Warn("error dialing upstream", zap.String("upstream_addr", b.address), zap.Error(dstDialErr))
+
+		b.setResult(dstDialErr)
+
+		lb.list.Down(b)
+	}
+
+	upstreamTarget.HandleConn(conn)
+}
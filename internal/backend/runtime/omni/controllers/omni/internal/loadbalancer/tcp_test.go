@@ -0,0 +1,243 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package loadbalancer_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-loadbalancer/upstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/loadbalancer"
+)
+
+func startEchoServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close() //nolint:errcheck
+
+				io.Copy(conn, conn) //nolint:errcheck
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func freePort(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	port := listener.Addr().(*net.TCPAddr).Port //nolint:forcetypeassert,errcheck
+
+	require.NoError(t, listener.Close())
+
+	return port
+}
+
+func TestTCPUpstreams(t *testing.T) {
+	t.Parallel()
+
+	healthyUpstream := startEchoServer(t)
+	unhealthyUpstream := net.JoinHostPort("127.0.0.1", strconv.Itoa(freePort(t)))
+
+	port := freePort(t)
+
+	lb, err := loadbalancer.NewTCP("127.0.0.1", port, zaptest.NewLogger(t),
+		loadbalancer.WithHealthCheckOptions(
+			upstream.WithHealthcheckInterval(50*time.Millisecond),
+			upstream.WithHealthcheckTimeout(time.Second),
+		),
+	)
+	require.NoError(t, err)
+
+	upstreamCh := make(chan []string)
+
+	require.NoError(t, lb.Start(upstreamCh))
+
+	t.Cleanup(func() { require.NoError(t, lb.Shutdown()) })
+
+	upstreamCh <- []string{healthyUpstream, unhealthyUpstream}
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		upstreams := lb.Upstreams()
+
+		if !assert.Len(collect, upstreams, 2) {
+			return
+		}
+
+		statuses := map[string]loadbalancer.UpstreamStatus{}
+		for _, status := range upstreams {
+			statuses[status.Address] = status
+		}
+
+		assert.True(collect, statuses[healthyUpstream].Healthy)
+		assert.Empty(collect, statuses[healthyUpstream].LastError)
+
+		assert.False(collect, statuses[unhealthyUpstream].Healthy)
+		assert.NotEmpty(collect, statuses[unhealthyUpstream].LastError)
+		assert.NotZero(collect, statuses[unhealthyUpstream].HealthCheckFailures)
+	}, 10*time.Second, 50*time.Millisecond)
+
+	healthy, err := lb.Healthy()
+	require.NoError(t, err)
+	require.True(t, healthy)
+
+	// the unhealthy upstream is eventually excluded from the balancing, so the connection goes to the healthy one
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		conn, dialErr := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+		if !assert.NoError(collect, dialErr) {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		if dialErr = conn.SetDeadline(time.Now().Add(time.Second)); !assert.NoError(collect, dialErr) {
+			return
+		}
+
+		if _, dialErr = conn.Write([]byte("ping")); !assert.NoError(collect, dialErr) {
+			return
+		}
+
+		buf := make([]byte, 4)
+
+		_, dialErr = io.ReadFull(conn, buf)
+		assert.NoError(collect, dialErr)
+		assert.Equal(collect, "ping", string(buf))
+
+		for _, status := range lb.Upstreams() {
+			if status.Address == healthyUpstream {
+				assert.EqualValues(collect, 1, status.ActiveConnections)
+			}
+		}
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// removed upstreams are not reported anymore
+	upstreamCh <- []string{healthyUpstream}
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		upstreams := lb.Upstreams()
+
+		if assert.Len(collect, upstreams, 1) {
+			assert.Equal(collect, healthyUpstream, upstreams[0].Address)
+			assert.Zero(collect, upstreams[0].ActiveConnections)
+		}
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestTCPUpstreamRecovers(t *testing.T) {
+	t.Parallel()
+
+	upstreamAddress := net.JoinHostPort("127.0.0.1", strconv.Itoa(freePort(t)))
+
+	lb, err := loadbalancer.NewTCP("127.0.0.1", freePort(t), zaptest.NewLogger(t),
+		loadbalancer.WithHealthCheckOptions(
+			upstream.WithHealthcheckInterval(50*time.Millisecond),
+			upstream.WithHealthcheckTimeout(time.Second),
+		),
+	)
+	require.NoError(t, err)
+
+	upstreamCh := make(chan []string)
+
+	require.NoError(t, lb.Start(upstreamCh))
+
+	t.Cleanup(func() { require.NoError(t, lb.Shutdown()) })
+
+	upstreamCh <- []string{upstreamAddress}
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		upstreams := lb.Upstreams()
+
+		if assert.Len(collect, upstreams, 1) {
+			assert.False(collect, upstreams[0].Healthy)
+			assert.NotEmpty(collect, upstreams[0].LastError)
+		}
+	}, 10*time.Second, 50*time.Millisecond)
+
+	listener, err := net.Listen("tcp", upstreamAddress)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	// the error of the failed checks is cleared once the upstream is reachable again
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		upstreams := lb.Upstreams()
+
+		if assert.Len(collect, upstreams, 1) {
+			assert.True(collect, upstreams[0].Healthy)
+			assert.Empty(collect, upstreams[0].LastError)
+		}
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestReadyzHealthChecker(t *testing.T) {
+	t.Parallel()
+
+	var ready atomic.Bool
+
+	ready.Store(true)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/readyz" {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		if !ready.Load() {
+			http.Error(w, "[-]etcd failed", http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Write([]byte("ok")) //nolint:errcheck
+	}))
+
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	checker, err := loadbalancer.NewHealthChecker(loadbalancer.HealthCheckReadyz)
+	require.NoError(t, err)
+
+	address := strings.TrimPrefix(server.URL, "https://")
+
+	require.NoError(t, checker.Check(ctx, address))
+
+	ready.Store(false)
+
+	err = checker.Check(ctx, address)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "etcd failed")
+
+	_, err = loadbalancer.NewHealthChecker("unknown")
+	require.Error(t, err)
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package machinehealth derives the hardware health diagnostics from the subsequent machine statuses.
+package machinehealth
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/inventory"
+)
+
+// Diagnostic types.
+const (
+	TypeDiskMissing  = "disk_missing"
+	TypeDiskReadOnly = "disk_readonly"
+	TypeLinkFlapping = "link_flapping"
+	TypeTalos        = "talos"
+)
+
+const (
+	// FlapWindow is the period the link transitions are counted in.
+	FlapWindow = time.Hour
+
+	// FlapThreshold is the number of the link state transitions in the FlapWindow which marks the link as flapping.
+	FlapThreshold = 4
+
+	// MissingDiskRetention is the time after which a missing disk is considered to be removed on purpose and is forgotten.
+	MissingDiskRetention = 7 * 24 * time.Hour
+
+	// HistorySize is the number of the resolved diagnostics kept in the history.
+	HistorySize = 50
+)
+
+// Update updates the health status from the current machine status.
+//
+// It returns the duration after which the health should be re-evaluated even if the machine status doesn't change, or zero.
+func Update(health *specs.MachineHealthStatusSpec, status *specs.MachineStatusSpec, now time.Time) time.Duration {
+	previous := make(map[string]*specs.MachineHealthStatusSpec_Diagnostic, len(health.Diagnostics))
+
+	for _, diagnostic := range health.Diagnostics {
+		previous[diagnosticKey(diagnostic)] = diagnostic
+	}
+
+	var current []*specs.MachineHealthStatusSpec_Diagnostic
+
+	current = append(current, updateDisks(health, status.GetHardware().GetBlockdevices(), previous, now)...)
+	current = append(current, readOnlyDisks(status.GetHardware().GetBlockdevices())...)
+
+	linkDiagnostics, requeueAfter := updateLinks(health, status.GetNetwork().GetNetworkLinks(), previous, now)
+
+	current = append(current, linkDiagnostics...)
+
+	for _, diagnostic := range status.GetDiagnostics() {
+		current = append(current, &specs.MachineHealthStatusSpec_Diagnostic{
+			Type:     TypeTalos,
+			Severity: specs.MachineHealthStatusSpec_WARNING,
+			Subject:  diagnostic.Id,
+			Message:  diagnostic.Message,
+		})
+	}
+
+	health.Severity = specs.MachineHealthStatusSpec_INFO
+
+	for _, diagnostic := range current {
+		key := diagnosticKey(diagnostic)
+
+		if prev, ok := previous[key]; ok {
+			diagnostic.FirstSeen = prev.FirstSeen
+
+			delete(previous, key)
+		} else {
+			diagnostic.FirstSeen = timestamppb.New(now)
+		}
+
+		health.Severity = max(health.Severity, diagnostic.Severity)
+	}
+
+	// the previous diagnostics which are not active anymore are resolved
+	for _, diagnostic := range health.Diagnostics {
+		if _, ok := previous[diagnosticKey(diagnostic)]; !ok {
+			continue
+		}
+
+		diagnostic.Resolved = timestamppb.New(now)
+
+		health.History = append(health.History, diagnostic)
+	}
+
+	if len(health.History) > HistorySize {
+		health.History = slices.Clone(health.History[len(health.History)-HistorySize:])
+	}
+
+	slices.SortFunc(current, func(a, b *specs.MachineHealthStatusSpec_Diagnostic) int {
+		if c := cmp.Compare(b.Severity, a.Severity); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(diagnosticKey(a), diagnosticKey(b))
+	})
+
+	health.Diagnostics = current
+
+	return requeueAfter
+}
+
+func diagnosticKey(diagnostic *specs.MachineHealthStatusSpec_Diagnostic) string {
+	return diagnostic.Type + "/" + diagnostic.Subject
+}
+
+// carryOver keeps the previous diagnostics of the type, it is used when the machine status lacks the information to evaluate them.
+func carryOver(previous map[string]*specs.MachineHealthStatusSpec_Diagnostic, diagnosticType string) []*specs.MachineHealthStatusSpec_Diagnostic {
+	var diagnostics []*specs.MachineHealthStatusSpec_Diagnostic
+
+	for _, diagnostic := range previous {
+		if diagnostic.Type == diagnosticType {
+			diagnostics = append(diagnostics, diagnostic.CloneVT())
+		}
+	}
+
+	return diagnostics
+}
+
+func diskKey(device *specs.MachineStatusSpec_HardwareStatus_BlockDevice) string {
+	return inventory.Disk{
+		LinuxName: device.LinuxName,
+		Serial:    device.Serial,
+		WWID:      device.Wwid,
+	}.Key()
+}
+
+func updateDisks(health *specs.MachineHealthStatusSpec, devices []*specs.MachineStatusSpec_HardwareStatus_BlockDevice,
+	previous map[string]*specs.MachineHealthStatusSpec_Diagnostic, now time.Time,
+) []*specs.MachineHealthStatusSpec_Diagnostic {
+	// the disks are not polled yet, or the machine is not reachable
+	if len(devices) == 0 {
+		return carryOver(previous, TypeDiskMissing)
+	}
+
+	present := make(map[string]*specs.MachineStatusSpec_HardwareStatus_BlockDevice, len(devices))
+
+	for _, device := range devices {
+		present[diskKey(device)] = device
+	}
+
+	var (
+		diagnostics []*specs.MachineHealthStatusSpec_Diagnostic
+		disks       []*specs.MachineHealthStatusSpec_Disk
+	)
+
+	for _, disk := range health.Disks {
+		if device, ok := present[disk.Key]; ok {
+			disk.LinuxName = device.LinuxName
+			disk.LastSeen = timestamppb.New(now)
+
+			delete(present, disk.Key)
+		} else {
+			if now.Sub(disk.LastSeen.AsTime()) > MissingDiskRetention {
+				continue
+			}
+
+			diagnostics = append(diagnostics, &specs.MachineHealthStatusSpec_Diagnostic{
+				Type:     TypeDiskMissing,
+				Severity: specs.MachineHealthStatusSpec_ERROR,
+				Subject:  disk.Key,
+				Message:  fmt.Sprintf("disk %s is missing since %s", disk.LinuxName, disk.LastSeen.AsTime().Format(time.RFC3339)),
+			})
+		}
+
+		disks = append(disks, disk)
+	}
+
+	for _, device := range devices {
+		key := diskKey(device)
+
+		if _, ok := present[key]; !ok {
+			continue
+		}
+
+		delete(present, key)
+
+		disks = append(disks, &specs.MachineHealthStatusSpec_Disk{
+			Key:       key,
+			LinuxName: device.LinuxName,
+			LastSeen:  timestamppb.New(now),
+		})
+	}
+
+	health.Disks = disks
+
+	return diagnostics
+}
+
+func readOnlyDisks(devices []*specs.MachineStatusSpec_HardwareStatus_BlockDevice) []*specs.MachineHealthStatusSpec_Diagnostic {
+	var diagnostics []*specs.MachineHealthStatusSpec_Diagnostic
+
+	for _, device := range devices {
+		// optical drives are always read-only
+		if !device.Readonly || device.Type == "CD" {
+			continue
+		}
+
+		diagnostics = append(diagnostics, &specs.MachineHealthStatusSpec_Diagnostic{
+			Type:     TypeDiskReadOnly,
+			Severity: specs.MachineHealthStatusSpec_WARNING,
+			Subject:  diskKey(device),
+			Message:  fmt.Sprintf("disk %s is read-only", device.LinuxName),
+		})
+	}
+
+	return diagnostics
+}
+
+func updateLinks(health *specs.MachineHealthStatusSpec, links []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus,
+	previous map[string]*specs.MachineHealthStatusSpec_Diagnostic, now time.Time,
+) ([]*specs.MachineHealthStatusSpec_Diagnostic, time.Duration) {
+	// the links are not polled yet
+	if len(links) == 0 {
+		return carryOver(previous, TypeLinkFlapping), 0
+	}
+
+	known := make(map[string]*specs.MachineHealthStatusSpec_Link, len(health.Links))
+
+	for _, link := range health.Links {
+		known[link.Name] = link
+	}
+
+	var (
+		diagnostics  []*specs.MachineHealthStatusSpec_Diagnostic
+		requeueAfter time.Duration
+		updated      = make([]*specs.MachineHealthStatusSpec_Link, 0, len(links))
+	)
+
+	for _, linkStatus := range links {
+		link, ok := known[linkStatus.LinuxName]
+		if !ok {
+			link = &specs.MachineHealthStatusSpec_Link{
+				Name:         linkStatus.LinuxName,
+				LinkUp:       linkStatus.LinkUp,
+				StateChanges: linkStatus.StateChanges,
+			}
+		}
+
+		// the changes counter restarts when Omni reconnects to the machine
+		changes := linkStatus.StateChanges
+		if changes >= link.StateChanges {
+			changes -= link.StateChanges
+		}
+
+		// the changes between the polls are only seen in the counter
+		if changes == 0 && link.LinkUp != linkStatus.LinkUp {
+			changes = 1
+		}
+
+		for range min(changes, FlapThreshold) {
+			link.Transitions = append(link.Transitions, timestamppb.New(now))
+		}
+
+		link.LinkUp = linkStatus.LinkUp
+		link.StateChanges = linkStatus.StateChanges
+
+		link.Transitions = slices.DeleteFunc(link.Transitions, func(transition *timestamppb.Timestamp) bool {
+			return now.Sub(transition.AsTime()) >= FlapWindow
+		})
+
+		if len(link.Transitions) > 0 {
+			expiresIn := link.Transitions[0].AsTime().Add(FlapWindow).Sub(now)
+
+			if requeueAfter == 0 || expiresIn < requeueAfter {
+				requeueAfter = expiresIn
+			}
+		}
+
+		if len(link.Transitions) >= FlapThreshold {
+			diagnostics = append(diagnostics, &specs.MachineHealthStatusSpec_Diagnostic{
+				Type:     TypeLinkFlapping,
+				Severity: specs.MachineHealthStatusSpec_WARNING,
+				Subject:  link.Name,
+				Message:  fmt.Sprintf("link %s changed its state %d times in the last %s", link.Name, len(link.Transitions), FlapWindow),
+			})
+		}
+
+		updated = append(updated, link)
+	}
+
+	health.Links = updated
+
+	return diagnostics, requeueAfter
+}
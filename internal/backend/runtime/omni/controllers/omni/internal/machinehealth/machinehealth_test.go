@@ -0,0 +1,188 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machinehealth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/machinehealth"
+)
+
+func machineStatus(disks []*specs.MachineStatusSpec_HardwareStatus_BlockDevice, links ...*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus) *specs.MachineStatusSpec {
+	return &specs.MachineStatusSpec{
+		Hardware: &specs.MachineStatusSpec_HardwareStatus{
+			Blockdevices: disks,
+		},
+		Network: &specs.MachineStatusSpec_NetworkStatus{
+			NetworkLinks: links,
+		},
+	}
+}
+
+func TestDiskMissing(t *testing.T) {
+	t.Parallel()
+
+	health := &specs.MachineHealthStatusSpec{}
+	now := time.Now()
+
+	sda := &specs.MachineStatusSpec_HardwareStatus_BlockDevice{LinuxName: "/dev/sda", Serial: "S1", Type: "SSD"}
+	sdb := &specs.MachineStatusSpec_HardwareStatus_BlockDevice{LinuxName: "/dev/sdb", Serial: "S2", Type: "HDD"}
+
+	machinehealth.Update(health, machineStatus([]*specs.MachineStatusSpec_HardwareStatus_BlockDevice{sda, sdb}), now)
+
+	assert.Empty(t, health.Diagnostics)
+	assert.Len(t, health.Disks, 2)
+
+	// the disks are not polled, nothing changes
+	machinehealth.Update(health, machineStatus(nil), now.Add(time.Minute))
+
+	assert.Empty(t, health.Diagnostics)
+
+	machinehealth.Update(health, machineStatus([]*specs.MachineStatusSpec_HardwareStatus_BlockDevice{sda}), now.Add(2*time.Minute))
+
+	require.Len(t, health.Diagnostics, 1)
+	assert.Equal(t, machinehealth.TypeDiskMissing, health.Diagnostics[0].Type)
+	assert.Equal(t, "serial:S2", health.Diagnostics[0].Subject)
+	assert.Equal(t, specs.MachineHealthStatusSpec_ERROR, health.Severity)
+
+	firstSeen := health.Diagnostics[0].FirstSeen.AsTime()
+
+	// the missing disk is carried over while the disks are unknown
+	machinehealth.Update(health, machineStatus(nil), now.Add(3*time.Minute))
+
+	require.Len(t, health.Diagnostics, 1)
+	assert.Equal(t, firstSeen, health.Diagnostics[0].FirstSeen.AsTime())
+
+	// the disk is back with a different name
+	sdb.LinuxName = "/dev/sdc"
+
+	machinehealth.Update(health, machineStatus([]*specs.MachineStatusSpec_HardwareStatus_BlockDevice{sda, sdb}), now.Add(4*time.Minute))
+
+	assert.Empty(t, health.Diagnostics)
+	assert.Equal(t, specs.MachineHealthStatusSpec_INFO, health.Severity)
+
+	require.Len(t, health.History, 1)
+	assert.Equal(t, machinehealth.TypeDiskMissing, health.History[0].Type)
+	assert.Equal(t, now.Add(4*time.Minute).UTC(), health.History[0].Resolved.AsTime())
+
+	// the disk is forgotten after the retention period
+	machinehealth.Update(health, machineStatus([]*specs.MachineStatusSpec_HardwareStatus_BlockDevice{sda}), now.Add(5*time.Minute))
+	require.Len(t, health.Diagnostics, 1)
+
+	machinehealth.Update(health, machineStatus([]*specs.MachineStatusSpec_HardwareStatus_BlockDevice{sda}), now.Add(machinehealth.MissingDiskRetention+5*time.Minute))
+
+	assert.Empty(t, health.Diagnostics)
+	assert.Len(t, health.Disks, 1)
+	assert.Len(t, health.History, 2)
+}
+
+func TestDiskReadOnly(t *testing.T) {
+	t.Parallel()
+
+	health := &specs.MachineHealthStatusSpec{}
+
+	machinehealth.Update(health, machineStatus([]*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+		{LinuxName: "/dev/sr0", Type: "CD", Readonly: true},
+		{LinuxName: "/dev/nvme0n1", Wwid: "W1", Type: "NVME", Readonly: true},
+	}), time.Now())
+
+	require.Len(t, health.Diagnostics, 1)
+	assert.Equal(t, machinehealth.TypeDiskReadOnly, health.Diagnostics[0].Type)
+	assert.Equal(t, "wwid:W1", health.Diagnostics[0].Subject)
+	assert.Equal(t, specs.MachineHealthStatusSpec_WARNING, health.Severity)
+}
+
+func TestLinkFlapping(t *testing.T) {
+	t.Parallel()
+
+	health := &specs.MachineHealthStatusSpec{}
+	now := time.Now()
+
+	link := func(up bool) *specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus {
+		return &specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{LinuxName: "eth0", LinkUp: up}
+	}
+
+	assert.Zero(t, machinehealth.Update(health, machineStatus(nil, link(true)), now))
+
+	var requeueAfter time.Duration
+
+	for i := range machinehealth.FlapThreshold {
+		requeueAfter = machinehealth.Update(health, machineStatus(nil, link(i%2 == 1)), now.Add(time.Duration(i+1)*time.Minute))
+	}
+
+	require.Len(t, health.Diagnostics, 1)
+	assert.Equal(t, machinehealth.TypeLinkFlapping, health.Diagnostics[0].Type)
+	assert.Equal(t, "eth0", health.Diagnostics[0].Subject)
+
+	// the first transition leaves the window in an hour after it happened
+	assert.Equal(t, machinehealth.FlapWindow-time.Duration(machinehealth.FlapThreshold-1)*time.Minute, requeueAfter)
+
+	machinehealth.Update(health, machineStatus(nil, link(true)), now.Add(time.Minute+machinehealth.FlapWindow))
+
+	assert.Empty(t, health.Diagnostics)
+	require.Len(t, health.History, 1)
+	assert.Equal(t, machinehealth.TypeLinkFlapping, health.History[0].Type)
+}
+
+func TestLinkFlappingBetweenPolls(t *testing.T) {
+	t.Parallel()
+
+	health := &specs.MachineHealthStatusSpec{}
+	now := time.Now()
+
+	link := func(stateChanges uint32) *specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus {
+		return &specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{LinuxName: "eth0", LinkUp: true, StateChanges: stateChanges}
+	}
+
+	machinehealth.Update(health, machineStatus(nil, link(0)), now)
+
+	// the link went down and up twice between the polls, its state is the same
+	machinehealth.Update(health, machineStatus(nil, link(2)), now.Add(time.Minute))
+
+	assert.Empty(t, health.Diagnostics)
+
+	machinehealth.Update(health, machineStatus(nil, link(4)), now.Add(2*time.Minute))
+
+	require.Len(t, health.Diagnostics, 1)
+	assert.Equal(t, machinehealth.TypeLinkFlapping, health.Diagnostics[0].Type)
+
+	// Omni reconnected to the machine, the counter restarted
+	machinehealth.Update(health, machineStatus(nil, link(1)), now.Add(3*time.Minute))
+
+	require.Len(t, health.Links, 1)
+	assert.Len(t, health.Links[0].Transitions, 5)
+	assert.EqualValues(t, 1, health.Links[0].StateChanges)
+}
+
+func TestTalosDiagnosticsAndHistorySize(t *testing.T) {
+	t.Parallel()
+
+	health := &specs.MachineHealthStatusSpec{}
+	now := time.Now()
+
+	for i := range 2*machinehealth.HistorySize + 10 {
+		status := machineStatus(nil)
+
+		if i%2 == 0 {
+			status.Diagnostics = []*specs.MachineStatusSpec_Diagnostic{{Id: "address-overlap", Message: "host and Kubernetes pod/service CIDR addresses overlap"}}
+		}
+
+		machinehealth.Update(health, status, now.Add(time.Duration(i)*time.Minute))
+	}
+
+	assert.Empty(t, health.Diagnostics)
+	assert.Len(t, health.History, machinehealth.HistorySize)
+
+	for _, diagnostic := range health.History {
+		assert.Equal(t, machinehealth.TypeTalos, diagnostic.Type)
+		assert.Equal(t, "address-overlap", diagnostic.Subject)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/configpatch"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/set"
 )
 
@@ -47,7 +48,7 @@ func (c *Create) Apply(ctx context.Context, r controller.ReaderWriter, logger *z
 	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
 	clusterMachineConfigPatches.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
 
-	helpers.UpdateInputsVersions(clusterMachine, configPatches...)
+	configpatch.UpdateInputsVersions(clusterMachine, configPatches...)
 
 	if err := setPatches(clusterMachineConfigPatches, configPatches); err != nil {
 		return err
@@ -113,7 +114,7 @@ func (u *Update) Apply(ctx context.Context, r controller.ReaderWriter, logger *z
 	configPatches := rc.GetConfigPatches(u.ID)
 
 	// nothing changed in the patch list, skip any updates
-	if !helpers.UpdateInputsVersions(clusterMachine, configPatches...) {
+	if !configpatch.UpdateInputsVersions(clusterMachine, configPatches...) {
 		return nil
 	}
 
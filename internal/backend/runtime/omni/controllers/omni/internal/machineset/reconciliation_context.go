@@ -292,7 +292,7 @@ func NewReconciliationContext(
 
 		patches := rc.patchesByMachine[id]
 
-		if helpers.UpdateInputsVersions(clusterMachine, patches...) {
+		if configpatch.UpdateInputsVersions(clusterMachine, patches...) {
 			updateMachine(id)
 		}
 	}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machine
+
+import (
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// linkTracker counts the state changes of the physical network links.
+//
+// The links are polled at most once per poll interval, so a link going down and up between the polls is only seen in the watch events.
+type linkTracker struct {
+	links map[string]*trackedLink
+}
+
+type trackedLink struct {
+	changes uint32
+	up      bool
+}
+
+// observe records the link state from the watch event.
+func (tracker *linkTracker) observe(r *network.LinkStatus) {
+	if !r.TypedSpec().Physical() {
+		return
+	}
+
+	if tracker.links == nil {
+		tracker.links = map[string]*trackedLink{}
+	}
+
+	link, ok := tracker.links[r.Metadata().ID()]
+	if !ok {
+		tracker.links[r.Metadata().ID()] = &trackedLink{up: r.TypedSpec().LinkState}
+
+		return
+	}
+
+	if link.up != r.TypedSpec().LinkState {
+		link.up = r.TypedSpec().LinkState
+		link.changes++
+	}
+}
+
+// annotate sets the state changes counters of the polled links.
+func (tracker *linkTracker) annotate(links []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus) {
+	for _, link := range links {
+		if tracked, ok := tracker.links[link.LinuxName]; ok {
+			link.StateChanges = tracked.changes
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machine
+
+import (
+	"testing"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+func TestLinkTracker(t *testing.T) {
+	t.Parallel()
+
+	var tracker linkTracker
+
+	linkStatus := func(id string, up bool) *network.LinkStatus {
+		r := network.NewLinkStatus(network.NamespaceName, id)
+
+		r.TypedSpec().Type = nethelpers.LinkEther
+		r.TypedSpec().LinkState = up
+
+		return r
+	}
+
+	// the link goes down and up between the polls
+	tracker.observe(linkStatus("eth0", true))
+	tracker.observe(linkStatus("eth0", false))
+	tracker.observe(linkStatus("eth0", true))
+	tracker.observe(linkStatus("eth0", true))
+	tracker.observe(linkStatus("eth1", false))
+
+	links := []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+		{LinuxName: "eth0", LinkUp: true},
+		{LinuxName: "eth1"},
+		{LinuxName: "eth2"},
+	}
+
+	tracker.annotate(links)
+
+	assert.EqualValues(t, 2, links[0].StateChanges)
+	assert.Zero(t, links[1].StateChanges)
+	assert.Zero(t, links[2].StateChanges)
+}
@@ -67,6 +67,9 @@ type Info struct { //nolint:govet
 	SecureBootStatus  *specs.SecureBootStatus
 
 	Diagnostics []*specs.MachineStatusSpec_Diagnostic
+
+	// ResourceUsage is sent alone, without the other machine information.
+	ResourceUsage *specs.MachineResourceUsageSpec
 }
 
 // InfoChan is a channel for sending machine info from tasks back to the controller.
@@ -81,6 +84,7 @@ type CollectTaskSpec struct {
 	Endpoint                   string
 	MachineID                  string
 	DefaultSchematicKernelArgs []string
+	ResourceUsageInterval      time.Duration
 	MaintenanceMode            bool
 }
 
@@ -103,7 +107,7 @@ func resourceEqual[T any, S interface {
 //
 // If the task spec changes, the task will be restarted.
 func (spec CollectTaskSpec) Equal(other CollectTaskSpec) bool {
-	if spec.Endpoint != other.Endpoint || spec.MaintenanceMode != other.MaintenanceMode {
+	if spec.Endpoint != other.Endpoint || spec.MaintenanceMode != other.MaintenanceMode || spec.ResourceUsageInterval != other.ResourceUsageInterval {
 		return false
 	}
 
@@ -197,6 +201,19 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 	pollTicker := time.NewTicker(minPolInterval)
 	defer pollTicker.Stop()
 
+	var (
+		usageTickerCh <-chan time.Time
+		usage         usageCollector
+	)
+
+	// resource usage is not available in the maintenance mode
+	if spec.ResourceUsageInterval > 0 && !spec.MaintenanceMode {
+		usageTicker := time.NewTicker(spec.ResourceUsageInterval)
+		defer usageTicker.Stop()
+
+		usageTickerCh = usageTicker.C
+	}
+
 	watchCh := make(chan state.Event)
 
 	registeredTypes, err := QueryRegisteredTypes(ctx, c.COSI)
@@ -266,6 +283,9 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 		}
 	}
 
+	// the link state changes are counted from the watch events, as the link might go down and up between the polls
+	var links linkTracker
+
 	dirtyPollers := map[string]struct{}{}
 
 	// mark everything as dirty on start
@@ -285,6 +305,8 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 		if len(dirtyPollers) > 0 {
 			info, err := spec.poll(ctx, c, maps.Keys(dirtyPollers))
 
+			links.annotate(info.NetworkLinks)
+
 			if !spec.sendInfo(ctx, info, notifyCh, err) {
 				return nil
 			}
@@ -306,6 +328,17 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 				dirtyPollers["disks"] = struct{}{}
 			case <-pollTicker.C:
 				break waitLoop
+			case <-usageTickerCh:
+				resourceUsage, err := usage.collect(ctx, c)
+				if err != nil {
+					logger.Warn("failed to collect machine resource usage", zap.Error(err))
+
+					continue
+				}
+
+				if !channel.SendWithContext(ctx, notifyCh, Info{MachineID: spec.MachineID, ResourceUsage: resourceUsage}) {
+					return nil
+				}
 			case event := <-watchCh:
 				switch event.Type {
 				case state.Errored:
@@ -320,6 +353,10 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 						break waitLoop
 					}
 
+					if linkStatus, ok := event.Resource.(*network.LinkStatus); ok && event.Type != state.Destroyed {
+						links.observe(linkStatus)
+					}
+
 					markAsDirty := true
 
 					if watchers[event.Resource.Metadata().Type()].filterFunc != nil && !resource.IsTombstone(event.Resource) { // can't run filter for tombstones
@@ -0,0 +1,212 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machine
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// usageCollector collects the resource usage of the machine.
+//
+// The CPU usage and the network throughput are computed from the counter deltas between the subsequent collections,
+// so they are reported starting from the second collection.
+type usageCollector struct {
+	collectedAt time.Time
+	cpu         *machineapi.CPUStat
+	netDevices  map[string]*machineapi.NetDev
+}
+
+func (collector *usageCollector) collect(ctx context.Context, c *client.Client) (*specs.MachineResourceUsageSpec, error) {
+	loadAvg, err := c.MachineClient.LoadAvg(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	systemStat, err := c.MachineClient.SystemStat(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	memory, err := c.Memory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts, err := c.Mounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	netStats, err := c.MachineClient.NetworkDeviceStats(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	physicalLinks := map[string]struct{}{}
+
+	if err = forEachResource(ctx, c, network.NamespaceName, network.LinkStatusType, func(r *network.LinkStatus) error {
+		if r.TypedSpec().Physical() {
+			physicalLinks[r.Metadata().ID()] = struct{}{}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	usage := &specs.MachineResourceUsageSpec{
+		CollectedAt: timestamppb.New(now),
+	}
+
+	for _, msg := range loadAvg.GetMessages() {
+		usage.Load1 = msg.GetLoad1()
+		usage.Load5 = msg.GetLoad5()
+		usage.Load15 = msg.GetLoad15()
+	}
+
+	for _, msg := range memory.GetMessages() {
+		// meminfo is reported in kilobytes
+		usage.MemoryTotalBytes = msg.GetMeminfo().GetMemtotal() * 1024
+		usage.MemoryAvailableBytes = msg.GetMeminfo().GetMemavailable() * 1024
+	}
+
+	for _, msg := range mounts.GetMessages() {
+		usage.Mounts = filterMounts(msg.GetStats())
+	}
+
+	var (
+		cpu        *machineapi.CPUStat
+		netDevices []*machineapi.NetDev
+	)
+
+	for _, msg := range systemStat.GetMessages() {
+		cpu = msg.GetCpuTotal()
+	}
+
+	for _, msg := range netStats.GetMessages() {
+		netDevices = slices.DeleteFunc(msg.GetDevices(), func(dev *machineapi.NetDev) bool {
+			_, physical := physicalLinks[dev.GetName()]
+
+			return !physical
+		})
+	}
+
+	collector.update(usage, now, cpu, netDevices)
+
+	return usage, nil
+}
+
+// update fills in the usage computed from the deltas to the previous collection and stores the current counters.
+func (collector *usageCollector) update(usage *specs.MachineResourceUsageSpec, now time.Time, cpu *machineapi.CPUStat, netDevices []*machineapi.NetDev) {
+	if !collector.collectedAt.IsZero() {
+		usage.CpuUsagePercent = cpuUsagePercent(collector.cpu, cpu)
+		usage.NetworkInterfaces = networkThroughput(collector.netDevices, netDevices, now.Sub(collector.collectedAt))
+	}
+
+	collector.collectedAt = now
+	collector.cpu = cpu
+	collector.netDevices = make(map[string]*machineapi.NetDev, len(netDevices))
+
+	for _, dev := range netDevices {
+		collector.netDevices[dev.GetName()] = dev
+	}
+}
+
+// filterMounts returns the mounts of the block devices, skipping the virtual filesystems and the duplicate mount points.
+func filterMounts(stats []*machineapi.MountStat) []*specs.MachineResourceUsageSpec_Mount {
+	var mounts []*specs.MachineResourceUsageSpec_Mount
+
+	seen := map[string]struct{}{}
+
+	for _, stat := range stats {
+		if !strings.HasPrefix(stat.GetFilesystem(), "/dev/") || stat.GetSize() == 0 {
+			continue
+		}
+
+		if _, ok := seen[stat.GetMountedOn()]; ok {
+			continue
+		}
+
+		seen[stat.GetMountedOn()] = struct{}{}
+
+		mounts = append(mounts, &specs.MachineResourceUsageSpec_Mount{
+			Filesystem:     stat.GetFilesystem(),
+			MountedOn:      stat.GetMountedOn(),
+			SizeBytes:      stat.GetSize(),
+			AvailableBytes: stat.GetAvailable(),
+		})
+	}
+
+	return mounts
+}
+
+func cpuTimes(stat *machineapi.CPUStat) (busy, total float64) {
+	// guest time is already accounted in the user time
+	total = stat.GetUser() + stat.GetNice() + stat.GetSystem() + stat.GetIdle() + stat.GetIowait() + stat.GetIrq() + stat.GetSoftIrq() + stat.GetSteal()
+	busy = total - stat.GetIdle() - stat.GetIowait()
+
+	return busy, total
+}
+
+func cpuUsagePercent(previous, current *machineapi.CPUStat) float64 {
+	if previous == nil || current == nil {
+		return 0
+	}
+
+	previousBusy, previousTotal := cpuTimes(previous)
+	currentBusy, currentTotal := cpuTimes(current)
+
+	if currentTotal <= previousTotal {
+		return 0
+	}
+
+	return max(0, min(100, (currentBusy-previousBusy)/(currentTotal-previousTotal)*100))
+}
+
+func networkThroughput(previous map[string]*machineapi.NetDev, current []*machineapi.NetDev, elapsed time.Duration) []*specs.MachineResourceUsageSpec_NetworkInterface {
+	if elapsed <= 0 {
+		return nil
+	}
+
+	var interfaces []*specs.MachineResourceUsageSpec_NetworkInterface
+
+	for _, dev := range current {
+		prev, ok := previous[dev.GetName()]
+		if !ok {
+			continue
+		}
+
+		interfaces = append(interfaces, &specs.MachineResourceUsageSpec_NetworkInterface{
+			Name:             dev.GetName(),
+			RxBytesPerSecond: counterRate(prev.GetRxBytes(), dev.GetRxBytes(), elapsed),
+			TxBytesPerSecond: counterRate(prev.GetTxBytes(), dev.GetTxBytes(), elapsed),
+		})
+	}
+
+	return interfaces
+}
+
+// counterRate returns the per second rate of the counter, the counter reset (e.g. on the link re-creation) results in zero rate.
+func counterRate(previous, current uint64, elapsed time.Duration) float64 {
+	if current < previous {
+		return 0
+	}
+
+	return float64(current-previous) / elapsed.Seconds()
+}
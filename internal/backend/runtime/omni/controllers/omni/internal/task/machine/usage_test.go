@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machine
+
+import (
+	"testing"
+	"time"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+func TestUsageCollectorUpdate(t *testing.T) {
+	t.Parallel()
+
+	var collector usageCollector
+
+	start := time.Now()
+
+	first := &specs.MachineResourceUsageSpec{}
+
+	collector.update(first, start,
+		&machineapi.CPUStat{User: 10, System: 10, Idle: 80},
+		[]*machineapi.NetDev{{Name: "eth0", RxBytes: 1000, TxBytes: 2000}},
+	)
+
+	assert.Zero(t, first.CpuUsagePercent)
+	assert.Empty(t, first.NetworkInterfaces)
+
+	second := &specs.MachineResourceUsageSpec{}
+
+	collector.update(second, start.Add(10*time.Second),
+		&machineapi.CPUStat{User: 40, System: 20, Idle: 130, Iowait: 10},
+		[]*machineapi.NetDev{
+			{Name: "eth0", RxBytes: 11000, TxBytes: 1000},
+			{Name: "eth1", RxBytes: 500, TxBytes: 500},
+		},
+	)
+
+	// 40 busy out of 100 total
+	assert.InDelta(t, 40, second.CpuUsagePercent, 0.001)
+
+	require.Len(t, second.NetworkInterfaces, 1)
+	assert.Equal(t, "eth0", second.NetworkInterfaces[0].Name)
+	assert.InDelta(t, 1000, second.NetworkInterfaces[0].RxBytesPerSecond, 0.001)
+	assert.Zero(t, second.NetworkInterfaces[0].TxBytesPerSecond) // counter reset
+}
+
+func TestFilterMounts(t *testing.T) {
+	t.Parallel()
+
+	mounts := filterMounts([]*machineapi.MountStat{
+		{Filesystem: "/dev/sda4", MountedOn: "/system/state", Size: 100, Available: 90},
+		{Filesystem: "/dev/sda6", MountedOn: "/var", Size: 1000, Available: 500},
+		{Filesystem: "/dev/sda6", MountedOn: "/var", Size: 1000, Available: 500},
+		{Filesystem: "overlay", MountedOn: "/etc/cni", Size: 1000, Available: 500},
+		{Filesystem: "tmpfs", MountedOn: "/run", Size: 1000, Available: 500},
+	})
+
+	require.Len(t, mounts, 2)
+	assert.Equal(t, "/system/state", mounts[0].MountedOn)
+	assert.Equal(t, "/var", mounts[1].MountedOn)
+	assert.Equal(t, uint64(500), mounts[1].AvailableBytes)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/inventory"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// InventorySyncController pushes the changes of the machine hardware inventory to an external HTTP endpoint.
+type InventorySyncController struct {
+	client   *http.Client
+	headers  map[string]string
+	endpoint string
+	previous []inventory.Machine
+	interval time.Duration
+	synced   bool
+}
+
+// NewInventorySyncController initializes InventorySyncController.
+func NewInventorySyncController(endpoint string, interval time.Duration, headers map[string]string) *InventorySyncController {
+	return &InventorySyncController{
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		endpoint: endpoint,
+		interval: interval,
+		headers:  headers,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *InventorySyncController) Name() string {
+	return "InventorySyncController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *InventorySyncController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.MachineStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *InventorySyncController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+// The machine status changes are batched, the inventory is pushed at most once per interval.
+func (ctrl *InventorySyncController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(ctrl.interval)
+	defer ticker.Stop()
+
+	dirty := true
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			dirty = true
+
+			continue
+		case <-ticker.C:
+		}
+
+		if !dirty {
+			continue
+		}
+
+		if err := ctrl.sync(ctx, r, logger); err != nil {
+			return err
+		}
+
+		dirty = false
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *InventorySyncController) sync(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	list, err := safe.ReaderListAll[*omni.MachineStatus](ctx, r)
+	if err != nil {
+		return err
+	}
+
+	current := make([]inventory.Machine, 0, list.Len())
+
+	for machineStatus := range list.All() {
+		current = append(current, inventory.FromMachineStatus(machineStatus))
+	}
+
+	inventory.Sort(current)
+
+	report := inventory.Report{
+		Timestamp: time.Now(),
+		Full:      !ctrl.synced,
+	}
+
+	if report.Full {
+		report.Machines = current
+	} else {
+		report.Changes = inventory.Diff(ctrl.previous, current)
+
+		if len(report.Changes) == 0 {
+			return nil
+		}
+	}
+
+	if err = ctrl.push(ctx, report); err != nil {
+		return fmt.Errorf("failed to push the inventory to %q: %w", ctrl.endpoint, err)
+	}
+
+	logger.Info("pushed the inventory", zap.Bool("full", report.Full), zap.Int("machines", len(report.Machines)), zap.Int("changes", len(report.Changes)))
+
+	ctrl.previous = current
+	ctrl.synced = true
+
+	return nil
+}
+
+func (ctrl *InventorySyncController) push(ctx context.Context, report inventory.Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ctrl.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for key, value := range ctrl.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := ctrl.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024)) //nolint:errcheck
+
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(message))
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/inventory"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type InventorySyncSuite struct {
+	OmniSuite
+}
+
+func (suite *InventorySyncSuite) TestPush() {
+	var (
+		reportsMu sync.Mutex
+		reports   []inventory.Report
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		var report inventory.Report
+
+		if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		reportsMu.Lock()
+		reports = append(reports, report)
+		reportsMu.Unlock()
+	}))
+
+	suite.T().Cleanup(server.Close)
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(
+		omnictrl.NewInventorySyncController(server.URL, 100*time.Millisecond, map[string]string{"Authorization": "Bearer token"}),
+	))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine-1")
+	machineStatus.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{
+		Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+			{LinuxName: "/dev/sda", Serial: "S1"},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	getReports := func() []inventory.Report {
+		reportsMu.Lock()
+		defer reportsMu.Unlock()
+
+		return append([]inventory.Report(nil), reports...)
+	}
+
+	suite.Require().EventuallyWithT(func(collect *assert.CollectT) {
+		if reports := getReports(); assert.NotEmpty(collect, reports) {
+			last := reports[len(reports)-1]
+
+			assert.True(collect, last.Full)
+			assert.Len(collect, last.Machines, 1)
+		}
+	}, 10*time.Second, 50*time.Millisecond)
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.Cluster = "cluster-1"
+		res.TypedSpec().Value.Hardware.Blockdevices = append(res.TypedSpec().Value.Hardware.Blockdevices, &specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+			LinuxName: "/dev/sdb",
+			Serial:    "S2",
+		})
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().EventuallyWithT(func(collect *assert.CollectT) {
+		reports := getReports()
+		last := reports[len(reports)-1]
+
+		if !assert.False(collect, last.Full) {
+			return
+		}
+
+		if assert.Len(collect, last.Changes, 2) {
+			assert.Equal(collect, inventory.ChangeMachineMoved, last.Changes[0].Type)
+			assert.Equal(collect, "cluster-1", last.Changes[0].ToCluster)
+			assert.Equal(collect, inventory.ChangeDiskAdded, last.Changes[1].Type)
+			assert.Equal(collect, "S2", last.Changes[1].Disk.Serial)
+		}
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestInventorySyncSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(InventorySyncSuite))
+}
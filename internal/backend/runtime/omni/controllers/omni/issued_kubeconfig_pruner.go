@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+)
+
+// IssuedKubeconfigPrunerController is a controller which periodically removes the records of the expired service account kubeconfigs.
+//
+// The revoked kubeconfigs are kept until they expire, as the token is valid up to the expiration otherwise.
+type IssuedKubeconfigPrunerController struct {
+	clock clock.Clock
+
+	interval time.Duration
+}
+
+// NewIssuedKubeconfigPrunerController initializes a new IssuedKubeconfigPrunerController.
+func NewIssuedKubeconfigPrunerController(interval time.Duration, clk clock.Clock) *IssuedKubeconfigPrunerController {
+	if clk == nil {
+		clk = clock.New()
+	}
+
+	return &IssuedKubeconfigPrunerController{
+		clock:    clk,
+		interval: interval,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (*IssuedKubeconfigPrunerController) Name() string {
+	return "IssuedKubeconfigPrunerController"
+}
+
+// Inputs implements controller.Controller interface.
+func (*IssuedKubeconfigPrunerController) Inputs() []controller.Input {
+	// no inputs
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (*IssuedKubeconfigPrunerController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: auth.IssuedKubeconfigType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *IssuedKubeconfigPrunerController) Run(ctx context.Context, runtime controller.Runtime, logger *zap.Logger) error {
+	ticker := ctrl.clock.Ticker(ctrl.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-runtime.EventCh():
+		case <-ticker.C:
+		}
+
+		if err := ctrl.run(ctx, runtime, logger); err != nil {
+			return fmt.Errorf("error running issued kubeconfig pruner: %w", err)
+		}
+	}
+}
+
+func (ctrl *IssuedKubeconfigPrunerController) run(ctx context.Context, runtime controller.Runtime, logger *zap.Logger) error {
+	list, err := safe.ReaderListAll[*auth.IssuedKubeconfig](ctx, runtime)
+	if err != nil {
+		return err
+	}
+
+	for issuedKubeconfig := range list.All() {
+		expiresAt := issuedKubeconfig.TypedSpec().Value.GetExpiresAt().AsTime()
+
+		if ctrl.clock.Now().Before(expiresAt) {
+			continue
+		}
+
+		logger.Info("removing expired kubeconfig", zap.String("id", issuedKubeconfig.Metadata().ID()), zap.Time("expiration", expiresAt))
+
+		if err = runtime.Destroy(ctx, issuedKubeconfig.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error destroying issued kubeconfig %q: %w", issuedKubeconfig.Metadata().ID(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+func TestIssuedKubeconfigPrunerSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(IssuedKubeconfigPrunerSuite))
+}
+
+type IssuedKubeconfigPrunerSuite struct {
+	OmniSuite
+}
+
+func (suite *IssuedKubeconfigPrunerSuite) TestRemoveExpired() {
+	fakeClock := clock.NewMock()
+	fakeClock.Set(time.Now())
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewIssuedKubeconfigPrunerController(time.Second, fakeClock)))
+
+	owner := pointer.To(omnictrl.IssuedKubeconfigPrunerController{}).Name()
+
+	expiring := authres.NewIssuedKubeconfig(resources.DefaultNamespace, "expiring")
+	expiring.TypedSpec().Value.Cluster = "cluster"
+	expiring.TypedSpec().Value.ExpiresAt = timestamppb.New(fakeClock.Now().Add(5 * time.Second))
+
+	// the revoked kubeconfig is kept until it expires
+	revoked := authres.NewIssuedKubeconfig(resources.DefaultNamespace, "revoked")
+	revoked.TypedSpec().Value.Cluster = "cluster"
+	revoked.TypedSpec().Value.Revoked = true
+	revoked.TypedSpec().Value.ExpiresAt = timestamppb.New(fakeClock.Now().Add(time.Hour))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, expiring, state.WithCreateOwner(owner)))
+	suite.Require().NoError(suite.state.Create(suite.ctx, revoked, state.WithCreateOwner(owner)))
+
+	fakeClock.Add(3 * time.Second)
+
+	assertResource(&suite.OmniSuite, expiring.Metadata(), func(*authres.IssuedKubeconfig, *assert.Assertions) {})
+
+	fakeClock.Add(3 * time.Second)
+
+	assertNoResource(&suite.OmniSuite, expiring)
+	assertResource(&suite.OmniSuite, revoked.Metadata(), func(*authres.IssuedKubeconfig, *assert.Assertions) {})
+}
@@ -15,6 +15,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
@@ -37,11 +38,13 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
+	cosilabels "github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/panichandler"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/image"
 )
@@ -61,8 +64,30 @@ const (
 	//
 	// tsgen:ServiceIconAnnotationKey
 	ServiceIconAnnotationKey = "omni-kube-service-exposer.sidero.dev/icon"
+
+	// ServiceAllowedUsersAnnotationKey is the annotation to define the comma-separated list of identities (or glob patterns) allowed to access the exposed service.
+	ServiceAllowedUsersAnnotationKey = "omni-kube-service-exposer.sidero.dev/allowed-users"
+
+	// ServiceAllowedIdentityLabelsAnnotationKey is the annotation to define the label selector matched against the Identity of the user accessing the exposed service.
+	ServiceAllowedIdentityLabelsAnnotationKey = "omni-kube-service-exposer.sidero.dev/allowed-identity-labels"
+
+	// ServiceMinRoleAnnotationKey is the annotation to define the minimum role on the cluster required to access the exposed service.
+	ServiceMinRoleAnnotationKey = "omni-kube-service-exposer.sidero.dev/min-role"
+
+	// ServiceProtocolAnnotationKey is the annotation to define the protocol of the exposed service: http (default), grpc or tcp.
+	ServiceProtocolAnnotationKey = "omni-kube-service-exposer.sidero.dev/protocol"
 )
 
+var exposedServiceAnnotationKeys = []string{
+	ServiceLabelAnnotationKey,
+	ServicePortAnnotationKey,
+	ServiceIconAnnotationKey,
+	ServiceAllowedUsersAnnotationKey,
+	ServiceAllowedIdentityLabelsAnnotationKey,
+	ServiceMinRoleAnnotationKey,
+	ServiceProtocolAnnotationKey,
+}
+
 // KubernetesStatusController manages KubernetesStatus resource lifecycle.
 //
 // KubernetesStatusController plays the role of machine discovery.
@@ -234,6 +259,24 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 			svcLogger.Debug("invalid icon on Service", zap.Error(err))
 		}
 
+		allowedUsers, allowedIdentityLabelSelectors, minRole, err := ctrl.parseAccessRules(service.Annotations)
+		if err != nil {
+			svcLogger.Warn("invalid access rules on Service", zap.Error(err))
+
+			tracker.keep(exposedService)
+
+			continue
+		}
+
+		protocol, err := ctrl.parseProtocol(service.Annotations[ServiceProtocolAnnotationKey])
+		if err != nil {
+			svcLogger.Warn("invalid protocol on Service", zap.Error(err))
+
+			tracker.keep(exposedService)
+
+			continue
+		}
+
 		var alias string
 
 		if err = safe.WriterModify(ctx, r, exposedService, func(res *omni.ExposedService) error {
@@ -254,6 +297,17 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 			res.TypedSpec().Value.Port = uint32(port)
 			res.TypedSpec().Value.Label = label
 			res.TypedSpec().Value.IconBase64 = icon
+			res.TypedSpec().Value.AllowedUsers = allowedUsers
+			res.TypedSpec().Value.AllowedIdentityLabelSelectors = allowedIdentityLabelSelectors
+			res.TypedSpec().Value.MinRole = minRole
+			res.TypedSpec().Value.Protocol = protocol
+
+			// raw TCP services are not reachable through the workload proxy subdomain, but only via the port-forward tunnel
+			if protocol == specs.ExposedServiceSpec_TCP {
+				res.TypedSpec().Value.Url = ""
+
+				return nil
+			}
 
 			res.TypedSpec().Value.Url, err = ctrl.buildExposedServiceURL(alias)
 			if err != nil {
@@ -296,6 +350,54 @@ func (ctrl *KubernetesStatusController) buildExposedServiceURL(alias string) (st
 	return url, nil
 }
 
+// parseAccessRules parses the access rules of the exposed service from the Service annotations.
+func (ctrl *KubernetesStatusController) parseAccessRules(annotations map[string]string) (allowedUsers, allowedIdentityLabelSelectors []string, minRole string, err error) {
+	for _, user := range strings.Split(annotations[ServiceAllowedUsersAnnotationKey], ",") {
+		user = strings.TrimSpace(user)
+		if user == "" {
+			continue
+		}
+
+		if _, err = filepath.Match(user, ""); err != nil {
+			return nil, nil, "", fmt.Errorf("invalid allowed user pattern %q: %w", user, err)
+		}
+
+		allowedUsers = append(allowedUsers, user)
+	}
+
+	if selector := strings.TrimSpace(annotations[ServiceAllowedIdentityLabelsAnnotationKey]); selector != "" {
+		if _, err = cosilabels.ParseQuery(selector); err != nil {
+			return nil, nil, "", fmt.Errorf("invalid allowed identity label selector %q: %w", selector, err)
+		}
+
+		allowedIdentityLabelSelectors = append(allowedIdentityLabelSelectors, selector)
+	}
+
+	if minRoleStr := strings.TrimSpace(annotations[ServiceMinRoleAnnotationKey]); minRoleStr != "" {
+		parsedRole, parseErr := role.Parse(minRoleStr)
+		if parseErr != nil {
+			return nil, nil, "", fmt.Errorf("invalid min role %q: %w", minRoleStr, parseErr)
+		}
+
+		minRole = string(parsedRole)
+	}
+
+	return allowedUsers, allowedIdentityLabelSelectors, minRole, nil
+}
+
+func (ctrl *KubernetesStatusController) parseProtocol(protocol string) (specs.ExposedServiceSpec_Protocol, error) {
+	if protocol == "" {
+		return specs.ExposedServiceSpec_HTTP, nil
+	}
+
+	value, ok := specs.ExposedServiceSpec_Protocol_value[strings.ToUpper(protocol)]
+	if !ok {
+		return specs.ExposedServiceSpec_HTTP, fmt.Errorf("unknown protocol %q", protocol)
+	}
+
+	return specs.ExposedServiceSpec_Protocol(value), nil
+}
+
 func (ctrl *KubernetesStatusController) parseIcon(iconBase64 string) (string, error) {
 	if iconBase64 == "" {
 		return "", nil
@@ -815,7 +917,7 @@ func IsExposedServiceEvent(k8sObject, oldK8sObject any, logger *zap.Logger) bool
 	oldAnnotations := oldK8sObject.(*corev1.Service).GetObjectMeta().GetAnnotations() //nolint:forcetypeassert,errcheck
 	newAnnotations := k8sObject.(*corev1.Service).GetObjectMeta().GetAnnotations()    //nolint:forcetypeassert,errcheck
 
-	for _, key := range []string{ServiceLabelAnnotationKey, ServicePortAnnotationKey, ServiceIconAnnotationKey} {
+	for _, key := range exposedServiceAnnotationKeys {
 		if oldAnnotations[key] != newAnnotations[key] {
 			return true
 		}
@@ -9,10 +9,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 )
 
@@ -101,6 +103,25 @@ func TestIsExposedServiceEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "update service - change in exposed service access rules",
+			expectChanged: true,
+			obj: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						omni.ServicePortAnnotationKey:    "8080",
+						omni.ServiceMinRoleAnnotationKey: "Operator",
+					},
+				},
+			},
+			oldObj: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						omni.ServicePortAnnotationKey: "8080",
+					},
+				},
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
@@ -111,3 +132,48 @@ func TestIsExposedServiceEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestParseProtocol(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		protocol      string
+		expected      specs.ExposedServiceSpec_Protocol
+		expectedError string
+	}{
+		{
+			protocol: "",
+			expected: specs.ExposedServiceSpec_HTTP,
+		},
+		{
+			protocol: "http",
+			expected: specs.ExposedServiceSpec_HTTP,
+		},
+		{
+			protocol: "GRPC",
+			expected: specs.ExposedServiceSpec_GRPC,
+		},
+		{
+			protocol: "tcp",
+			expected: specs.ExposedServiceSpec_TCP,
+		},
+		{
+			protocol:      "udp",
+			expected:      specs.ExposedServiceSpec_HTTP,
+			expectedError: `unknown protocol "udp"`,
+		},
+	} {
+		t.Run(tt.protocol, func(t *testing.T) {
+			t.Parallel()
+
+			protocol, err := omni.ParseProtocol(tt.protocol)
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expected, protocol)
+		})
+	}
+}
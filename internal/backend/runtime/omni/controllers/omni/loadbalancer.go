@@ -8,14 +8,19 @@ package omni
 import (
 	"context"
 	"fmt"
+	"net"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/loadbalancer"
@@ -25,7 +30,14 @@ import (
 type LoadBalancerController struct {
 	NewLoadBalancer loadbalancer.NewFunc
 
+	// SNIEndpoint is the address the load balancers are multiplexed on by the TLS server name, disabled if empty.
+	SNIEndpoint string
+
 	loadBalancers *loadbalancer.Manager
+	sniRouter     *loadbalancer.SNIRouter
+
+	// mu protects loadBalancers from the concurrent metrics collection.
+	mu sync.Mutex
 }
 
 // Name returns the name of the controller.
@@ -65,7 +77,9 @@ func (ctrl *LoadBalancerController) Run(ctx context.Context, r controller.Runtim
 		ctrl.NewLoadBalancer = loadbalancer.DefaultNew
 	}
 
+	ctrl.mu.Lock()
 	ctrl.loadBalancers = loadbalancer.NewManager(logger, ctrl.NewLoadBalancer)
+	ctrl.mu.Unlock()
 
 	defer func() {
 		if err := ctrl.loadBalancers.Stop(); err != nil {
@@ -73,6 +87,20 @@ func (ctrl *LoadBalancerController) Run(ctx context.Context, r controller.Runtim
 		}
 	}()
 
+	if ctrl.SNIEndpoint != "" {
+		ctrl.sniRouter = loadbalancer.NewSNIRouter(ctrl.SNIEndpoint, logger)
+
+		if err := ctrl.sniRouter.Start(); err != nil {
+			return fmt.Errorf("error starting load balancer SNI router: %w", err)
+		}
+
+		defer func() {
+			if err := ctrl.sniRouter.Shutdown(); err != nil {
+				logger.Error("error shutting down load balancer SNI router", zap.Error(err))
+			}
+		}()
+	}
+
 	ticker := time.NewTicker(time.Second * 10)
 	defer ticker.Stop()
 
@@ -109,6 +137,7 @@ func (ctrl *LoadBalancerController) reconcileLoadBalancers(ctx context.Context,
 	// figure out which loadBalancers should run
 	shouldRun := make(map[loadbalancer.ID]loadbalancer.Spec, list.Len())
 	endpoints := make(map[loadbalancer.ID][]string, list.Len())
+	sniRoutes := map[string]string{}
 	stopped := map[loadbalancer.ID]struct{}{}
 
 	for item := range list.All() {
@@ -143,12 +172,22 @@ func (ctrl *LoadBalancerController) reconcileLoadBalancers(ctx context.Context,
 			return fmt.Errorf("failed to parse port %q as int: %w", lbSpec.BindPort, err)
 		}
 
+		bindAddress, localAddress := "0.0.0.0", "127.0.0.1"
+
+		if lbSpec.BindAddress != "" {
+			bindAddress, localAddress = lbSpec.BindAddress, lbSpec.BindAddress
+		}
+
 		shouldRun[item.Metadata().ID()] = loadbalancer.Spec{
-			BindAddress: "0.0.0.0",
+			BindAddress: bindAddress,
 			BindPort:    bindPort,
 		}
 
 		endpoints[item.Metadata().ID()] = lbSpec.Endpoints
+
+		if lbSpec.SniHost != "" {
+			sniRoutes[lbSpec.SniHost] = net.JoinHostPort(localAddress, lbSpec.BindPort)
+		}
 	}
 
 	if err = ctrl.loadBalancers.Reconcile(shouldRun); err != nil {
@@ -158,6 +197,10 @@ func (ctrl *LoadBalancerController) reconcileLoadBalancers(ctx context.Context,
 
 	ctrl.loadBalancers.UpdateUpstreams(endpoints)
 
+	if ctrl.sniRouter != nil {
+		ctrl.sniRouter.SetRoutes(sniRoutes)
+	}
+
 	// Clean up statuses of load balancers that have been deleted or stopped.
 	statuses, err := safe.ReaderListAll[*omni.LoadBalancerStatus](ctx, r)
 	if err != nil {
@@ -173,6 +216,7 @@ func (ctrl *LoadBalancerController) reconcileLoadBalancers(ctx context.Context,
 
 				status.TypedSpec().Value.Stopped = true
 				status.TypedSpec().Value.Healthy = false
+				status.TypedSpec().Value.Upstreams = nil
 
 				return nil
 			}); err != nil {
@@ -196,12 +240,19 @@ func (ctrl *LoadBalancerController) reconcileLoadBalancers(ctx context.Context,
 }
 
 func (ctrl *LoadBalancerController) updateHealthStatus(ctx context.Context, r controller.Runtime) error {
-	for id, healthy := range ctrl.loadBalancers.GetHealthStatus() {
+	for id, lbStatus := range ctrl.loadBalancers.GetHealthStatus() {
 		if err := safe.WriterModify(ctx, r, omni.NewLoadBalancerStatus(resources.DefaultNamespace, id), func(status *omni.LoadBalancerStatus) error {
 			status.Metadata().Labels().Set(omni.LabelCluster, id)
 
 			status.TypedSpec().Value.Stopped = false
-			status.TypedSpec().Value.Healthy = healthy
+			status.TypedSpec().Value.Healthy = lbStatus.Healthy
+			status.TypedSpec().Value.Upstreams = xslices.Map(lbStatus.Upstreams, func(upstream loadbalancer.UpstreamStatus) *specs.LoadBalancerStatusSpec_Upstream {
+				return &specs.LoadBalancerStatusSpec_Upstream{
+					Address:   upstream.Address,
+					Healthy:   upstream.Healthy,
+					LastError: upstream.LastError,
+				}
+			})
 
 			return nil
 		}); err != nil {
@@ -211,3 +262,23 @@ func (ctrl *LoadBalancerController) updateHealthStatus(ctx context.Context, r co
 
 	return nil
 }
+
+// Describe implements prom.Collector interface.
+func (ctrl *LoadBalancerController) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(ctrl, ch)
+}
+
+// Collect implements prom.Collector interface.
+func (ctrl *LoadBalancerController) Collect(ch chan<- prometheus.Metric) {
+	ctrl.mu.Lock()
+	loadBalancers := ctrl.loadBalancers
+	ctrl.mu.Unlock()
+
+	if loadBalancers == nil {
+		return
+	}
+
+	loadBalancers.Collect(ch)
+}
+
+var _ prometheus.Collector = &LoadBalancerController{}
@@ -90,6 +90,16 @@ func (lb *mockLoadBalancer) Healthy() (bool, error) {
 	return true, nil
 }
 
+func (lb *mockLoadBalancer) Upstreams() []loadbalancer.UpstreamStatus {
+	return []loadbalancer.UpstreamStatus{
+		{
+			Address:           "1.2.3.4:6443",
+			Healthy:           true,
+			ActiveConnections: 2,
+		},
+	}
+}
+
 type LoadBalancerSuite struct {
 	OmniSuite
 }
@@ -254,6 +264,13 @@ func (suite *LoadBalancerSuite) assertStatus(id string, expectedHealthy, expecte
 			lbStatus := res.TypedSpec().Value
 			assertions.Equal(expectedHealthy, lbStatus.Healthy)
 			assertions.Equal(expectedStopped, lbStatus.Stopped)
+
+			if expectedStopped {
+				assertions.Empty(lbStatus.Upstreams)
+			} else if assertions.Len(lbStatus.Upstreams, 1) {
+				assertions.Equal("1.2.3.4:6443", lbStatus.Upstreams[0].Address)
+				assertions.True(lbStatus.Upstreams[0].Healthy)
+			}
 		},
 	)
 }
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/machinehealth"
+)
+
+// MachineHealthStatusController manages MachineHealthStatus resource lifecycle.
+//
+// MachineHealthStatusController derives the hardware health diagnostics from the changes of the MachineStatus.
+type MachineHealthStatusController = qtransform.QController[*omni.MachineStatus, *omni.MachineHealthStatus]
+
+// NewMachineHealthStatusController initializes MachineHealthStatusController.
+func NewMachineHealthStatusController() *MachineHealthStatusController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.MachineStatus, *omni.MachineHealthStatus]{
+			Name: "MachineHealthStatusController",
+			MapMetadataFunc: func(machineStatus *omni.MachineStatus) *omni.MachineHealthStatus {
+				return omni.NewMachineHealthStatus(resources.DefaultNamespace, machineStatus.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(health *omni.MachineHealthStatus) *omni.MachineStatus {
+				return omni.NewMachineStatus(resources.DefaultNamespace, health.Metadata().ID())
+			},
+			TransformFunc: func(_ context.Context, _ controller.Reader, _ *zap.Logger, machineStatus *omni.MachineStatus, health *omni.MachineHealthStatus) error {
+				if cluster, ok := machineStatus.Metadata().Labels().Get(omni.LabelCluster); ok {
+					health.Metadata().Labels().Set(omni.LabelCluster, cluster)
+				} else {
+					health.Metadata().Labels().Delete(omni.LabelCluster)
+				}
+
+				requeueAfter := machinehealth.Update(health.TypedSpec().Value, machineStatus.TypedSpec().Value, time.Now())
+
+				// re-evaluate the link flapping when the oldest transition leaves the window
+				if requeueAfter > 0 {
+					return controller.NewRequeueInterval(requeueAfter)
+				}
+
+				return nil
+			},
+		},
+		qtransform.WithConcurrency(4),
+	)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type MachineHealthStatusSuite struct {
+	OmniSuite
+}
+
+func (suite *MachineHealthStatusSuite) TestReconcile() {
+	require := suite.Require()
+
+	suite.ctx, suite.ctxCancel = context.WithTimeout(suite.ctx, time.Second*10)
+
+	suite.startRuntime()
+
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewMachineHealthStatusController()))
+
+	disks := []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+		{LinuxName: "/dev/sda", Serial: "S1", Type: "SSD"},
+		{LinuxName: "/dev/sdb", Serial: "S2", Type: "HDD"},
+	}
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine")
+	machineStatus.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+	machineStatus.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{
+		Blockdevices: disks,
+	}
+
+	require.NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	assertResource(
+		&suite.OmniSuite,
+		omni.NewMachineHealthStatus(resources.DefaultNamespace, "machine").Metadata(),
+		func(res *omni.MachineHealthStatus, assertions *assert.Assertions) {
+			cluster, _ := res.Metadata().Labels().Get(omni.LabelCluster)
+
+			assertions.Equal("cluster", cluster)
+			assertions.Len(res.TypedSpec().Value.Disks, 2)
+			assertions.Empty(res.TypedSpec().Value.Diagnostics)
+		},
+	)
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.Hardware.Blockdevices = disks[:1]
+
+		return nil
+	})
+	require.NoError(err)
+
+	assertResource(
+		&suite.OmniSuite,
+		omni.NewMachineHealthStatus(resources.DefaultNamespace, "machine").Metadata(),
+		func(res *omni.MachineHealthStatus, assertions *assert.Assertions) {
+			assertions.Equal(specs.MachineHealthStatusSpec_ERROR, res.TypedSpec().Value.Severity)
+
+			if assertions.Len(res.TypedSpec().Value.Diagnostics, 1) {
+				assertions.Equal("disk_missing", res.TypedSpec().Value.Diagnostics[0].Type)
+				assertions.Equal("serial:S2", res.TypedSpec().Value.Diagnostics[0].Subject)
+			}
+		},
+	)
+
+	rtestutils.Destroy[*omni.MachineStatus](suite.ctx, suite.T(), suite.state, []resource.ID{machineStatus.Metadata().ID()})
+
+	rtestutils.AssertNoResource[*omni.MachineHealthStatus](suite.ctx, suite.T(), suite.state, machineStatus.Metadata().ID())
+}
+
+func TestMachineHealthStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(MachineHealthStatusSuite))
+}
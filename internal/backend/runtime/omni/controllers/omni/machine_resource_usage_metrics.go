@@ -0,0 +1,214 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// MachineResourceUsageMetricsController publishes per machine metrics based on MachineResourceUsage.
+//
+//nolint:govet
+type MachineResourceUsageMetricsController struct {
+	usageMu sync.Mutex
+	usage   map[string]machineResourceUsage
+
+	metricsOnce                    sync.Once
+	metricLoad                     *prometheus.Desc
+	metricCPUUsage                 *prometheus.Desc
+	metricMemoryTotal              *prometheus.Desc
+	metricMemoryAvailable          *prometheus.Desc
+	metricFilesystemSize           *prometheus.Desc
+	metricFilesystemAvailable      *prometheus.Desc
+	metricNetworkReceiveRate       *prometheus.Desc
+	metricNetworkTransmitRate      *prometheus.Desc
+	metricResourceUsageCollectedAt *prometheus.Desc
+}
+
+type machineResourceUsage struct {
+	spec    *specs.MachineResourceUsageSpec
+	cluster string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *MachineResourceUsageMetricsController) Name() string {
+	return "MachineResourceUsageMetricsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MachineResourceUsageMetricsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: resources.EphemeralNamespace,
+			Type:      omni.MachineResourceUsageType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MachineResourceUsageMetricsController) Outputs() []controller.Output {
+	return nil
+}
+
+func (ctrl *MachineResourceUsageMetricsController) initMetrics() {
+	ctrl.metricsOnce.Do(func() {
+		machineLabels := []string{"machine_id", "cluster"}
+
+		ctrl.metricLoad = prometheus.NewDesc(
+			"omni_machine_load_average",
+			"Load average of the machine.",
+			append(machineLabels, "period"),
+			nil,
+		)
+
+		ctrl.metricCPUUsage = prometheus.NewDesc(
+			"omni_machine_cpu_usage_percent",
+			"Share of the non-idle CPU time of the machine across all cores.",
+			machineLabels,
+			nil,
+		)
+
+		ctrl.metricMemoryTotal = prometheus.NewDesc(
+			"omni_machine_memory_total_bytes",
+			"Total memory of the machine.",
+			machineLabels,
+			nil,
+		)
+
+		ctrl.metricMemoryAvailable = prometheus.NewDesc(
+			"omni_machine_memory_available_bytes",
+			"Available memory of the machine.",
+			machineLabels,
+			nil,
+		)
+
+		ctrl.metricFilesystemSize = prometheus.NewDesc(
+			"omni_machine_filesystem_size_bytes",
+			"Size of the filesystem mounted on the machine.",
+			append(machineLabels, "mountpoint"),
+			nil,
+		)
+
+		ctrl.metricFilesystemAvailable = prometheus.NewDesc(
+			"omni_machine_filesystem_available_bytes",
+			"Available space of the filesystem mounted on the machine.",
+			append(machineLabels, "mountpoint"),
+			nil,
+		)
+
+		ctrl.metricNetworkReceiveRate = prometheus.NewDesc(
+			"omni_machine_network_receive_bytes_per_second",
+			"Receive throughput of the physical network interface of the machine.",
+			append(machineLabels, "interface"),
+			nil,
+		)
+
+		ctrl.metricNetworkTransmitRate = prometheus.NewDesc(
+			"omni_machine_network_transmit_bytes_per_second",
+			"Transmit throughput of the physical network interface of the machine.",
+			append(machineLabels, "interface"),
+			nil,
+		)
+
+		ctrl.metricResourceUsageCollectedAt = prometheus.NewDesc(
+			"omni_machine_resource_usage_collected_timestamp_seconds",
+			"Time of the last resource usage collection of the machine.",
+			machineLabels,
+			nil,
+		)
+	})
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *MachineResourceUsageMetricsController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	ctrl.initMetrics()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := safe.ReaderListAll[*omni.MachineResourceUsage](ctx, r)
+		if err != nil {
+			return err
+		}
+
+		usage := make(map[string]machineResourceUsage, list.Len())
+
+		for res := range list.All() {
+			cluster, _ := res.Metadata().Labels().Get(omni.LabelCluster)
+
+			usage[res.Metadata().ID()] = machineResourceUsage{
+				spec:    res.TypedSpec().Value,
+				cluster: cluster,
+			}
+		}
+
+		ctrl.usageMu.Lock()
+		ctrl.usage = usage
+		ctrl.usageMu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(10 * time.Second): // don't reconcile too often, as metrics are not scraped that often
+		}
+	}
+}
+
+// Describe implements prom.Collector interface.
+func (ctrl *MachineResourceUsageMetricsController) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(ctrl, ch)
+}
+
+// Collect implements prom.Collector interface.
+func (ctrl *MachineResourceUsageMetricsController) Collect(ch chan<- prometheus.Metric) {
+	ctrl.initMetrics()
+
+	ctrl.usageMu.Lock()
+	defer ctrl.usageMu.Unlock()
+
+	for id, usage := range ctrl.usage {
+		spec := usage.spec
+
+		ch <- prometheus.MustNewConstMetric(ctrl.metricLoad, prometheus.GaugeValue, spec.Load1, id, usage.cluster, "1m")
+		ch <- prometheus.MustNewConstMetric(ctrl.metricLoad, prometheus.GaugeValue, spec.Load5, id, usage.cluster, "5m")
+		ch <- prometheus.MustNewConstMetric(ctrl.metricLoad, prometheus.GaugeValue, spec.Load15, id, usage.cluster, "15m")
+		ch <- prometheus.MustNewConstMetric(ctrl.metricCPUUsage, prometheus.GaugeValue, spec.CpuUsagePercent, id, usage.cluster)
+		ch <- prometheus.MustNewConstMetric(ctrl.metricMemoryTotal, prometheus.GaugeValue, float64(spec.MemoryTotalBytes), id, usage.cluster)
+		ch <- prometheus.MustNewConstMetric(ctrl.metricMemoryAvailable, prometheus.GaugeValue, float64(spec.MemoryAvailableBytes), id, usage.cluster)
+
+		for _, mount := range spec.Mounts {
+			ch <- prometheus.MustNewConstMetric(ctrl.metricFilesystemSize, prometheus.GaugeValue, float64(mount.SizeBytes), id, usage.cluster, mount.MountedOn)
+			ch <- prometheus.MustNewConstMetric(ctrl.metricFilesystemAvailable, prometheus.GaugeValue, float64(mount.AvailableBytes), id, usage.cluster, mount.MountedOn)
+		}
+
+		for _, iface := range spec.NetworkInterfaces {
+			ch <- prometheus.MustNewConstMetric(ctrl.metricNetworkReceiveRate, prometheus.GaugeValue, iface.RxBytesPerSecond, id, usage.cluster, iface.Name)
+			ch <- prometheus.MustNewConstMetric(ctrl.metricNetworkTransmitRate, prometheus.GaugeValue, iface.TxBytesPerSecond, id, usage.cluster, iface.Name)
+		}
+
+		if spec.CollectedAt != nil {
+			ch <- prometheus.MustNewConstMetric(ctrl.metricResourceUsageCollectedAt, prometheus.GaugeValue, float64(spec.CollectedAt.AsTime().Unix()), id, usage.cluster)
+		}
+	}
+}
+
+var _ prometheus.Collector = &MachineResourceUsageMetricsController{}
@@ -26,6 +26,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/infra"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
+	"github.com/siderolabs/omni/internal/pkg/machineexpr"
 )
 
 type machineStatusLabels = system.ResourceLabels[*omni.MachineStatus]
@@ -68,6 +69,11 @@ func (ctrl *MachineSetNodeController) Inputs() []controller.Input {
 			Type:      system.ResourceLabelsType[*omni.MachineStatus](),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.MachineStatusType,
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: resources.DefaultNamespace,
 			Type:      omni.MachineClassType,
@@ -220,6 +226,7 @@ func (ctrl *MachineSetNodeController) Run(ctx context.Context, r controller.Runt
 }
 
 type allocationConfig struct {
+	expression     *machineexpr.Expression
 	selectors      resource.LabelQueries
 	machineCount   uint32
 	allocationType specs.MachineSetSpec_MachineAllocation_Type
@@ -228,6 +235,7 @@ type allocationConfig struct {
 
 func (ctrl *MachineSetNodeController) getMachineAllocation(ctx context.Context, r controller.Reader, machineSet *omni.MachineSet) (*allocationConfig, error) {
 	var (
+		expression        *machineexpr.Expression
 		selectors         resource.LabelQueries
 		machineAllocation = omni.GetMachineAllocation(machineSet)
 	)
@@ -264,6 +272,18 @@ func (ctrl *MachineSetNodeController) getMachineAllocation(ctx context.Context,
 			return nil, err
 		}
 
+		if matchExpression := machineClass.TypedSpec().Value.MatchExpression; matchExpression != "" {
+			expression, err = machineexpr.Compile(matchExpression)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile match expression of the machine class %q: %w", machineClass.Metadata().ID(), err)
+			}
+
+			// the machine class without labels matches all machines by labels
+			if len(selectors) == 0 {
+				selectors = resource.LabelQueries{{}}
+			}
+		}
+
 		manualAllocation = true
 	case machineAllocation.Source == specs.MachineSetSpec_MachineAllocation_MachineRequestSet:
 		selectors = append(selectors, resource.LabelQuery{
@@ -280,6 +300,7 @@ func (ctrl *MachineSetNodeController) getMachineAllocation(ctx context.Context,
 	}
 
 	return &allocationConfig{
+		expression:     expression,
 		selectors:      selectors,
 		allocationType: machineAllocation.AllocationType,
 		machineCount:   machineAllocation.MachineCount,
@@ -314,6 +335,12 @@ func (ctrl *MachineSetNodeController) reconcileMachineSet(
 
 	existingMachineSetNodes := allMachineSetNodes.FilterLabelQuery(resource.LabelEqual(omni.LabelMachineSet, machineSet.Metadata().ID()))
 
+	if allocation.expression != nil {
+		if err = ctrl.labelMismatchedNodes(ctx, r, allocation.expression, existingMachineSetNodes, logger); err != nil {
+			return err
+		}
+	}
+
 	switch allocation.allocationType {
 	case specs.MachineSetSpec_MachineAllocation_Unlimited:
 		err = ctrl.createNodes(ctx, r, machineSet, allocation, allMachineStatuses, math.MaxInt32, logger)
@@ -427,6 +454,19 @@ func (ctrl *MachineSetNodeController) createNodes(
 
 			id := machine.Metadata().ID()
 
+			if allocation.expression != nil {
+				var matched bool
+
+				matched, err = matchMachineExpression(ctx, r, allocation.expression, id)
+				if err != nil {
+					return err
+				}
+
+				if !matched {
+					continue
+				}
+			}
+
 			if err := r.Create(ctx, omni.NewMachineSetNode(resources.DefaultNamespace, id, machineSet)); err != nil {
 				if state.IsConflictError(err) {
 					continue
@@ -522,6 +562,79 @@ func (ctrl *MachineSetNodeController) deleteNodes(
 	return nil
 }
 
+// labelMismatchedNodes marks the machine set nodes which no longer match the machine class expression with the [omni.LabelMachineClassMismatch] label.
+//
+// The mismatched nodes are not removed from the machine set, as the hardware status might change only temporarily,
+// e.g. when a disk is briefly missing. The evaluation errors keep the nodes as they are.
+func (ctrl *MachineSetNodeController) labelMismatchedNodes(
+	ctx context.Context,
+	r controller.Runtime,
+	expression *machineexpr.Expression,
+	machineSetNodes safe.List[*omni.MachineSetNode],
+	logger *zap.Logger,
+) error {
+	for machineSetNode := range machineSetNodes.All() {
+		if machineSetNode.Metadata().Owner() != ctrl.Name() || machineSetNode.Metadata().Phase() == resource.PhaseTearingDown {
+			continue
+		}
+
+		machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, machineSetNode.Metadata().ID())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return err
+		}
+
+		matched, err := expression.Match(machineStatus)
+		if err != nil {
+			continue
+		}
+
+		_, labeled := machineSetNode.Metadata().Labels().Get(omni.LabelMachineClassMismatch)
+		if labeled != matched {
+			continue
+		}
+
+		if !matched {
+			logger.Warn("machine set node doesn't match the machine class expression", zap.String("machine", machineSetNode.Metadata().ID()))
+		}
+
+		if err = safe.WriterModify(ctx, r, machineSetNode, func(res *omni.MachineSetNode) error {
+			if matched {
+				res.Metadata().Labels().Delete(omni.LabelMachineClassMismatch)
+			} else {
+				res.Metadata().Labels().Set(omni.LabelMachineClassMismatch, "")
+			}
+
+			return nil
+		}); err != nil && !state.IsNotFoundError(err) && !state.IsPhaseConflictError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchMachineExpression evaluates the machine class expression against the machine status.
+//
+// The evaluation errors are not returned, the machine is considered not matching, as the expression might rely on the data missing for some machines.
+func matchMachineExpression(ctx context.Context, r controller.Reader, expression *machineexpr.Expression, id resource.ID) (bool, error) {
+	machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	matched, matchErr := expression.Match(machineStatus)
+
+	return matchErr == nil && matched, nil
+}
+
 func getSortFunction(machineStatuses map[resource.ID]*machineStatusLabels) func(a, b *omni.MachineSetNode) int {
 	return func(a, b *omni.MachineSetNode) int {
 		ms1, ok1 := machineStatuses[a.Metadata().ID()]
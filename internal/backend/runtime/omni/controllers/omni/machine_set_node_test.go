@@ -229,6 +229,103 @@ func (suite *MachineSetNodeSuite) TestReconcile() {
 	assertNoMachineSetNode(machines[4])
 }
 
+func (suite *MachineSetNodeSuite) TestReconcileMatchExpression() {
+	suite.startRuntime()
+
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	suite.Require().NoError(suite.runtime.RegisterController(&omnictrl.MachineSetNodeController{}))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewLabelsExtractorController[*omni.MachineStatus]()))
+
+	availableLabels := map[string]string{
+		omni.MachineStatusLabelAvailable:       "",
+		omni.MachineStatusLabelConnected:       "",
+		omni.MachineStatusLabelReportingEvents: "",
+	}
+
+	machines := suite.createMachines(availableLabels, availableLabels)
+
+	for i, diskCount := range []int{1, 2} {
+		_, err := safe.StateUpdateWithConflicts(ctx, suite.state, machines[i].Metadata(), func(res *omni.MachineStatus) error {
+			res.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{}
+
+			for range diskCount {
+				res.TypedSpec().Value.Hardware.Blockdevices = append(res.TypedSpec().Value.Hardware.Blockdevices, &specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+					Type: "nvme",
+					Size: 2 * 1000 * 1000 * 1000 * 1000,
+				})
+			}
+
+			return nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "cluster-expr")
+	cluster.TypedSpec().Value.TalosVersion = "1.6.0"
+
+	suite.Require().NoError(suite.state.Create(ctx, cluster))
+
+	machineClass := newMachineClass()
+	machineClass.TypedSpec().Value.MatchExpression = `machine.hardware.blockdevices.filter(d, d.type == "nvme" && d.size >= 1 * TB).size() >= 2`
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "expr")
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	machineSet.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+	machineSet.TypedSpec().Value.MachineAllocation = &specs.MachineSetSpec_MachineAllocation{
+		Name:         machineClass.Metadata().ID(),
+		MachineCount: 2,
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, machineClass))
+	suite.Require().NoError(suite.state.Create(ctx, machineSet))
+
+	assertResource(&suite.OmniSuite, omni.NewMachineSetNode(resources.DefaultNamespace, machines[1].Metadata().ID(), machineSet).Metadata(),
+		func(*omni.MachineSetNode, *assert.Assertions) {},
+	)
+
+	assertNoResource(&suite.OmniSuite, omni.NewMachineSetNode(resources.DefaultNamespace, machines[0].Metadata().ID(), machineSet))
+
+	setDisks := func(machine *omni.MachineStatus, count int) {
+		_, err := safe.StateUpdateWithConflicts(ctx, suite.state, machine.Metadata(), func(res *omni.MachineStatus) error {
+			res.TypedSpec().Value.Hardware.Blockdevices = nil
+
+			for range count {
+				res.TypedSpec().Value.Hardware.Blockdevices = append(res.TypedSpec().Value.Hardware.Blockdevices, &specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+					Type: "nvme",
+					Size: 2 * 1000 * 1000 * 1000 * 1000,
+				})
+			}
+
+			return nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	// the allocated machine which stops matching the expression is kept in the machine set, its node is labeled
+	setDisks(machines[1], 1)
+
+	assertResource(&suite.OmniSuite, omni.NewMachineSetNode(resources.DefaultNamespace, machines[1].Metadata().ID(), machineSet).Metadata(),
+		func(res *omni.MachineSetNode, assertion *assert.Assertions) {
+			_, mismatched := res.Metadata().Labels().Get(omni.LabelMachineClassMismatch)
+			assertion.True(mismatched)
+		},
+	)
+
+	assertNoResource(&suite.OmniSuite, omni.NewMachineSetNode(resources.DefaultNamespace, machines[0].Metadata().ID(), machineSet))
+
+	// the label is removed once the machine matches again
+	setDisks(machines[1], 2)
+
+	assertResource(&suite.OmniSuite, omni.NewMachineSetNode(resources.DefaultNamespace, machines[1].Metadata().ID(), machineSet).Metadata(),
+		func(res *omni.MachineSetNode, assertion *assert.Assertions) {
+			_, mismatched := res.Metadata().Labels().Get(omni.LabelMachineClassMismatch)
+			assertion.False(mismatched)
+		},
+	)
+}
+
 func TestSortFunction(t *testing.T) {
 	machineStatuses := map[resource.ID]*system.ResourceLabels[*omni.MachineStatus]{}
 	machineSetNodes := make([]*omni.MachineSetNode, 0, 10)
@@ -125,6 +125,16 @@ func NewMachineSetStatusController() *MachineSetStatusController {
 			// config patch to machine set if the machine is allocated, checks by different layers, if is on the cluster layer,
 			// matches all machine sets
 			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, patch *omni.ConfigPatch) ([]resource.Pointer, error) {
+				// fleet patch, the selector might match any machine set
+				if _, ok := patch.Metadata().Labels().Get(omni.LabelFleetPatchTarget); ok {
+					list, err := r.List(ctx, omni.NewMachineSet(resources.DefaultNamespace, "").Metadata())
+					if err != nil {
+						return nil, err
+					}
+
+					return xslices.Map(list.Items, func(r resource.Resource) resource.Pointer { return r.Metadata() }), nil
+				}
+
 				clusterName, ok := patch.Metadata().Labels().Get(omni.LabelCluster)
 				if !ok {
 					// no cluster, map by the machine ID
@@ -160,6 +170,47 @@ func NewMachineSetStatusController() *MachineSetStatusController {
 				return xslices.Map(list.Items, func(r resource.Resource) resource.Pointer { return r.Metadata() }), nil
 			},
 		),
+		qtransform.WithExtraMappedInput(
+			// machine status to machine set if there are fleet patches targeting the machines by their labels
+			// or config patch templates which might use the machine facts
+			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, machineStatus *omni.MachineStatus) ([]resource.Pointer, error) {
+				dependentPatches := 0
+
+				for _, query := range []resource.LabelQueryOption{
+					resource.LabelEqual(omni.LabelFleetPatchTarget, omni.FleetPatchTargetMachine),
+					resource.LabelExists(omni.LabelConfigPatchTemplate),
+				} {
+					patches, err := r.List(ctx, omni.NewConfigPatch(resources.DefaultNamespace, "").Metadata(), state.WithLabelQuery(query))
+					if err != nil {
+						return nil, err
+					}
+
+					dependentPatches += len(patches.Items)
+				}
+
+				if dependentPatches == 0 {
+					return nil, nil
+				}
+
+				clusterMachine, err := r.Get(ctx, omni.NewClusterMachine(resources.DefaultNamespace, machineStatus.Metadata().ID()).Metadata())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return nil, nil
+					}
+
+					return nil, err
+				}
+
+				machineSetID, ok := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+				if !ok {
+					return nil, nil
+				}
+
+				return []resource.Pointer{
+					omni.NewMachineSet(resources.DefaultNamespace, machineSetID).Metadata(),
+				}, nil
+			},
+		),
 		qtransform.WithExtraOutputs(
 			controller.Output{
 				Type: omni.ClusterMachineType,
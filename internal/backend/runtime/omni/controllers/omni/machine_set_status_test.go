@@ -939,6 +939,127 @@ func (suite *MachineSetStatusSuite) TestMachineLocks() {
 	})
 }
 
+func (suite *MachineSetStatusSuite) TestFleetConfigPatches() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
+	defer cancel()
+
+	clusterName := "fleet"
+
+	machines := []string{
+		"fleet01",
+		"fleet02",
+	}
+
+	suite.createMachineSet(clusterName, "machine-set-fleet", machines)
+
+	suite.assertMachinePatches(machines, func(res *omni.ClusterMachineConfigPatches, assertions *assert.Assertions) {
+		patches, err := res.TypedSpec().Value.GetUncompressedPatches()
+		assertions.NoError(err)
+
+		assertions.Len(patches, 1)
+	})
+
+	clusterPatchData := `machine:
+  network:
+    hostname: fleet-cluster`
+
+	clusterPatch := omni.NewConfigPatch(resources.DefaultNamespace, "fleet-cluster",
+		pair.MakePair(omni.LabelFleetPatchTarget, omni.FleetPatchTargetCluster),
+	)
+
+	suite.Require().NoError(clusterPatch.TypedSpec().Value.SetUncompressedData([]byte(clusterPatchData)))
+	suite.Require().NoError(suite.state.Create(ctx, clusterPatch))
+
+	machinePatch := omni.NewConfigPatch(resources.DefaultNamespace, "fleet-machine",
+		pair.MakePair(omni.LabelFleetPatchTarget, omni.FleetPatchTargetMachine),
+	)
+
+	machinePatch.Metadata().Annotations().Set(omni.FleetPatchSelector, "rack=a")
+
+	suite.Require().NoError(machinePatch.TypedSpec().Value.SetUncompressedData([]byte(`machine:
+  network:
+    hostname: fleet-machine`)))
+	suite.Require().NoError(suite.state.Create(ctx, machinePatch))
+
+	// the fleet patch without the selector applies to all clusters and goes before the cluster patches
+	suite.assertMachinePatches(machines, func(res *omni.ClusterMachineConfigPatches, assertions *assert.Assertions) {
+		patches, err := res.TypedSpec().Value.GetUncompressedPatches()
+		assertions.NoError(err)
+
+		if assertions.Len(patches, 2) {
+			assertions.Equal(clusterPatchData, patches[0])
+		}
+	})
+
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, omni.NewMachineStatus(resources.DefaultNamespace, machines[0]).Metadata(), func(res *omni.MachineStatus) error {
+		res.Metadata().Labels().Set("rack", "a")
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.assertMachinePatches(machines[:1], func(res *omni.ClusterMachineConfigPatches, assertions *assert.Assertions) {
+		patches, err := res.TypedSpec().Value.GetUncompressedPatches()
+		assertions.NoError(err)
+
+		assertions.Len(patches, 3)
+	})
+
+	suite.assertMachinePatches(machines[1:], func(res *omni.ClusterMachineConfigPatches, assertions *assert.Assertions) {
+		patches, err := res.TypedSpec().Value.GetUncompressedPatches()
+		assertions.NoError(err)
+
+		assertions.Len(patches, 2)
+	})
+}
+
+func (suite *MachineSetStatusSuite) TestConfigPatchTemplates() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
+	defer cancel()
+
+	clusterName := "templates"
+
+	machines := []string{
+		"template01",
+		"template02",
+	}
+
+	suite.createMachineSet(clusterName, "machine-set-templates", machines)
+
+	templatePatch := omni.NewConfigPatch(resources.DefaultNamespace, "template",
+		pair.MakePair(omni.LabelCluster, clusterName),
+		pair.MakePair(omni.LabelConfigPatchTemplate, ""),
+	)
+
+	suite.Require().NoError(templatePatch.TypedSpec().Value.SetUncompressedData([]byte(`machine:
+  network:
+    hostname: {{ .Cluster.Name }}-{{ .Machine.Labels.rack | default "none" }}-{{ .Machine.ID }}`)))
+	suite.Require().NoError(suite.state.Create(ctx, templatePatch))
+
+	assertHostname := func(machine, hostname string) {
+		suite.assertMachinePatches([]string{machine}, func(res *omni.ClusterMachineConfigPatches, assertions *assert.Assertions) {
+			patches, err := res.TypedSpec().Value.GetUncompressedPatches()
+			assertions.NoError(err)
+
+			assertions.Contains(patches, "machine:\n  network:\n    hostname: "+hostname)
+		})
+	}
+
+	assertHostname(machines[0], "templates-none-template01")
+	assertHostname(machines[1], "templates-none-template02")
+
+	// the template is rendered again when the machine facts change
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, omni.NewMachineStatus(resources.DefaultNamespace, machines[1]).Metadata(), func(res *omni.MachineStatus) error {
+		res.Metadata().Labels().Set("rack", "r2")
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	assertHostname(machines[0], "templates-none-template01")
+	assertHostname(machines[1], "templates-r2-template02")
+}
+
 func (suite *MachineSetStatusSuite) TestMachineIsAddedToAnotherMachineSet() {
 	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
 	defer cancel()
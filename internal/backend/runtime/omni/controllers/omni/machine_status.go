@@ -8,6 +8,7 @@ package omni
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic"
@@ -45,17 +46,21 @@ type MachineStatusController struct {
 	runner             *task.Runner[machinetask.InfoChan, machinetask.CollectTaskSpec]
 	notifyCh           chan machinetask.Info
 	generic.NamedController
+	resourceUsageInterval time.Duration
 }
 
 // NewMachineStatusController initializes MachineStatusController.
-func NewMachineStatusController(imageFactoryClient SchematicEnsurer) *MachineStatusController {
+//
+// The machine resource usage is collected every resourceUsageInterval, the collection is disabled if it's zero.
+func NewMachineStatusController(imageFactoryClient SchematicEnsurer, resourceUsageInterval time.Duration) *MachineStatusController {
 	return &MachineStatusController{
 		NamedController: generic.NamedController{
 			ControllerName: MachineStatusControllerName,
 		},
-		notifyCh:           make(chan machinetask.Info),
-		runner:             task.NewEqualRunner[machinetask.CollectTaskSpec](),
-		ImageFactoryClient: imageFactoryClient,
+		notifyCh:              make(chan machinetask.Info),
+		runner:                task.NewEqualRunner[machinetask.CollectTaskSpec](),
+		ImageFactoryClient:    imageFactoryClient,
+		resourceUsageInterval: resourceUsageInterval,
 	}
 }
 
@@ -109,6 +114,10 @@ func (ctrl *MachineStatusController) Settings() controller.QSettings {
 				Kind: controller.OutputExclusive,
 				Type: omni.MachineStatusType,
 			},
+			{
+				Kind: controller.OutputExclusive,
+				Type: omni.MachineResourceUsageType,
+			},
 		},
 		Concurrency: optional.Some[uint](4),
 		RunHook: func(ctx context.Context, _ *zap.Logger, r controller.QRuntime) error {
@@ -232,12 +241,20 @@ func (ctrl *MachineStatusController) reconcileRunning(ctx context.Context, r con
 		MachineID:                  machine.Metadata().ID(),
 		MachineLabels:              inputs.machineLabels,
 		DefaultSchematicKernelArgs: siderolink.KernelArgs(params),
+		ResourceUsageInterval:      ctrl.resourceUsageInterval,
 	}
 
 	if !machine.TypedSpec().Value.Connected {
 		ctrl.runner.StopTask(logger, machine.Metadata().ID())
 	}
 
+	// the resource usage of the disconnected machines is not known
+	if !machine.TypedSpec().Value.Connected || ctrl.resourceUsageInterval == 0 {
+		if err = ctrl.destroyResourceUsage(ctx, r, machine.Metadata().ID()); err != nil {
+			return err
+		}
+	}
+
 	if machine.TypedSpec().Value.Connected {
 		ctrl.runner.StartTask(ctx, logger, machine.Metadata().ID(), spec, ctrl.notifyCh)
 	}
@@ -285,6 +302,10 @@ func (ctrl *MachineStatusController) reconcileTearingDown(ctx context.Context, r
 		return err
 	}
 
+	if err = ctrl.destroyResourceUsage(ctx, r, machine.Metadata().ID()); err != nil {
+		return err
+	}
+
 	md := omni.NewMachineStatus(resources.DefaultNamespace, machine.Metadata().ID()).Metadata()
 
 	ready, err := r.Teardown(ctx, md)
@@ -307,8 +328,47 @@ func (ctrl *MachineStatusController) reconcileTearingDown(ctx context.Context, r
 	return r.RemoveFinalizer(ctx, machine.Metadata(), ctrl.Name())
 }
 
+func (ctrl *MachineStatusController) destroyResourceUsage(ctx context.Context, r controller.QRuntime, id resource.ID) error {
+	if err := r.Destroy(ctx, omni.NewMachineResourceUsage(resources.EphemeralNamespace, id).Metadata()); err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error destroying machine resource usage: %w", err)
+	}
+
+	return nil
+}
+
+func (ctrl *MachineStatusController) handleResourceUsage(ctx context.Context, r controller.QRuntime, event machinetask.Info) error {
+	machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, event.MachineID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if machineStatus.Metadata().Phase() == resource.PhaseTearingDown {
+		return nil
+	}
+
+	return safe.WriterModify(ctx, r, omni.NewMachineResourceUsage(resources.EphemeralNamespace, event.MachineID), func(res *omni.MachineResourceUsage) error {
+		res.TypedSpec().Value = event.ResourceUsage
+
+		if cluster := machineStatus.TypedSpec().Value.Cluster; cluster != "" {
+			res.Metadata().Labels().Set(omni.LabelCluster, cluster)
+		} else {
+			res.Metadata().Labels().Delete(omni.LabelCluster)
+		}
+
+		return nil
+	})
+}
+
 //nolint:gocyclo,cyclop,gocognit
 func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r controller.QRuntime, event machinetask.Info) error {
+	if event.ResourceUsage != nil {
+		return ctrl.handleResourceUsage(ctx, r, event)
+	}
+
 	if err := safe.WriterModify(ctx, r, omni.NewMachineStatus(resources.DefaultNamespace, event.MachineID), func(m *omni.MachineStatus) error {
 		spec := m.TypedSpec().Value
 
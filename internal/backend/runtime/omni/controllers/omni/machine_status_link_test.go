@@ -39,6 +39,7 @@ func (suite *MachineStatusLinkSuite) SetupTest() {
 
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineStatusController(
 		&imageFactoryClientMock{},
+		0,
 	)))
 	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewMachineStatusLinkController(suite.deltaCh)))
 }
@@ -77,7 +77,7 @@ func (suite *MachineStatusSuite) setup() {
 
 	suite.Require().NoError(suite.state.Create(suite.ctx, params))
 
-	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineStatusController(&imageFactoryClientMock{})))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineStatusController(&imageFactoryClientMock{}, 0)))
 }
 
 const testID = "testID"
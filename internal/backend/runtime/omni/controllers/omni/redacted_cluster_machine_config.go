@@ -60,12 +60,7 @@ func NewRedactedClusterMachineConfigController() *RedactedClusterMachineConfigCo
 					return nil
 				}
 
-				config, err := configloader.NewFromBytes(data)
-				if err != nil {
-					return err
-				}
-
-				redactedData, err := config.RedactSecrets(x509.Redacted).EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+				redactedData, err := redactMachineConfig(data)
 				if err != nil {
 					return err
 				}
@@ -81,3 +76,13 @@ func NewRedactedClusterMachineConfigController() *RedactedClusterMachineConfigCo
 		},
 	)
 }
+
+// redactMachineConfig redacts the secrets in the machine config and strips the comments.
+func redactMachineConfig(data []byte) ([]byte, error) {
+	config, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return config.RedactSecrets(x509.Redacted).EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+}
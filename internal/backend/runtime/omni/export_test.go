@@ -11,8 +11,10 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
 	"github.com/siderolabs/omni/internal/pkg/config"
@@ -30,8 +32,10 @@ func EtcdElections(ctx context.Context, client *clientv3.Client, electionKey str
 	return etcdElections(ctx, client, electionKey, logger, f)
 }
 
-func ClusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackupParams, embeddedDiscoveryServiceConfig config.EmbeddedDiscoveryServiceParams) []validated.StateOption {
-	return clusterValidationOptions(st, etcdBackupConfig, embeddedDiscoveryServiceConfig)
+func ClusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackupParams, embeddedDiscoveryServiceConfig config.EmbeddedDiscoveryServiceParams,
+	loadBalancerConfig config.LoadBalancerParams,
+) []validated.StateOption {
+	return clusterValidationOptions(st, etcdBackupConfig, embeddedDiscoveryServiceConfig, loadBalancerConfig)
 }
 
 func RelationLabelsValidationOptions() []validated.StateOption {
@@ -85,3 +89,25 @@ func MachineRequestSetValidationOptions(st state.State) []validated.StateOption
 func InfraMachineConfigValidationOptions(st state.State) []validated.StateOption {
 	return infraMachineConfigValidationOptions(st)
 }
+
+func WrapStateWithTracing(st state.CoreState, tracer trace.Tracer) state.CoreState {
+	return &stateTracing{st: st, tracer: tracer}
+}
+
+var testConfigPreview = omnictrl.NewClusterMachineConfigPreview("factory-test.talos.dev", nil, 8090)
+
+func AdmissionPolicyValidationOptions(st state.State) []validated.StateOption {
+	return admissionPolicyValidationOptions(st, testConfigPreview, zap.NewNop())
+}
+
+func ChangeRequestValidationOptions(st state.State) []validated.StateOption {
+	return changeRequestValidationOptions(st)
+}
+
+func NewApprovalState(st state.CoreState) state.CoreState {
+	return newApprovalState(st, testConfigPreview, zap.NewNop())
+}
+
+func AddonValidationOptions() []validated.StateOption {
+	return addonValidationOptions()
+}
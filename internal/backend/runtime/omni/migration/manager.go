@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
 )
 
@@ -181,6 +182,10 @@ func NewManager(state state.State, logger *zap.Logger) *Manager {
 				callback: removeMaintenanceConfigPatchFinalizers,
 				name:     "removeMaintenanceConfigPatchFinalizers",
 			},
+			{
+				callback: deleteAllResources(resource.NewMetadata(resources.DefaultNamespace, omni.MachineResourceUsageType, "", resource.VersionUndefined)),
+				name:     "deleteDefaultNamespaceMachineResourceUsages",
+			},
 		},
 	}
 }
@@ -208,6 +213,11 @@ func WithMaxVersion(version int) Option {
 	}
 }
 
+// LatestVersion returns the DB version after all migrations are run.
+func (m *Manager) LatestVersion() uint64 {
+	return uint64(len(m.migrations))
+}
+
 // Run COSI state migrations.
 func (m *Manager) Run(ctx context.Context, opt ...Option) error {
 	opts := Options{}
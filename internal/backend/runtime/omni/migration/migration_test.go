@@ -1619,6 +1619,12 @@ func (suite *MigrationSuite) TestDeleteMachineClassStatuses() {
 	}, "deleteMachineClassStatuses")
 }
 
+func (suite *MigrationSuite) TestDeleteDefaultNamespaceMachineResourceUsages() {
+	suite.testDeleteDeprecatedResources(func(id string) resource.Resource {
+		return omni.NewMachineResourceUsage(resources.DefaultNamespace, id)
+	}, "deleteDefaultNamespaceMachineResourceUsages")
+}
+
 func (suite *MigrationSuite) TestRemoveMaintenanceConfigPatchFinalizers() {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
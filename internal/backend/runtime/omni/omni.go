@@ -9,8 +9,10 @@ package omni
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/url"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -46,11 +48,13 @@ import (
 	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/image"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/pkg/controllerstatus"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/virtual"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/virtual/pkg/producers"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
 	"github.com/siderolabs/omni/internal/backend/workloadproxy"
+	"github.com/siderolabs/omni/internal/pkg/addon/helm"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	newgroup "github.com/siderolabs/omni/internal/pkg/errgroup"
@@ -63,6 +67,7 @@ var Name = common.Runtime_Omni.String()
 // Runtime implements Omni internal runtime.
 type Runtime struct {
 	controllerRuntime  *cosiruntime.Runtime
+	controllerTracker  *controllerstatus.Tracker
 	talosClientFactory *talos.ClientFactory
 	storeFactory       store.Factory
 
@@ -178,6 +183,12 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		return nil, err
 	}
 
+	var loadBalancerSNIEndpoint string
+
+	if config.Config.LoadBalancer.SNIEnabled() {
+		loadBalancerSNIEndpoint = net.JoinHostPort("0.0.0.0", strconv.Itoa(config.Config.LoadBalancer.SNIPort))
+	}
+
 	controllers := []controller.Controller{
 		omnictrl.NewCertRefreshTickController(constants.CertificateValidityTime / 10), // issue ticks at 10% of the validity, as we refresh certificates at 50% of the validity
 		omnictrl.NewClusterController(),
@@ -196,21 +207,29 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 			NodeResolver:       dnsService,
 		}),
 		omnictrl.NewKubernetesStatusController(config.Config.APIURL, config.Config.WorkloadProxying.Subdomain),
-		&omnictrl.LoadBalancerController{},
+		&omnictrl.LoadBalancerController{
+			SNIEndpoint: loadBalancerSNIEndpoint,
+		},
 		&omnictrl.MachineSetNodeController{},
 		&omnictrl.MachineSetDestroyStatusController{},
 		omnictrl.NewMachineCleanupController(),
 		omnictrl.NewMachineStatusLinkController(linkCounterDeltaCh),
 		&omnictrl.MachineStatusMetricsController{},
+		&omnictrl.MachineResourceUsageMetricsController{},
 		&omnictrl.VersionsController{},
 		omnictrl.NewClusterLoadBalancerController(
 			config.Config.LoadBalancer.MinPort,
 			config.Config.LoadBalancer.MaxPort,
+			omnictrl.WithLoadBalancerSNI(config.Config.LoadBalancer.SNIDomain, config.Config.LoadBalancer.SNIPort),
 		),
 		&omnictrl.InstallationMediaController{},
 		omnictrl.NewKeyPrunerController(
 			config.Config.KeyPruner.Interval,
 		),
+		omnictrl.NewIssuedKubeconfigPrunerController(
+			config.Config.KeyPruner.Interval,
+			nil,
+		),
 		&omnictrl.OngoingTaskController{},
 		omnictrl.NewMachineRequestStatusCleanupController(),
 	}
@@ -222,6 +241,41 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 
 	imageFactoryHost := imageFactoryBaseURL.Host
 
+	// the installer images are pulled through the cache served on the API endpoint
+	if config.Config.ImageFactoryCache.InstallerRegistryEnabled() {
+		apiURL, err := url.Parse(config.Config.APIURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse API URL %q: %w", config.Config.APIURL, err)
+		}
+
+		imageFactoryHost = apiURL.Host
+	}
+
+	// the admission policies evaluate the machine configs generated with the config patches which are not written yet
+	configPreview := omnictrl.NewClusterMachineConfigPreview(imageFactoryHost, config.Config.DefaultConfigGenOptions, config.Config.EventSinkPort)
+
+	// the approved change requests are applied with the same validations as the API writes,
+	// the authorization is checked when the change is requested and approved
+	writeValidationOptions := slices.Concat(
+		clusterValidationOptions(resourceState, config.Config.EtcdBackup, config.Config.EmbeddedDiscoveryService, config.Config.LoadBalancer),
+		relationLabelsValidationOptions(),
+		accessPolicyValidationOptions(),
+		roleValidationOptions(),
+		machineSetNodeValidationOptions(resourceState),
+		machineSetValidationOptions(resourceState, storeFactory),
+		machineClassValidationOptions(resourceState),
+		identityValidationOptions(config.Config.Auth.SAML),
+		exposedServiceValidationOptions(),
+		configPatchValidationOptions(resourceState),
+		etcdManualBackupValidationOptions(),
+		samlLabelRuleValidationOptions(),
+		s3ConfigValidationOptions(),
+		machineRequestSetValidationOptions(resourceState),
+		infraMachineConfigValidationOptions(resourceState),
+		admissionPolicyValidationOptions(resourceState, configPreview, logger),
+		addonValidationOptions(),
+	)
+
 	qcontrollers := []controller.QController{
 		destroy.NewController[*siderolinkresources.Link](optional.Some[uint](4)),
 
@@ -233,8 +287,10 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		omnictrl.NewClusterMachineConfigController(imageFactoryHost, config.Config.DefaultConfigGenOptions, config.Config.EventSinkPort),
 		omnictrl.NewClusterMachineTeardownController(defaultDiscoveryClient, embeddedDiscoveryClient),
 		omnictrl.NewMachineConfigGenOptionsController(),
-		omnictrl.NewMachineStatusController(imageFactoryClient),
+		omnictrl.NewMachineStatusController(imageFactoryClient, config.Config.MachineResourceUsageInterval),
+		omnictrl.NewMachineHealthStatusController(),
 		omnictrl.NewClusterMachineConfigStatusController(imageFactoryHost),
+		omnictrl.NewConfigRevisionController(config.Config.ConfigRevisionLimit, config.Config.ConfigRevisionRetention),
 		omnictrl.NewClusterMachineEncryptionKeyController(),
 		omnictrl.NewClusterMachineStatusController(),
 		omnictrl.NewClusterStatusController(config.Config.EmbeddedDiscoveryService.Enabled),
@@ -261,6 +317,9 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		omnictrl.NewMachineStatusSnapshotController(siderolinkEventsCh),
 		omnictrl.NewMachineProvisionController(),
 		omnictrl.NewMachineRequestLinkController(resourceState),
+		omnictrl.NewChangeRequestController(resourceState, writeValidationOptions...),
+		omnictrl.NewAdmissionPolicyStatusController(),
+		omnictrl.NewAddonStatusController(&omnictrl.KubernetesAddonApplier{Logger: logger}, helm.NewFetcher(nil)),
 		omnictrl.NewLabelsExtractorController[*omni.MachineStatus](),
 		omnictrl.NewMachineRequestSetStatusController(),
 		omnictrl.NewClusterMachineRequestStatusController(),
@@ -269,14 +328,24 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		omnictrl.NewInfraProviderConfigPatchController(),
 	}
 
+	if config.Config.InventorySync.Endpoint != "" {
+		controllers = append(controllers,
+			omnictrl.NewInventorySyncController(config.Config.InventorySync.Endpoint, config.Config.InventorySync.Interval, config.Config.InventorySync.Headers),
+		)
+	}
+
 	if config.Config.Auth.SAML.Enabled {
 		controllers = append(controllers,
 			&omnictrl.SAMLAssertionController{},
 		)
 	}
 
+	controllerTracker := controllerstatus.NewTracker()
+
+	metricsRegistry.MustRegister(controllerTracker)
+
 	for _, c := range controllers {
-		if err = controllerRuntime.RegisterController(c); err != nil {
+		if err = controllerRuntime.RegisterController(controllerTracker.WrapController(c)); err != nil {
 			return nil, err
 		}
 
@@ -286,7 +355,7 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 	}
 
 	for _, c := range qcontrollers {
-		if err = controllerRuntime.RegisterQController(c); err != nil {
+		if err = controllerRuntime.RegisterQController(controllerTracker.WrapQController(c)); err != nil {
 			return nil, err
 		}
 
@@ -317,33 +386,21 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 	metricsRegistry.MustRegister(expvarCollector)
 
 	validationOptions := slices.Concat(
-		clusterValidationOptions(resourceState, config.Config.EtcdBackup, config.Config.EmbeddedDiscoveryService),
-		relationLabelsValidationOptions(),
-		accessPolicyValidationOptions(),
 		authorizationValidationOptions(resourceState),
-		roleValidationOptions(),
-		machineSetNodeValidationOptions(resourceState),
-		machineSetValidationOptions(resourceState, storeFactory),
-		machineClassValidationOptions(resourceState),
-		identityValidationOptions(config.Config.Auth.SAML),
-		exposedServiceValidationOptions(),
-		configPatchValidationOptions(resourceState),
-		etcdManualBackupValidationOptions(),
-		samlLabelRuleValidationOptions(),
-		s3ConfigValidationOptions(),
-		machineRequestSetValidationOptions(resourceState),
-		infraMachineConfigValidationOptions(resourceState),
+		writeValidationOptions,
+		changeRequestValidationOptions(resourceState),
 	)
 
 	return &Runtime{
 		controllerRuntime:       controllerRuntime,
+		controllerTracker:       controllerTracker,
 		talosClientFactory:      talosClientFactory,
 		storeFactory:            storeFactory,
 		dnsService:              dnsService,
 		workloadProxyReconciler: workloadProxyReconciler,
 		resourceLogger:          resourceLogger,
-		state:                   state.WrapCore(validated.NewState(resourceState, validationOptions...)),
-		cachedState:             state.WrapCore(validated.NewState(controllerRuntime.CachedState(), validationOptions...)),
+		state:                   state.WrapCore(validated.NewState(newApprovalState(resourceState, configPreview, logger), validationOptions...)),
+		cachedState:             state.WrapCore(validated.NewState(newApprovalState(controllerRuntime.CachedState(), configPreview, logger), validationOptions...)),
 		virtual:                 virtualState,
 		logger:                  logger,
 	}, nil
@@ -542,6 +599,16 @@ func (r *Runtime) GetCOSIRuntime() *cosiruntime.Runtime {
 	return r.controllerRuntime
 }
 
+// EtcdBackupStoreFactory returns the etcd backup store factory, it is started with the runtime.
+func (r *Runtime) EtcdBackupStoreFactory() store.Factory { //nolint:ireturn
+	return r.storeFactory
+}
+
+// ControllerStatuses returns the reconcile statuses of the controllers.
+func (r *Runtime) ControllerStatuses() []controllerstatus.Status {
+	return r.controllerTracker.List()
+}
+
 // RawTalosconfig returns the raw admin talosconfig for the cluster with the given name.
 func (r *Runtime) RawTalosconfig(ctx context.Context, clusterName string) ([]byte, error) {
 	ctx = actor.MarkContextAsInternalActor(ctx)
@@ -0,0 +1,444 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package controllerstatus tracks the reconcile loops of the controllers and exposes them as metrics and statuses.
+package controllerstatus
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/runtime/metrics"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// Kind is the kind of the controller.
+type Kind string
+
+// Controller kinds.
+const (
+	KindController  Kind = "controller"
+	KindQController Kind = "qcontroller"
+)
+
+// Health is the summarized state of the controller.
+type Health string
+
+// Controller health values.
+const (
+	// HealthPending is the health of the controller which didn't finish any reconcile yet.
+	HealthPending Health = "pending"
+	// HealthOK is the health of the controller which last reconcile succeeded.
+	HealthOK Health = "ok"
+	// HealthFailing is the health of the controller which last reconcile failed.
+	HealthFailing Health = "failing"
+)
+
+// Status is the status of a single controller.
+type Status struct {
+	LastSuccess   time.Time
+	LastErrorTime time.Time
+	Name          string
+	Kind          Kind
+	Health        Health
+	LastError     string
+	Reconciles    uint64
+	Errors        uint64
+	QueueDepth    int64
+	// Busy is the number of the reconciles in progress.
+	Busy int
+	// BusySince is the start time of the oldest reconcile in progress.
+	BusySince time.Time
+}
+
+// Tracker wraps the controllers to track their reconcile loops.
+//
+// Tracker implements prometheus.Collector.
+type Tracker struct {
+	controllers map[string]*controllerState
+
+	reconciles  *prometheus.CounterVec
+	errors      *prometheus.CounterVec
+	duration    *prometheus.HistogramVec
+	lastSuccess *prometheus.GaugeVec
+
+	mu sync.Mutex
+}
+
+// Check interfaces.
+var _ prometheus.Collector = &Tracker{}
+
+// NewTracker creates a new Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		controllers: map[string]*controllerState{},
+		reconciles: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_controller_reconciles_total",
+			Help: "Number of finished reconciles by controller name.",
+		}, []string{"controller"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_controller_reconcile_errors_total",
+			Help: "Number of failed reconciles by controller name.",
+		}, []string{"controller"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "omni_controller_reconcile_duration_seconds",
+			Help:    "Duration of the reconciles by controller name.",
+			Buckets: []float64{0.001, 0.01, 0.1, 0.5, 1, 5, 10, 30, 60, 300},
+		}, []string{"controller"}),
+		lastSuccess: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "omni_controller_last_success_timestamp_seconds",
+			Help: "Unix timestamp of the last successful reconcile by controller name.",
+		}, []string{"controller"}),
+	}
+}
+
+// WrapController wraps the controller to track its reconcile cycles.
+//
+// A cycle starts when the controller receives an event and ends when the controller waits for the next one.
+// The cycle fails if the controller returns an error.
+func (tracker *Tracker) WrapController(ctrl controller.Controller) controller.Controller {
+	return &trackedController{
+		Controller: ctrl,
+		state:      tracker.register(ctrl.Name(), KindController),
+	}
+}
+
+// WrapQController wraps the queue controller to track its reconciles.
+func (tracker *Tracker) WrapQController(ctrl controller.QController) controller.QController {
+	return &trackedQController{
+		QController: ctrl,
+		state:       tracker.register(ctrl.Name(), KindQController),
+	}
+}
+
+func (tracker *Tracker) register(name string, kind Kind) *controllerState {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	state := &controllerState{
+		tracker: tracker,
+		name:    name,
+		kind:    kind,
+		busy:    map[uint64]time.Time{},
+	}
+
+	tracker.controllers[name] = state
+
+	return state
+}
+
+// List returns the statuses of the tracked controllers sorted by name.
+func (tracker *Tracker) List() []Status {
+	tracker.mu.Lock()
+	states := make([]*controllerState, 0, len(tracker.controllers))
+
+	for _, state := range tracker.controllers {
+		states = append(states, state)
+	}
+	tracker.mu.Unlock()
+
+	statuses := make([]Status, 0, len(states))
+
+	for _, state := range states {
+		statuses = append(statuses, state.status())
+	}
+
+	slices.SortFunc(statuses, func(a, b Status) int { return strings.Compare(a.Name, b.Name) })
+
+	return statuses
+}
+
+// Describe implements prom.Collector interface.
+func (tracker *Tracker) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(tracker, ch)
+}
+
+// Collect implements prom.Collector interface.
+func (tracker *Tracker) Collect(ch chan<- prometheus.Metric) {
+	tracker.reconciles.Collect(ch)
+	tracker.errors.Collect(ch)
+	tracker.duration.Collect(ch)
+	tracker.lastSuccess.Collect(ch)
+}
+
+type controllerState struct {
+	tracker *Tracker
+	busy    map[uint64]time.Time
+
+	lastSuccess   time.Time
+	lastErrorTime time.Time
+	name          string
+	kind          Kind
+	lastError     string
+	reconciles    uint64
+	errors        uint64
+	nextID        uint64
+
+	mu sync.Mutex
+}
+
+func (state *controllerState) start() uint64 {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.nextID++
+
+	state.busy[state.nextID] = time.Now()
+
+	return state.nextID
+}
+
+func (state *controllerState) finish(id uint64, err error) {
+	now := time.Now()
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	started, ok := state.busy[id]
+	if !ok {
+		return
+	}
+
+	delete(state.busy, id)
+
+	state.reconciles++
+	state.tracker.reconciles.WithLabelValues(state.name).Inc()
+	state.tracker.duration.WithLabelValues(state.name).Observe(now.Sub(started).Seconds())
+
+	if err != nil {
+		state.recordError(now, err)
+
+		return
+	}
+
+	state.lastSuccess = now
+	state.tracker.lastSuccess.WithLabelValues(state.name).Set(float64(now.Unix()))
+}
+
+func (state *controllerState) abort(id uint64) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	delete(state.busy, id)
+}
+
+func (state *controllerState) fail(err error) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.recordError(time.Now(), err)
+}
+
+func (state *controllerState) recordError(now time.Time, err error) {
+	state.errors++
+	state.lastError = err.Error()
+	state.lastErrorTime = now
+
+	state.tracker.errors.WithLabelValues(state.name).Inc()
+}
+
+func (state *controllerState) status() Status {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	status := Status{
+		Name:          state.name,
+		Kind:          state.kind,
+		Reconciles:    state.reconciles,
+		Errors:        state.errors,
+		LastSuccess:   state.lastSuccess,
+		LastError:     state.lastError,
+		LastErrorTime: state.lastErrorTime,
+		Busy:          len(state.busy),
+	}
+
+	for _, started := range state.busy {
+		if status.BusySince.IsZero() || started.Before(status.BusySince) {
+			status.BusySince = started
+		}
+	}
+
+	switch {
+	case state.lastSuccess.IsZero() && state.lastErrorTime.IsZero():
+		status.Health = HealthPending
+	case state.lastErrorTime.After(state.lastSuccess):
+		status.Health = HealthFailing
+	default:
+		status.Health = HealthOK
+	}
+
+	if state.kind == KindQController {
+		if queueLength, ok := metrics.QControllerQueueLength.Get(state.name).(*expvar.Int); ok {
+			status.QueueDepth = queueLength.Value()
+		}
+	}
+
+	return status
+}
+
+type trackedQController struct {
+	controller.QController
+
+	state *controllerState
+}
+
+func (ctrl *trackedQController) Reconcile(ctx context.Context, logger *zap.Logger, r controller.QRuntime, ptr resource.Pointer) error {
+	id := ctrl.state.start()
+
+	err := ctrl.QController.Reconcile(ctx, logger, r, ptr)
+
+	ctrl.state.finish(id, reconcileError(err))
+
+	return err
+}
+
+func (ctrl *trackedQController) MapInput(ctx context.Context, logger *zap.Logger, r controller.QRuntime, ptr resource.Pointer) ([]resource.Pointer, error) {
+	result, err := ctrl.QController.MapInput(ctx, logger, r, ptr)
+	if err = reconcileError(err); err != nil {
+		ctrl.state.fail(err)
+	}
+
+	return result, err
+}
+
+// reconcileError filters out the intentional requeues without an error.
+func reconcileError(err error) error {
+	var requeueError *controller.RequeueError
+
+	if errors.As(err, &requeueError) {
+		return requeueError.Err()
+	}
+
+	return err
+}
+
+type trackedController struct {
+	controller.Controller
+
+	state *controllerState
+}
+
+func (ctrl *trackedController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	runtime := &trackedRuntime{
+		Runtime: r,
+		state:   ctrl.state,
+		done:    make(chan struct{}),
+	}
+
+	err := ctrl.Controller.Run(ctx, runtime, logger)
+
+	close(runtime.done)
+
+	runtime.mu.Lock()
+	cycle := runtime.cycle
+	runtime.cycle = 0
+	runtime.stopped = true
+	runtime.mu.Unlock()
+
+	switch {
+	case err != nil && ctx.Err() == nil && cycle != 0:
+		ctrl.state.finish(cycle, err)
+	case err != nil && ctx.Err() == nil:
+		ctrl.state.fail(err)
+	case cycle != 0:
+		// the controller was stopped in the middle of the cycle
+		ctrl.state.abort(cycle)
+	}
+
+	return err
+}
+
+// trackedRuntime observes the events consumed by the controller to detect the reconcile cycles.
+type trackedRuntime struct {
+	controller.Runtime
+
+	state   *controllerState
+	ch      chan controller.ReconcileEvent
+	done    chan struct{}
+	once    sync.Once
+	cycle   uint64
+	mu      sync.Mutex
+	pending bool
+	stopped bool
+}
+
+// EventCh finishes the current cycle, as the controller is waiting for the next event.
+func (r *trackedRuntime) EventCh() <-chan controller.ReconcileEvent {
+	r.mu.Lock()
+	r.finishCycle()
+
+	// the event which arrived while the controller was busy is picked up right away
+	if r.pending {
+		r.pending = false
+		r.startCycle()
+	}
+
+	r.mu.Unlock()
+
+	r.once.Do(func() {
+		r.ch = make(chan controller.ReconcileEvent)
+
+		go r.forward(r.Runtime.EventCh())
+	})
+
+	return r.ch
+}
+
+func (r *trackedRuntime) forward(in <-chan controller.ReconcileEvent) {
+	for {
+		var event controller.ReconcileEvent
+
+		select {
+		case <-r.done:
+			return
+		case event = <-in:
+		}
+
+		// the cycle is started before the event is handed over, so that the controller can't finish it before it is started
+		r.mu.Lock()
+
+		switch {
+		case r.cycle == 0:
+			// the controller is waiting for the event
+			r.startCycle()
+		case r.pending:
+			// the controller picked up the previous event without waiting for it via EventCh, so the cycle is over
+			r.finishCycle()
+			r.startCycle()
+		default:
+			// the controller is busy, the cycle starts once it waits for the event via EventCh
+			r.pending = true
+		}
+
+		r.mu.Unlock()
+
+		select {
+		case <-r.done:
+			return
+		case r.ch <- event:
+		}
+	}
+}
+
+func (r *trackedRuntime) startCycle() {
+	if !r.stopped {
+		r.cycle = r.state.start()
+	}
+}
+
+func (r *trackedRuntime) finishCycle() {
+	if r.cycle != 0 {
+		r.state.finish(r.cycle, nil)
+		r.cycle = 0
+	}
+}
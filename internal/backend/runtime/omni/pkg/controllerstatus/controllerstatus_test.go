@@ -0,0 +1,212 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package controllerstatus_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/pkg/controllerstatus"
+)
+
+type mockQController struct {
+	controller.QController
+
+	err error
+}
+
+func (ctrl *mockQController) Name() string { return "MockQController" }
+
+func (ctrl *mockQController) Reconcile(context.Context, *zap.Logger, controller.QRuntime, resource.Pointer) error {
+	return ctrl.err
+}
+
+func TestQController(t *testing.T) {
+	tracker := controllerstatus.NewTracker()
+
+	mock := &mockQController{}
+	ctrl := tracker.WrapQController(mock)
+
+	require.Len(t, tracker.List(), 1)
+	assert.Equal(t, controllerstatus.HealthPending, tracker.List()[0].Health)
+
+	require.NoError(t, ctrl.Reconcile(context.Background(), zaptest.NewLogger(t), nil, nil))
+
+	// the requeue without an error is not a failure
+	mock.err = controller.NewRequeueInterval(time.Second)
+
+	require.Error(t, ctrl.Reconcile(context.Background(), zaptest.NewLogger(t), nil, nil))
+
+	status := tracker.List()[0]
+
+	assert.Equal(t, "MockQController", status.Name)
+	assert.Equal(t, controllerstatus.KindQController, status.Kind)
+	assert.Equal(t, controllerstatus.HealthOK, status.Health)
+	assert.EqualValues(t, 2, status.Reconciles)
+	assert.Zero(t, status.Errors)
+	assert.False(t, status.LastSuccess.IsZero())
+	assert.Zero(t, status.Busy)
+
+	mock.err = controller.NewRequeueErrorf(time.Second, "boom")
+
+	require.Error(t, ctrl.Reconcile(context.Background(), zaptest.NewLogger(t), nil, nil))
+
+	status = tracker.List()[0]
+
+	assert.Equal(t, controllerstatus.HealthFailing, status.Health)
+	assert.EqualValues(t, 3, status.Reconciles)
+	assert.EqualValues(t, 1, status.Errors)
+	assert.Equal(t, "boom", status.LastError)
+
+	assert.Equal(t, 4, testutil.CollectAndCount(tracker))
+}
+
+type mockRuntime struct {
+	controller.Runtime
+
+	ch chan controller.ReconcileEvent
+}
+
+func (r *mockRuntime) EventCh() <-chan controller.ReconcileEvent { return r.ch }
+
+type mockController struct {
+	controller.Controller
+
+	reconciled chan error
+	results    chan error
+}
+
+func (ctrl *mockController) Name() string { return "MockController" }
+
+func (ctrl *mockController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		err := <-ctrl.results
+
+		ctrl.reconciled <- err
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func TestController(t *testing.T) {
+	tracker := controllerstatus.NewTracker()
+
+	mock := &mockController{
+		reconciled: make(chan error),
+		results:    make(chan error),
+	}
+
+	ctrl := tracker.WrapController(mock)
+	runtime := &mockRuntime{ch: make(chan controller.ReconcileEvent)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	errCh := make(chan error, 1)
+
+	go func() { errCh <- ctrl.Run(ctx, runtime, zaptest.NewLogger(t)) }()
+
+	runtime.ch <- controller.ReconcileEvent{}
+
+	// the cycle is in progress until the controller waits for the next event
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, 1, tracker.List()[0].Busy)
+	}, time.Second, 10*time.Millisecond)
+
+	mock.results <- nil
+	require.NoError(t, <-mock.reconciled)
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		status := tracker.List()[0]
+
+		assert.Equal(collect, controllerstatus.HealthOK, status.Health)
+		assert.EqualValues(collect, 1, status.Reconciles)
+		assert.Zero(collect, status.Busy)
+	}, time.Second, 10*time.Millisecond)
+
+	runtime.ch <- controller.ReconcileEvent{}
+
+	mock.results <- errors.New("boom")
+	require.Error(t, <-mock.reconciled)
+
+	require.EqualError(t, <-errCh, "boom")
+
+	status := tracker.List()[0]
+
+	assert.Equal(t, "MockController", status.Name)
+	assert.Equal(t, controllerstatus.KindController, status.Kind)
+	assert.Equal(t, controllerstatus.HealthFailing, status.Health)
+	assert.EqualValues(t, 2, status.Reconciles)
+	assert.EqualValues(t, 1, status.Errors)
+	assert.Equal(t, "boom", status.LastError)
+	assert.Zero(t, status.Busy)
+}
+
+func TestControllerEventWhileBusy(t *testing.T) {
+	tracker := controllerstatus.NewTracker()
+
+	mock := &mockController{
+		reconciled: make(chan error),
+		results:    make(chan error),
+	}
+
+	ctrl := tracker.WrapController(mock)
+	runtime := &mockRuntime{ch: make(chan controller.ReconcileEvent)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go ctrl.Run(ctx, runtime, zaptest.NewLogger(t)) //nolint:errcheck
+
+	runtime.ch <- controller.ReconcileEvent{}
+
+	// the event arrives while the controller is busy, it doesn't start another cycle
+	runtime.ch <- controller.ReconcileEvent{}
+
+	status := tracker.List()[0]
+
+	assert.Equal(t, 1, status.Busy)
+	assert.Zero(t, status.Reconciles)
+
+	// the first cycle is over, the controller picks up the next event right away
+	mock.results <- nil
+	require.NoError(t, <-mock.reconciled)
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		status := tracker.List()[0]
+
+		assert.EqualValues(collect, 1, status.Reconciles)
+		assert.Equal(collect, 1, status.Busy)
+	}, time.Second, 10*time.Millisecond)
+
+	mock.results <- nil
+	require.NoError(t, <-mock.reconciled)
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		status := tracker.List()[0]
+
+		assert.EqualValues(collect, 2, status.Reconciles)
+		assert.Zero(collect, status.Busy)
+	}, time.Second, 10*time.Millisecond)
+}
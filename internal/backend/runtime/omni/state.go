@@ -53,7 +53,7 @@ func NewState(ctx context.Context, params *config.Params, logger *zap.Logger, me
 		defer closer()
 
 		measuredState := stateWithMetrics(namespacedState, metricsRegistry)
-		resourceState := state.WrapCore(measuredState)
+		resourceState := state.WrapCore(wrapStateWithTracing(measuredState))
 
 		if err = initResources(ctx, resourceState, logger); err != nil {
 			return err
@@ -241,12 +241,12 @@ func (w *AuditWrap) Wrap(handler http.Handler) http.Handler {
 }
 
 // AuditTalosAccess logs a Talos access event. It does nothing if the audit log is disabled.
-func (w *AuditWrap) AuditTalosAccess(ctx context.Context, fullMethodName, clusterID, nodeID string) error {
+func (w *AuditWrap) AuditTalosAccess(ctx context.Context, fullMethodName, clusterID, nodeID, denyReason string) error {
 	if w.log == nil {
 		return nil
 	}
 
-	return w.log.AuditTalosAccess(ctx, fullMethodName, clusterID, nodeID)
+	return w.log.AuditTalosAccess(ctx, fullMethodName, clusterID, nodeID, denyReason)
 }
 
 // WrapState wraps the state with audit logging. It does nothing if the audit log is disabled.
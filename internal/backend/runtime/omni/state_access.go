@@ -57,6 +57,7 @@ var (
 	// clusterLabelTypeSet is the set of resource types which have the related cluster's ID as a label.
 	clusterLabelTypeSet = xslices.ToSet([]resource.Type{
 		omni.ClusterMachineConfigType,
+		omni.ClusterMachineConfigRevisionType,
 		omni.ClusterMachineConfigStatusType,
 		omni.ClusterMachineIdentityType,
 		omni.ClusterMachineType,
@@ -65,6 +66,7 @@ var (
 		omni.ClusterMachineTalosVersionType,
 		omni.ClusterMachineTemplateType,
 		omni.ConfigPatchType,
+		omni.ConfigPatchRevisionType,
 		omni.ExposedServiceType,
 		omni.ImagePullRequestType,
 		omni.ImagePullStatusType,
@@ -77,6 +79,8 @@ var (
 		omni.MachineExtensionsStatusType,
 		omni.MachineExtensionsType,
 		omni.ExtensionsConfigurationStatusType,
+		omni.AddonStatusType,
+		authres.IssuedKubeconfigType,
 	})
 
 	// userManagedResourceTypeSet is the set of resource types that are managed by the user.
@@ -407,12 +411,20 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.MachineSetStatusType,
 		omni.TalosUpgradeStatusType,
 		omni.RedactedClusterMachineConfigType,
+		omni.ClusterMachineConfigRevisionType,
+		omni.ConfigPatchRevisionType,
+		omni.ChangeRequestType,
+		omni.AddonType,
+		omni.AddonStatusType,
+		authres.IssuedKubeconfigType,
 		siderolink.LinkType,
 		omni.MachineClassType,
 		omni.MachineExtensionsStatusType,
 		omni.MachineExtensionsType,
 		omni.MachineStatusType,
 		omni.MachineStatusSnapshotType,
+		omni.MachineResourceUsageType,
+		omni.MachineHealthStatusType,
 		omni.MachineStatusLinkType,
 		omni.MachineConfigGenOptionsType,
 		omni.SchematicType,
@@ -440,7 +452,7 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		virtual.PermissionsType:
 		// allow access with just valid signature
 		_, err = auth.CheckGRPC(ctx, auth.WithValidSignature(true))
-	case authres.IdentityType, authres.UserType, authres.SAMLLabelRuleType, authres.AccessPolicyType, omni.EtcdBackupS3ConfType:
+	case authres.IdentityType, authres.UserType, authres.SAMLLabelRuleType, authres.AccessPolicyType, omni.AdmissionPolicyType, omni.AdmissionPolicyStatusType, omni.EtcdBackupS3ConfType:
 		var checkResult auth.CheckResult
 		// user management access
 		checkResult, err = auth.CheckGRPC(ctx, auth.WithRole(role.Admin))
@@ -502,6 +514,13 @@ func filterAccessByType(access state.Access) error {
 			return nil
 		}
 
+		return status.Error(codes.PermissionDenied, "only read, update and delete access is permitted")
+	case omni.ChangeRequestType:
+		// the change requests are created by Omni, the users review them by updating and withdraw them by destroying
+		if access.Verb.Readonly() || access.Verb == state.Update || access.Verb == state.Destroy {
+			return nil
+		}
+
 		return status.Error(codes.PermissionDenied, "only read, update and delete access is permitted")
 	case
 		infra.MachineRequestType,       // read-only for all except for InfraProvider role (checked in filterAccess)
@@ -550,6 +569,8 @@ func filterAccessByType(access state.Access) error {
 		omni.MachineStatusType,
 		omni.MachineStatusLinkType,
 		omni.MachineStatusSnapshotType,
+		omni.MachineResourceUsageType,
+		omni.MachineHealthStatusType,
 		omni.KubernetesVersionType,
 		omni.TalosExtensionsType,
 		omni.TalosVersionType,
@@ -557,6 +578,11 @@ func filterAccessByType(access state.Access) error {
 		omni.InstallationMediaType,
 		omni.OngoingTaskType,
 		omni.RedactedClusterMachineConfigType,
+		omni.ClusterMachineConfigRevisionType,
+		omni.ConfigPatchRevisionType,
+		omni.AddonStatusType,
+		omni.AdmissionPolicyStatusType,
+		authres.IssuedKubeconfigType,
 		omni.SchematicType,
 		omni.SchematicConfigurationType,
 		omni.ExtensionsConfigurationStatusType,
@@ -0,0 +1,342 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/admission"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// admissionPolicies evaluates the user-defined admission policies, the compiled policies are cached by their versions.
+type admissionPolicies struct {
+	st            state.State
+	logger        *zap.Logger
+	configPreview *omnictrl.ClusterMachineConfigPreview
+	compiled      map[resource.ID]compiledAdmissionPolicy
+	mu            sync.Mutex
+}
+
+type compiledAdmissionPolicy struct {
+	policy  *admission.Policy
+	version resource.Version
+}
+
+func newAdmissionPolicies(st state.State, configPreview *omnictrl.ClusterMachineConfigPreview, logger *zap.Logger) *admissionPolicies {
+	return &admissionPolicies{
+		st:            st,
+		logger:        logger,
+		configPreview: configPreview,
+		compiled:      map[resource.ID]compiledAdmissionPolicy{},
+	}
+}
+
+func (p *admissionPolicies) list(ctx context.Context) ([]*admission.Policy, error) {
+	list, err := safe.StateListAll[*omni.AdmissionPolicy](ctx, p.st)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	compiled := make(map[resource.ID]compiledAdmissionPolicy, list.Len())
+	policies := make([]*admission.Policy, 0, list.Len())
+
+	for res := range list.All() {
+		cached, ok := p.compiled[res.Metadata().ID()]
+
+		if !ok || !cached.version.Equal(res.Metadata().Version()) {
+			policy, err := admission.Compile(res)
+			if err != nil {
+				// the policies are validated on write, so this only happens if the resource types are gone after the upgrade,
+				// the broken policy is skipped so that it doesn't block all writes, it is reported in the AdmissionPolicyStatus
+				p.logger.Warn("skipping invalid admission policy", zap.String("policy", res.Metadata().ID()), zap.Error(err))
+			}
+
+			cached = compiledAdmissionPolicy{
+				policy:  policy,
+				version: res.Metadata().Version(),
+			}
+		}
+
+		compiled[res.Metadata().ID()] = cached
+
+		if cached.policy != nil {
+			policies = append(policies, cached.policy)
+		}
+	}
+
+	p.compiled = compiled
+
+	return policies, nil
+}
+
+// writeOperation returns the admission operation of the write, the writes which don't change the resource are skipped.
+//
+// The teardown is the destroy operation, the following writes to the resource being torn down are skipped.
+func writeOperation(res, existing resource.Resource) (string, bool) {
+	if existing == nil {
+		return admission.OperationCreate, true
+	}
+
+	if existing.Metadata().Phase() == resource.PhaseTearingDown {
+		return "", false
+	}
+
+	if res.Metadata().Phase() == resource.PhaseTearingDown {
+		return admission.OperationDestroy, true
+	}
+
+	if existing.Metadata().Labels().Equal(*res.Metadata().Labels()) && specEqual(existing, res) {
+		return "", false
+	}
+
+	return admission.OperationUpdate, true
+}
+
+// admissionResult is the result of the policies evaluation.
+type admissionResult struct {
+	violations   []*admission.Violation
+	approverRole role.Role
+}
+
+// evaluate evaluates the policies with the given action against the write.
+//
+// The writes made by Omni itself are not subject to the admission policies.
+func (p *admissionPolicies) evaluate(ctx context.Context, res, existing resource.Resource, operation string, requireApproval bool) (admissionResult, error) {
+	var result admissionResult
+
+	if actor.ContextIsInternalActor(ctx) || res.Metadata().Type() == omni.AdmissionPolicyType {
+		return result, nil
+	}
+
+	policies, err := p.list(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	latestKubernetesVersion := sync.OnceValues(func() (string, error) {
+		return latestKubernetesVersion(ctx, p.st)
+	})
+
+	var machineConfigs func() ([][]byte, error)
+
+	if patch, ok := res.(*omni.ConfigPatch); ok {
+		machineConfigs = sync.OnceValues(func() ([][]byte, error) {
+			return p.configPatchMachineConfigs(ctx, patch, operation == admission.OperationDestroy)
+		})
+	}
+
+	for _, policy := range policies {
+		if policy.RequiresApproval() != requireApproval || !policy.AppliesTo(res.Metadata().Type(), operation) {
+			continue
+		}
+
+		err = policy.Evaluate(admission.Input{
+			Resource:                res,
+			Existing:                existing,
+			Operation:               operation,
+			LatestKubernetesVersion: latestKubernetesVersion,
+			MachineConfigs:          machineConfigs,
+		})
+		if err == nil {
+			continue
+		}
+
+		var violation *admission.Violation
+
+		if !errors.As(err, &violation) {
+			return result, err
+		}
+
+		result.violations = append(result.violations, violation)
+
+		if result.approverRole == "" || policy.ApproverRole().Check(result.approverRole) == nil {
+			result.approverRole = policy.ApproverRole()
+		}
+	}
+
+	return result, nil
+}
+
+// deny evaluates the policies denying the writes.
+func (p *admissionPolicies) deny(ctx context.Context, res, existing resource.Resource, operation string) error {
+	result, err := p.evaluate(ctx, res, existing, operation, false)
+	if err != nil {
+		return err
+	}
+
+	var denials error
+
+	for _, violation := range result.violations {
+		denials = multierror.Append(denials, violation)
+	}
+
+	return denials
+}
+
+func specEqual(a, b resource.Resource) bool {
+	aSpec, aOk := a.Spec().(interface{ GetValue() proto.Message })
+	bSpec, bOk := b.Spec().(interface{ GetValue() proto.Message })
+
+	if !aOk || !bOk {
+		return false
+	}
+
+	return proto.Equal(aSpec.GetValue(), bSpec.GetValue())
+}
+
+func latestKubernetesVersion(ctx context.Context, st state.State) (string, error) {
+	versions, err := safe.StateListAll[*omni.KubernetesVersion](ctx, st)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		latest       semver.Version
+		latestString string
+	)
+
+	for version := range versions.All() {
+		parsed, err := semver.ParseTolerant(version.TypedSpec().Value.Version)
+		if err != nil {
+			continue
+		}
+
+		if latestString == "" || parsed.GT(latest) {
+			latest = parsed
+			latestString = version.TypedSpec().Value.Version
+		}
+	}
+
+	return latestString, nil
+}
+
+// configPatchMachineConfigs generates the machine configs of the cluster machines targeted by the config patch as they are after the write,
+// i.e. with the config patch created or updated, or without it if it is destroyed.
+//
+// The machines which don't have all config inputs yet are skipped.
+func (p *admissionPolicies) configPatchMachineConfigs(ctx context.Context, patch *omni.ConfigPatch, destroy bool) ([][]byte, error) {
+	var clusterMachines []*omni.ClusterMachine
+
+	labels := patch.Metadata().Labels()
+
+	clusterMachineID, ok := labels.Get(omni.LabelClusterMachine)
+	if !ok {
+		clusterMachineID, ok = labels.Get(omni.LabelMachine)
+	}
+
+	if ok {
+		clusterMachine, err := safe.StateGetByID[*omni.ClusterMachine](ctx, p.st, clusterMachineID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if clusterMachine != nil {
+			clusterMachines = append(clusterMachines, clusterMachine)
+		}
+	} else {
+		var query resource.LabelQueryOption
+
+		if machineSet, ok := labels.Get(omni.LabelMachineSet); ok {
+			query = resource.LabelEqual(omni.LabelMachineSet, machineSet)
+		} else if cluster, ok := labels.Get(omni.LabelCluster); ok {
+			query = resource.LabelEqual(omni.LabelCluster, cluster)
+		} else {
+			return nil, nil
+		}
+
+		list, err := safe.StateListAll[*omni.ClusterMachine](ctx, p.st, state.WithLabelQuery(query))
+		if err != nil {
+			return nil, err
+		}
+
+		clusterMachines = slices.Collect(list.All())
+	}
+
+	if len(clusterMachines) == 0 {
+		return nil, nil
+	}
+
+	return p.configPreview.Generate(ctx, p.st, clusterMachines, patch, destroy)
+}
+
+// admissionPolicyValidationOptions returns the validation options evaluating the user-defined admission policies denying the resource writes,
+// and validating the admission policies themselves.
+func admissionPolicyValidationOptions(st state.State, configPreview *omnictrl.ClusterMachineConfigPreview, logger *zap.Logger) []validated.StateOption {
+	policies := newAdmissionPolicies(st, configPreview, logger)
+
+	validatePolicy := func(res *omni.AdmissionPolicy) error {
+		if res.Metadata().Namespace() != resources.DefaultNamespace {
+			return fmt.Errorf("admission policies must be in the %q namespace", resources.DefaultNamespace)
+		}
+
+		_, err := admission.Compile(res)
+
+		return err
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(
+			validated.NewCreateValidationForType(func(_ context.Context, res *omni.AdmissionPolicy, _ ...state.CreateOption) error {
+				return validatePolicy(res)
+			}),
+			func(ctx context.Context, res resource.Resource, _ ...state.CreateOption) error {
+				return policies.deny(ctx, res, nil, admission.OperationCreate)
+			},
+		),
+		validated.WithUpdateValidations(
+			validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.AdmissionPolicy, newRes *omni.AdmissionPolicy, _ ...state.UpdateOption) error {
+				if newRes.Metadata().Phase() == resource.PhaseTearingDown {
+					return nil
+				}
+
+				return validatePolicy(newRes)
+			}),
+			func(ctx context.Context, existingRes resource.Resource, newRes resource.Resource, _ ...state.UpdateOption) error {
+				if existingRes == nil {
+					return nil
+				}
+
+				operation, ok := writeOperation(newRes, existingRes)
+				if !ok {
+					return nil
+				}
+
+				return policies.deny(ctx, newRes, existingRes, operation)
+			},
+		),
+		validated.WithDestroyValidations(
+			func(ctx context.Context, _ resource.Pointer, existingRes resource.Resource, _ ...state.DestroyOption) error {
+				// the resources being torn down were already admitted on the teardown
+				if existingRes == nil || existingRes.Metadata().Phase() == resource.PhaseTearingDown {
+					return nil
+				}
+
+				return policies.deny(ctx, existingRes, existingRes, admission.OperationDestroy)
+			},
+		),
+	}
+}
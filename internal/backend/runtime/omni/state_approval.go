@@ -0,0 +1,437 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/google/uuid"
+	"github.com/siderolabs/gen/channel"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/admission"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+// approvalState wraps COSI core state and holds the writes matching the admission policies requiring the approval as change requests.
+//
+// The change requests are applied by the ChangeRequestController once they are approved by another user.
+// The writes are held only for the authenticated users, the writes made by Omni itself are never held.
+//
+// The requested resource is stripped from the change requests read by the users who can't read the resource itself.
+type approvalState struct {
+	st       state.CoreState
+	policies *admissionPolicies
+	logger   *zap.Logger
+}
+
+// Check interfaces.
+var _ state.CoreState = &approvalState{}
+
+func newApprovalState(st state.CoreState, configPreview *omnictrl.ClusterMachineConfigPreview, logger *zap.Logger) *approvalState {
+	return &approvalState{
+		st:       st,
+		policies: newAdmissionPolicies(state.WrapCore(st), configPreview, logger),
+		logger:   logger,
+	}
+}
+
+func requestIdentity(ctx context.Context) string {
+	if actor.ContextIsInternalActor(ctx) {
+		return ""
+	}
+
+	identity, _ := ctxstore.Value[auth.IdentityContextKey](ctx)
+
+	return identity.Identity
+}
+
+// hold creates the change request if the write requires the approval.
+func (st *approvalState) hold(ctx context.Context, res, existing resource.Resource, operation string) error {
+	identity := requestIdentity(ctx)
+	if identity == "" || res.Metadata().Type() == omni.ChangeRequestType {
+		return nil
+	}
+
+	result, err := st.policies.evaluate(ctx, res, existing, operation, true)
+	if err != nil {
+		return err
+	}
+
+	if len(result.violations) == 0 {
+		return nil
+	}
+
+	data, err := omni.EncodeChangeRequestResource(res)
+	if err != nil {
+		return err
+	}
+
+	request := omni.NewChangeRequest(resources.DefaultNamespace, uuid.NewString())
+
+	request.TypedSpec().Value.Operation = operation
+	request.TypedSpec().Value.ResourceNamespace = res.Metadata().Namespace()
+	request.TypedSpec().Value.ResourceType = res.Metadata().Type()
+	request.TypedSpec().Value.ResourceId = res.Metadata().ID()
+	request.TypedSpec().Value.Resource = data
+	request.TypedSpec().Value.RequestedBy = identity
+	request.TypedSpec().Value.RequestedAt = timestamppb.Now()
+	request.TypedSpec().Value.ApproverRole = string(result.approverRole)
+	request.TypedSpec().Value.Phase = specs.ChangeRequestSpec_PENDING
+
+	if existing != nil {
+		request.TypedSpec().Value.ResourceVersion = existing.Metadata().Version().String()
+	}
+
+	for _, violation := range result.violations {
+		request.TypedSpec().Value.Reasons = append(request.TypedSpec().Value.Reasons, violation.Messages...)
+	}
+
+	if err = st.st.Create(actor.MarkContextAsInternalActor(ctx), request); err != nil {
+		return err
+	}
+
+	return status.Errorf(codes.FailedPrecondition, "%s of %s %q is held as change request %q pending approval: %s",
+		operation, res.Metadata().Type(), res.Metadata().ID(), request.Metadata().ID(), strings.Join(request.TypedSpec().Value.Reasons, "; "))
+}
+
+// redact strips the requested resource from the change request if the caller isn't allowed to read it.
+//
+// The change requests are readable by all users, while the requested resources might be readable only by the admins, e.g. the identities or the S3 configs.
+func (st *approvalState) redact(ctx context.Context, res resource.Resource) resource.Resource { //nolint:ireturn
+	request, ok := res.(*omni.ChangeRequest)
+	if !ok || len(request.TypedSpec().Value.Resource) == 0 || actor.ContextIsInternalActor(ctx) {
+		return res
+	}
+
+	spec := request.TypedSpec().Value
+
+	if checkForRole(ctx, state.WrapCore(st.st), state.Access{
+		ResourceNamespace: spec.ResourceNamespace,
+		ResourceType:      spec.ResourceType,
+		ResourceID:        spec.ResourceId,
+		Verb:              state.Get,
+	}, changeRequestClusterID(request), false) == nil {
+		return res
+	}
+
+	redacted := request.DeepCopy().(*omni.ChangeRequest) //nolint:forcetypeassert,errcheck
+
+	redacted.TypedSpec().Value.Resource = nil
+
+	return redacted
+}
+
+// changeRequestClusterID returns the ID of the cluster the requested resource belongs to, if any.
+func changeRequestClusterID(request *omni.ChangeRequest) resource.ID {
+	spec := request.TypedSpec().Value
+
+	if requested, err := omni.DecodeChangeRequestResource(spec.Resource); err == nil {
+		return clusterIDFromMetadata(requested.Metadata())
+	}
+
+	return clusterIDFromPointer(resource.NewMetadata(spec.ResourceNamespace, spec.ResourceType, spec.ResourceId, resource.VersionUndefined))
+}
+
+func (st *approvalState) redactEvent(ctx context.Context, event state.Event) state.Event {
+	if event.Resource != nil {
+		event.Resource = st.redact(ctx, event.Resource)
+	}
+
+	if event.Old != nil {
+		event.Old = st.redact(ctx, event.Old)
+	}
+
+	return event
+}
+
+func (st *approvalState) redactEvents(ctx context.Context, eventCh chan<- state.Event) chan state.Event {
+	innerEventCh := make(chan state.Event)
+
+	panichandler.Go(func() {
+		defer close(eventCh)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event, ok := <-innerEventCh:
+				if !ok {
+					return
+				}
+
+				channel.SendWithContext(ctx, eventCh, st.redactEvent(ctx, event))
+			}
+		}
+	}, st.logger)
+
+	return innerEventCh
+}
+
+func (st *approvalState) redactEventsAggregated(ctx context.Context, eventsCh chan<- []state.Event) chan []state.Event {
+	innerEventsCh := make(chan []state.Event)
+
+	panichandler.Go(func() {
+		defer close(eventsCh)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case events, ok := <-innerEventsCh:
+				if !ok {
+					return
+				}
+
+				redactedEvents := make([]state.Event, 0, len(events))
+
+				for _, event := range events {
+					redactedEvents = append(redactedEvents, st.redactEvent(ctx, event))
+				}
+
+				channel.SendWithContext(ctx, eventsCh, redactedEvents)
+			}
+		}
+	}, st.logger)
+
+	return innerEventsCh
+}
+
+func redactsType(ctx context.Context, resourceType resource.Type) bool {
+	return resourceType == omni.ChangeRequestType && !actor.ContextIsInternalActor(ctx)
+}
+
+// Get implements state.CoreState.
+func (st *approvalState) Get(ctx context.Context, ptr resource.Pointer, opts ...state.GetOption) (resource.Resource, error) {
+	res, err := st.st.Get(ctx, ptr, opts...)
+	if err != nil || !redactsType(ctx, ptr.Type()) {
+		return res, err
+	}
+
+	return st.redact(ctx, res), nil
+}
+
+// List implements state.CoreState.
+func (st *approvalState) List(ctx context.Context, kind resource.Kind, opts ...state.ListOption) (resource.List, error) {
+	list, err := st.st.List(ctx, kind, opts...)
+	if err != nil || !redactsType(ctx, kind.Type()) {
+		return list, err
+	}
+
+	for i, res := range list.Items {
+		list.Items[i] = st.redact(ctx, res)
+	}
+
+	return list, nil
+}
+
+// Create implements state.CoreState.
+func (st *approvalState) Create(ctx context.Context, res resource.Resource, opts ...state.CreateOption) error {
+	if err := st.hold(ctx, res, nil, omni.ChangeRequestOperationCreate); err != nil {
+		return err
+	}
+
+	return st.st.Create(ctx, res, opts...)
+}
+
+// Update implements state.CoreState.
+func (st *approvalState) Update(ctx context.Context, res resource.Resource, opts ...state.UpdateOption) error {
+	if request, ok := res.(*omni.ChangeRequest); ok && redactsType(ctx, omni.ChangeRequestType) && len(request.TypedSpec().Value.Resource) == 0 {
+		// the requested resource might be stripped on read, the reviews keep it as is
+		stored, err := st.st.Get(ctx, res.Metadata())
+		if err != nil {
+			return err
+		}
+
+		storedRequest, ok := stored.(*omni.ChangeRequest)
+		if !ok {
+			return fmt.Errorf("unexpected resource type %T", stored)
+		}
+
+		request = request.DeepCopy().(*omni.ChangeRequest) //nolint:forcetypeassert,errcheck
+		request.TypedSpec().Value.Resource = storedRequest.TypedSpec().Value.Resource
+
+		res = request
+	}
+
+	if requestIdentity(ctx) != "" {
+		existing, err := st.st.Get(ctx, res.Metadata())
+		if err != nil {
+			return err
+		}
+
+		if operation, ok := writeOperation(res, existing); ok {
+			if err = st.hold(ctx, res, existing, operation); err != nil {
+				return err
+			}
+		}
+	}
+
+	return st.st.Update(ctx, res, opts...)
+}
+
+// Destroy implements state.CoreState.
+func (st *approvalState) Destroy(ctx context.Context, ptr resource.Pointer, opts ...state.DestroyOption) error {
+	if requestIdentity(ctx) != "" {
+		existing, err := st.st.Get(ctx, ptr)
+		if err != nil {
+			return err
+		}
+
+		// the resources being torn down were already admitted on the teardown
+		if existing.Metadata().Phase() == resource.PhaseRunning {
+			if err = st.hold(ctx, existing, existing, omni.ChangeRequestOperationDestroy); err != nil {
+				return err
+			}
+		}
+	}
+
+	return st.st.Destroy(ctx, ptr, opts...)
+}
+
+// Watch implements state.CoreState.
+func (st *approvalState) Watch(ctx context.Context, ptr resource.Pointer, ch chan<- state.Event, opts ...state.WatchOption) error {
+	if !redactsType(ctx, ptr.Type()) {
+		return st.st.Watch(ctx, ptr, ch, opts...)
+	}
+
+	return st.st.Watch(ctx, ptr, st.redactEvents(ctx, ch), opts...)
+}
+
+// WatchKind implements state.CoreState.
+func (st *approvalState) WatchKind(ctx context.Context, kind resource.Kind, ch chan<- state.Event, opts ...state.WatchKindOption) error {
+	if !redactsType(ctx, kind.Type()) {
+		return st.st.WatchKind(ctx, kind, ch, opts...)
+	}
+
+	return st.st.WatchKind(ctx, kind, st.redactEvents(ctx, ch), opts...)
+}
+
+// WatchKindAggregated implements state.CoreState.
+func (st *approvalState) WatchKindAggregated(ctx context.Context, kind resource.Kind, ch chan<- []state.Event, opts ...state.WatchKindOption) error {
+	if !redactsType(ctx, kind.Type()) {
+		return st.st.WatchKindAggregated(ctx, kind, ch, opts...)
+	}
+
+	return st.st.WatchKindAggregated(ctx, kind, st.redactEventsAggregated(ctx, ch), opts...)
+}
+
+// changeRequestValidationOptions returns the validation options for the change requests.
+//
+// The change requests are created only by Omni, the users can only approve or reject the pending ones.
+// The approval requires a user other than the requester having the approver role, the cluster-scoped roles of the access policy are taken into account.
+//
+//nolint:gocognit,cyclop
+func changeRequestValidationOptions(st state.State) []validated.StateOption {
+	reviewer := func(ctx context.Context, request *omni.ChangeRequest) (string, role.Role, error) {
+		identity := requestIdentity(ctx)
+		if identity == "" {
+			return "", role.None, errors.New("change requests can be reviewed only by the authenticated users")
+		}
+
+		if clusterID := changeRequestClusterID(request); clusterID != "" {
+			callerRole, _, err := accesspolicy.RoleForCluster(ctx, clusterID, st)
+
+			return identity, callerRole, err
+		}
+
+		callerRole, _ := ctxstore.Value[auth.RoleContextKey](ctx)
+
+		return identity, callerRole.Role, nil
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, _ *omni.ChangeRequest, _ ...state.CreateOption) error {
+			if actor.ContextIsInternalActor(ctx) {
+				return nil
+			}
+
+			return errors.New("change requests are created only by Omni")
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, existingRes, newRes *omni.ChangeRequest, _ ...state.UpdateOption) error {
+			if actor.ContextIsInternalActor(ctx) || existingRes == nil || newRes.Metadata().Phase() == resource.PhaseTearingDown {
+				return nil
+			}
+
+			existing, updated := existingRes.TypedSpec().Value, newRes.TypedSpec().Value
+
+			if existing.Phase != specs.ChangeRequestSpec_PENDING {
+				return fmt.Errorf("change request is already %s", strings.ToLower(existing.Phase.String()))
+			}
+
+			if updated.Phase != specs.ChangeRequestSpec_APPROVED && updated.Phase != specs.ChangeRequestSpec_REJECTED {
+				return errors.New("change request can only be approved or rejected")
+			}
+
+			expected := existing.CloneVT()
+			expected.Phase = updated.Phase
+			expected.ReviewedBy = updated.ReviewedBy
+			expected.ReviewedAt = updated.ReviewedAt
+
+			if !expected.EqualVT(updated) {
+				return errors.New("change request can not be modified")
+			}
+
+			identity, callerRole, err := reviewer(ctx, existingRes)
+			if err != nil {
+				return err
+			}
+
+			if updated.ReviewedBy != identity {
+				return fmt.Errorf("reviewer must be the current user %q", identity)
+			}
+
+			isApprover := false
+
+			// the approvers are also required to be allowed to make the write themselves
+			if approverRole, parseErr := role.Parse(existing.ApproverRole); parseErr == nil {
+				isApprover = callerRole.Check(approverRole) == nil && callerRole.Check(admission.WriteRole(existing.ResourceType)) == nil
+			}
+
+			switch {
+			case updated.Phase == specs.ChangeRequestSpec_REJECTED && (identity == existing.RequestedBy || isApprover):
+				return nil
+			case identity == existing.RequestedBy:
+				return errors.New("change request can not be approved by the requester")
+			case !isApprover:
+				return fmt.Errorf("change request can be reviewed only by the users with the %q role", existing.ApproverRole)
+			}
+
+			return nil
+		})),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(func(ctx context.Context, _ resource.Pointer, res *omni.ChangeRequest, _ ...state.DestroyOption) error {
+			if actor.ContextIsInternalActor(ctx) || res == nil || res.TypedSpec().Value.Phase != specs.ChangeRequestSpec_PENDING {
+				return nil
+			}
+
+			if requestIdentity(ctx) != res.TypedSpec().Value.RequestedBy {
+				return errors.New("pending change request can be withdrawn only by the requester")
+			}
+
+			return nil
+		})),
+	}
+}
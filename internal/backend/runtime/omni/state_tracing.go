@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/siderolabs/omni/internal/pkg/tracing"
+)
+
+// stateTracing wraps COSI core state and records the resource operations as spans.
+//
+// The spans are recorded only as children of an already recorded span, so the controllers
+// operating on the state in the background don't produce the root spans for every operation.
+type stateTracing struct {
+	st     state.CoreState
+	tracer trace.Tracer
+}
+
+// Check interfaces.
+var _ state.CoreState = &stateTracing{}
+
+func wrapStateWithTracing(st state.CoreState) *stateTracing {
+	return &stateTracing{
+		st:     st,
+		tracer: tracing.Tracer(),
+	}
+}
+
+func (tracer *stateTracing) start(ctx context.Context, operation string, kind resource.Kind) (context.Context, trace.Span) {
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		return ctx, nil
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("omni.resource.namespace", kind.Namespace()),
+		attribute.String("omni.resource.type", kind.Type()),
+	}
+
+	if ptr, ok := kind.(resource.Pointer); ok {
+		attrs = append(attrs, attribute.String("omni.resource.id", ptr.ID()))
+	}
+
+	return tracer.tracer.Start(ctx, "state."+operation, trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(attrs...))
+}
+
+func endSpan(span trace.Span, err error) {
+	if span == nil {
+		return
+	}
+
+	if err != nil && !state.IsNotFoundError(err) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+func (tracer *stateTracing) Get(ctx context.Context, r resource.Pointer, opts ...state.GetOption) (resource.Resource, error) {
+	ctx, span := tracer.start(ctx, "Get", r)
+
+	result, err := tracer.st.Get(ctx, r, opts...)
+
+	endSpan(span, err)
+
+	return result, err
+}
+
+func (tracer *stateTracing) List(ctx context.Context, r resource.Kind, opts ...state.ListOption) (resource.List, error) {
+	ctx, span := tracer.start(ctx, "List", r)
+
+	result, err := tracer.st.List(ctx, r, opts...)
+
+	if span != nil {
+		span.SetAttributes(attribute.Int("omni.resource.count", len(result.Items)))
+	}
+
+	endSpan(span, err)
+
+	return result, err
+}
+
+func (tracer *stateTracing) Create(ctx context.Context, r resource.Resource, opts ...state.CreateOption) error {
+	ctx, span := tracer.start(ctx, "Create", r.Metadata())
+
+	err := tracer.st.Create(ctx, r, opts...)
+
+	endSpan(span, err)
+
+	return err
+}
+
+func (tracer *stateTracing) Update(ctx context.Context, newResource resource.Resource, opts ...state.UpdateOption) error {
+	ctx, span := tracer.start(ctx, "Update", newResource.Metadata())
+
+	err := tracer.st.Update(ctx, newResource, opts...)
+
+	endSpan(span, err)
+
+	return err
+}
+
+func (tracer *stateTracing) Destroy(ctx context.Context, r resource.Pointer, opts ...state.DestroyOption) error {
+	ctx, span := tracer.start(ctx, "Destroy", r)
+
+	err := tracer.st.Destroy(ctx, r, opts...)
+
+	endSpan(span, err)
+
+	return err
+}
+
+// Watch doesn't record a span, as the watches are long-lived.
+func (tracer *stateTracing) Watch(ctx context.Context, r resource.Pointer, ch chan<- state.Event, opts ...state.WatchOption) error {
+	return tracer.st.Watch(ctx, r, ch, opts...)
+}
+
+func (tracer *stateTracing) WatchKind(ctx context.Context, r resource.Kind, ch chan<- state.Event, opts ...state.WatchKindOption) error {
+	return tracer.st.WatchKind(ctx, r, ch, opts...)
+}
+
+func (tracer *stateTracing) WatchKindAggregated(ctx context.Context, r resource.Kind, c chan<- []state.Event, opts ...state.WatchKindOption) error {
+	return tracer.st.WatchKindAggregated(ctx, r, c, opts...)
+}
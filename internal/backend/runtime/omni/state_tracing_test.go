@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+)
+
+func TestStateTracing(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := provider.Tracer("test")
+
+	st := state.WrapCore(omni.WrapStateWithTracing(namespaced.NewState(inmem.Build), tracer))
+
+	// no spans are recorded outside of a trace
+	require.NoError(t, st.Create(ctx, omnires.NewCluster(resources.DefaultNamespace, "untraced")))
+	assert.Empty(t, recorder.Ended())
+
+	tracedCtx, span := tracer.Start(ctx, "request")
+
+	require.NoError(t, st.Create(tracedCtx, omnires.NewCluster(resources.DefaultNamespace, "traced")))
+
+	_, err := st.Get(tracedCtx, omnires.NewCluster(resources.DefaultNamespace, "missing").Metadata())
+	require.True(t, state.IsNotFoundError(err))
+
+	list, err := st.List(tracedCtx, omnires.NewCluster(resources.DefaultNamespace, "").Metadata())
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 4)
+
+	names := make([]string, 0, len(spans))
+
+	for _, s := range spans[:3] {
+		names = append(names, s.Name())
+
+		assert.Equal(t, span.SpanContext().SpanID(), s.Parent().SpanID())
+		assert.Contains(t, s.Attributes(), attribute.String("omni.resource.type", omnires.ClusterType))
+	}
+
+	assert.Equal(t, []string{"state.Create", "state.Get", "state.List"}, names)
+	assert.Contains(t, spans[0].Attributes(), attribute.String("omni.resource.id", "traced"))
+	assert.Contains(t, spans[2].Attributes(), attribute.Int("omni.resource.count", 2))
+}
@@ -10,9 +10,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/mail"
+	"net/netip"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 	"unicode"
@@ -34,17 +37,21 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/addon"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/machineexpr"
 )
 
 // clusterValidationOptions returns the validation options for the Talos and Kubernetes versions on the cluster resource.
 // Validation is only syntactic - they are checked whether they are valid semver strings.
 //
 //nolint:gocognit,gocyclo,cyclop
-func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackupParams, embeddedDiscoveryServiceConfig config.EmbeddedDiscoveryServiceParams) []validated.StateOption {
+func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackupParams, embeddedDiscoveryServiceConfig config.EmbeddedDiscoveryServiceParams,
+	loadBalancerConfig config.LoadBalancerParams,
+) []validated.StateOption {
 	validateVersions := func(ctx context.Context, existingRes *omni.Cluster, res *omni.Cluster, skipTalosVersion, skipKubernetesVersion bool) error {
 		if skipTalosVersion && skipKubernetesVersion {
 			return nil
@@ -142,6 +149,55 @@ func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackup
 		return nil
 	}
 
+	validateLoadBalancer := func(ctx context.Context, res *omni.Cluster) error {
+		lbConfig := res.TypedSpec().Value.GetLoadBalancer()
+
+		if lbConfig.GetSni() && !loadBalancerConfig.SNIEnabled() {
+			return errors.New("load balancer SNI multiplexing is not enabled")
+		}
+
+		if bindAddress := lbConfig.GetBindAddress(); bindAddress != "" {
+			if _, err := netip.ParseAddr(bindAddress); err != nil {
+				return fmt.Errorf("invalid load balancer bind address %q: %w", bindAddress, err)
+			}
+		}
+
+		bindPort := lbConfig.GetBindPort()
+		if bindPort == 0 {
+			return nil
+		}
+
+		if bindPort > math.MaxUint16 {
+			return fmt.Errorf("invalid load balancer bind port %d", bindPort)
+		}
+
+		port := strconv.FormatUint(uint64(bindPort), 10)
+
+		clusters, err := safe.StateListAll[*omni.Cluster](ctx, st)
+		if err != nil {
+			return err
+		}
+
+		for cluster := range clusters.All() {
+			if cluster.Metadata().ID() != res.Metadata().ID() && cluster.TypedSpec().Value.GetLoadBalancer().GetBindPort() == bindPort {
+				return fmt.Errorf("load balancer port %s is already requested by the cluster %q", port, cluster.Metadata().ID())
+			}
+		}
+
+		lbConfigs, err := safe.StateListAll[*omni.LoadBalancerConfig](ctx, st)
+		if err != nil {
+			return err
+		}
+
+		for cfg := range lbConfigs.All() {
+			if cfg.Metadata().ID() != res.Metadata().ID() && cfg.TypedSpec().Value.BindPort == port {
+				return fmt.Errorf("load balancer port %s is already in use by the cluster %q", port, cfg.Metadata().ID())
+			}
+		}
+
+		return nil
+	}
+
 	return []validated.StateOption{
 		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.Cluster, _ ...state.CreateOption) error {
 			var multiErr error
@@ -150,6 +206,10 @@ func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackup
 				multiErr = multierror.Append(multiErr, err)
 			}
 
+			if err := validateLoadBalancer(ctx, res); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+
 			if err := validateBackupInterval(res); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
@@ -183,6 +243,12 @@ func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackup
 				multiErr = multierror.Append(multiErr, err)
 			}
 
+			if !existingRes.TypedSpec().Value.GetLoadBalancer().EqualVT(newRes.TypedSpec().Value.GetLoadBalancer()) {
+				if err := validateLoadBalancer(ctx, newRes); err != nil {
+					multiErr = multierror.Append(multiErr, err)
+				}
+			}
+
 			if err := validateEmbeddedDiscoveryServiceSetting(existingRes, newRes); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
@@ -437,6 +503,10 @@ func machineClassValidationOptions(st state.State) []validated.StateOption {
 			return errors.New("can't set both auto provision and match labels at the same time")
 		}
 
+		if res.TypedSpec().Value.AutoProvision != nil && res.TypedSpec().Value.MatchExpression != "" {
+			return errors.New("can't set both auto provision and match expression at the same time")
+		}
+
 		if res.TypedSpec().Value.AutoProvision != nil {
 			autoProvision := res.TypedSpec().Value.AutoProvision
 
@@ -458,8 +528,14 @@ func machineClassValidationOptions(st state.State) []validated.StateOption {
 			return fmt.Errorf("failed to parse matchLabels: %w", err)
 		}
 
-		if len(queries) == 0 {
-			return fmt.Errorf("machine class should either have auto provision or match labels set")
+		if res.TypedSpec().Value.MatchExpression != "" {
+			if _, err = machineexpr.Compile(res.TypedSpec().Value.MatchExpression); err != nil {
+				return fmt.Errorf("failed to parse matchExpression: %w", err)
+			}
+		}
+
+		if len(queries) == 0 && res.TypedSpec().Value.MatchExpression == "" {
+			return fmt.Errorf("machine class should either have auto provision or match labels or match expression set")
 		}
 
 		if slices.IndexFunc(queries, func(s resource.LabelQuery) bool {
@@ -792,6 +868,10 @@ func exposedServiceValidationOptions() []validated.StateOption {
 func configPatchValidationOptions(st state.State) []validated.StateOption {
 	return []validated.StateOption{
 		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.ConfigPatch, _ ...state.CreateOption) error {
+			if err := validateFleetPatch(res); err != nil {
+				return err
+			}
+
 			if clusterName, ok := res.Metadata().Labels().Get(omni.LabelCluster); ok {
 				cluster, err := safe.StateGetByID[*omni.Cluster](ctx, st, clusterName)
 				if err != nil && !state.IsNotFoundError(err) {
@@ -821,9 +901,13 @@ func configPatchValidationOptions(st state.State) []validated.StateOption {
 
 			defer buffer.Free()
 
-			return omni.ValidateConfigPatch(buffer.Data())
+			return validateConfigPatchData(res, buffer.Data())
 		})),
 		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, oldRes *omni.ConfigPatch, newRes *omni.ConfigPatch, _ ...state.UpdateOption) error {
+			if err := validateFleetPatch(newRes); err != nil {
+				return err
+			}
+
 			// keep the old config patch if the data is the same for backwards-compatibility and for teardown cases
 			oldBuffer, err := oldRes.TypedSpec().Value.GetUncompressedData()
 			if err != nil {
@@ -842,15 +926,46 @@ func configPatchValidationOptions(st state.State) []validated.StateOption {
 			oldData := oldBuffer.Data()
 			newData := newBuffer.Data()
 
-			if bytes.Equal(oldData, newData) {
+			if bytes.Equal(oldData, newData) && omni.IsConfigPatchTemplate(oldRes) == omni.IsConfigPatchTemplate(newRes) {
 				return nil
 			}
 
-			return omni.ValidateConfigPatch(newData)
+			return validateConfigPatchData(newRes, newData)
 		})),
 	}
 }
 
+// validateConfigPatchData validates the config patch, the config patch templates are validated on the data rendered with the sample values.
+func validateConfigPatchData(res *omni.ConfigPatch, data []byte) error {
+	if omni.IsConfigPatchTemplate(res) {
+		return omni.ValidateConfigPatchTemplate(data)
+	}
+
+	return omni.ValidateConfigPatch(data)
+}
+
+// validateFleetPatch validates the target and the selector of the fleet config patch.
+func validateFleetPatch(res *omni.ConfigPatch) error {
+	target, ok := res.Metadata().Labels().Get(omni.LabelFleetPatchTarget)
+	if !ok {
+		return nil
+	}
+
+	if !slices.Contains(omni.FleetPatchTargets, target) {
+		return fmt.Errorf("invalid fleet patch target %q, must be one of %v", target, omni.FleetPatchTargets)
+	}
+
+	for _, label := range []string{omni.LabelCluster, omni.LabelMachineSet, omni.LabelClusterMachine, omni.LabelMachine} {
+		if _, ok = res.Metadata().Labels().Get(label); ok {
+			return fmt.Errorf("fleet patch can not have the %q label", label)
+		}
+	}
+
+	_, err := omni.FleetPatchSelectorQuery(res)
+
+	return err
+}
+
 func validateNotControlplane(machineSet *omni.MachineSet, res *omni.MachineSetNode) error {
 	if _, locked := res.Metadata().Annotations().Get(omni.MachineLocked); !locked {
 		return nil
@@ -1105,3 +1220,18 @@ func infraMachineConfigValidationOptions(st state.State) []validated.StateOption
 		})),
 	}
 }
+
+func addonValidationOptions() []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.Addon, _ ...state.CreateOption) error {
+			return addon.Validate(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _, newRes *omni.Addon, _ ...state.UpdateOption) error {
+			if newRes.Metadata().Phase() == resource.PhaseTearingDown {
+				return nil
+			}
+
+			return addon.Validate(newRes)
+		})),
+	}
+}
@@ -8,20 +8,28 @@ package omni_test
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	machineryconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	talossecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
@@ -34,8 +42,11 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/admission"
+	omniauth "github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
 )
 
 //go:embed testdata/infra.json
@@ -56,7 +67,7 @@ func TestClusterValidation(t *testing.T) {
 	}
 
 	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
-	st := validated.NewState(innerSt, omni.ClusterValidationOptions(state.WrapCore(innerSt), etcdBackupConfig, config.EmbeddedDiscoveryServiceParams{})...)
+	st := validated.NewState(innerSt, omni.ClusterValidationOptions(state.WrapCore(innerSt), etcdBackupConfig, config.EmbeddedDiscoveryServiceParams{}, config.LoadBalancerParams{})...)
 
 	talosVersion1 := omnires.NewTalosVersion(resources.DefaultNamespace, "1.4.0")
 	talosVersion1.TypedSpec().Value.CompatibleKubernetesVersions = []string{"1.27.0", "1.27.1"}
@@ -172,7 +183,7 @@ func TestClusterUseEmbeddedDiscoveryServiceValidation(t *testing.T) {
 
 	buildState := func(conf config.EmbeddedDiscoveryServiceParams) (inner, outer state.State) {
 		innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
-		st := validated.NewState(innerSt, omni.ClusterValidationOptions(state.WrapCore(innerSt), config.EtcdBackupParams{}, conf)...)
+		st := validated.NewState(innerSt, omni.ClusterValidationOptions(state.WrapCore(innerSt), config.EtcdBackupParams{}, conf, config.LoadBalancerParams{})...)
 
 		return innerSt, state.WrapCore(st)
 	}
@@ -252,6 +263,73 @@ func TestClusterUseEmbeddedDiscoveryServiceValidation(t *testing.T) {
 	})
 }
 
+func TestClusterLoadBalancerValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.ClusterValidationOptions(state.WrapCore(innerSt), config.EtcdBackupParams{}, config.EmbeddedDiscoveryServiceParams{}, config.LoadBalancerParams{
+		SNIDomain: "k8s.example.org",
+	})...)
+
+	talosVersion := omnires.NewTalosVersion(resources.DefaultNamespace, "1.8.0")
+	talosVersion.TypedSpec().Value.CompatibleKubernetesVersions = []string{"1.31.0"}
+
+	require.NoError(t, st.Create(ctx, talosVersion))
+
+	newCluster := func(id string, lb *specs.ClusterSpec_LoadBalancer) *omnires.Cluster {
+		cluster := omnires.NewCluster(resources.DefaultNamespace, id)
+		cluster.TypedSpec().Value.TalosVersion = "1.8.0"
+		cluster.TypedSpec().Value.KubernetesVersion = "1.31.0"
+		cluster.TypedSpec().Value.LoadBalancer = lb
+
+		return cluster
+	}
+
+	err := st.Create(ctx, newCluster("invalid-address", &specs.ClusterSpec_LoadBalancer{BindAddress: "not-an-ip"}))
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "invalid load balancer bind address")
+
+	err = st.Create(ctx, newCluster("invalid-port", &specs.ClusterSpec_LoadBalancer{BindPort: 70000}))
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "invalid load balancer bind port")
+
+	// the SNI port is not configured, so the multiplexing is disabled
+	err = st.Create(ctx, newCluster("sni", &specs.ClusterSpec_LoadBalancer{Sni: true}))
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "load balancer SNI multiplexing is not enabled")
+
+	cluster1 := newCluster("cluster1", &specs.ClusterSpec_LoadBalancer{BindPort: 6443, BindAddress: "10.5.0.1"})
+
+	require.NoError(t, st.Create(ctx, cluster1))
+
+	err = st.Create(ctx, newCluster("cluster2", &specs.ClusterSpec_LoadBalancer{BindPort: 6443}))
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "is already requested by the cluster \"cluster1\"")
+
+	// the port which was allocated dynamically to another cluster can't be requested
+	lbConfig := omnires.NewLoadBalancerConfig(resources.DefaultNamespace, "cluster3")
+	lbConfig.TypedSpec().Value.BindPort = "10000"
+
+	require.NoError(t, st.Create(ctx, lbConfig))
+
+	cluster2 := newCluster("cluster2", nil)
+
+	require.NoError(t, st.Create(ctx, cluster2))
+
+	cluster2.TypedSpec().Value.LoadBalancer = &specs.ClusterSpec_LoadBalancer{BindPort: 10000}
+
+	err = st.Update(ctx, cluster2)
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "is already in use by the cluster \"cluster3\"")
+
+	cluster2.TypedSpec().Value.LoadBalancer = &specs.ClusterSpec_LoadBalancer{BindPort: 10001}
+
+	require.NoError(t, st.Update(ctx, cluster2))
+}
+
 func TestRelationLabelsValidation(t *testing.T) {
 	t.Parallel()
 
@@ -752,6 +830,452 @@ machine:
 	require.ErrorContains(t, err, "tearing down")
 }
 
+func TestFleetConfigPatchValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.ConfigPatchValidationOptions(innerSt)...)
+
+	configPatch := omnires.NewConfigPatch(resources.DefaultNamespace, "fleet")
+	configPatch.Metadata().Labels().Set(omnires.LabelFleetPatchTarget, "rack")
+
+	require.NoError(t, configPatch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  env:\n    bla: bla\n")))
+
+	require.ErrorContains(t, st.Create(ctx, configPatch), "invalid fleet patch target")
+
+	configPatch.Metadata().Labels().Set(omnires.LabelFleetPatchTarget, omnires.FleetPatchTargetMachine)
+	configPatch.Metadata().Labels().Set(omnires.LabelCluster, "cluster")
+
+	require.ErrorContains(t, st.Create(ctx, configPatch), "fleet patch can not have the")
+
+	configPatch.Metadata().Labels().Delete(omnires.LabelCluster)
+	configPatch.Metadata().Annotations().Set(omnires.FleetPatchSelector, "env in (prod")
+
+	require.Error(t, st.Create(ctx, configPatch))
+
+	configPatch.Metadata().Annotations().Set(omnires.FleetPatchSelector, "env=prod,region")
+
+	require.NoError(t, st.Create(ctx, configPatch))
+
+	configPatch.Metadata().Labels().Set(omnires.LabelFleetPatchTarget, "rack")
+
+	require.ErrorContains(t, st.Update(ctx, configPatch), "invalid fleet patch target")
+}
+
+func TestConfigPatchTemplateValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.ConfigPatchValidationOptions(innerSt)...)
+
+	configPatch := omnires.NewConfigPatch(resources.DefaultNamespace, "template")
+	configPatch.Metadata().Labels().Set(omnires.LabelConfigPatchTemplate, "")
+
+	require.NoError(t, configPatch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  network:\n    hostname: {{ .Machine.Serial }}\n")))
+	require.ErrorContains(t, st.Create(ctx, configPatch), "can't evaluate field Serial")
+
+	require.NoError(t, configPatch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  network:\n    hostname: {{ .Machine.Labels.rack }}-{{ .Machine.ID }}\n")))
+	require.NoError(t, st.Create(ctx, configPatch))
+
+	require.NoError(t, configPatch.TypedSpec().Value.SetUncompressedData([]byte("cluster:\n  clusterName: {{ .Cluster.Name }}\n")))
+	require.ErrorContains(t, st.Update(ctx, configPatch), "is not allowed in the config patch")
+
+	// the same data is validated again when the patch becomes a template
+	plainPatch := omnires.NewConfigPatch(resources.DefaultNamespace, "plain")
+
+	require.NoError(t, plainPatch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  env:\n    value: '{{ .Unknown }}'\n")))
+	require.NoError(t, st.Create(ctx, plainPatch))
+
+	plainPatch.Metadata().Labels().Set(omnires.LabelConfigPatchTemplate, "")
+
+	require.ErrorContains(t, st.Update(ctx, plainPatch), "can't evaluate field Unknown")
+}
+
+func TestAdmissionPolicyValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.AdmissionPolicyValidationOptions(innerSt)...)
+
+	policy := omnires.NewAdmissionPolicy(resources.DefaultNamespace, "prod")
+	policy.TypedSpec().Value.ResourceTypes = []string{omnires.ClusterType}
+	policy.TypedSpec().Value.MatchExpression = `"env" in labels && labels["env"] == "prod"`
+	policy.TypedSpec().Value.Rules = []*specs.AdmissionPolicySpec_Rule{
+		{
+			Expression: "spec.features.disk_encryption",
+			Message:    "prod clusters must have disk encryption",
+		},
+		{
+			Expression: "semver_minor(spec.kubernetes_version) >= semver_minor(latest_kubernetes_version) - 1",
+			Message:    "Kubernetes version is too old",
+		},
+	}
+
+	policy.TypedSpec().Value.Rules[0].Expression = "spec.features.encryption"
+
+	require.ErrorContains(t, st.Create(ctx, policy), "undefined field 'encryption'")
+
+	policy.TypedSpec().Value.Rules[0].Expression = "spec.features.disk_encryption"
+
+	require.NoError(t, st.Create(ctx, policy))
+
+	// the policy which can't be compiled anymore is skipped, it doesn't block the writes
+	brokenPolicy := omnires.NewAdmissionPolicy(resources.DefaultNamespace, "broken")
+	brokenPolicy.TypedSpec().Value.ResourceTypes = []string{"Gone.omni.sidero.dev"}
+	brokenPolicy.TypedSpec().Value.Rules = []*specs.AdmissionPolicySpec_Rule{{Expression: "false", Message: "always denied"}}
+
+	require.NoError(t, innerSt.Create(ctx, brokenPolicy))
+
+	for _, version := range []string{"1.30.3", "1.31.1", "1.29.0"} {
+		kubernetesVersion := omnires.NewKubernetesVersion(resources.DefaultNamespace, version)
+		kubernetesVersion.TypedSpec().Value.Version = version
+
+		require.NoError(t, innerSt.Create(ctx, kubernetesVersion))
+	}
+
+	cluster := omnires.NewCluster(resources.DefaultNamespace, "test")
+	cluster.TypedSpec().Value.KubernetesVersion = "1.29.0"
+
+	// not matched by the policy
+	require.NoError(t, st.Create(ctx, cluster))
+
+	cluster.Metadata().Labels().Set("env", "prod")
+
+	err := st.Update(ctx, cluster)
+	require.True(t, validated.IsValidationError(err))
+	require.ErrorContains(t, err, `denied by admission policy "prod": prod clusters must have disk encryption; Kubernetes version is too old`)
+
+	cluster.TypedSpec().Value.Features = &specs.ClusterSpec_Features{DiskEncryption: true}
+	cluster.TypedSpec().Value.KubernetesVersion = "1.30.3"
+
+	require.NoError(t, st.Update(ctx, cluster))
+
+	// the writes are admitted once the policy is removed
+	require.NoError(t, st.Destroy(ctx, policy.Metadata()))
+
+	cluster.TypedSpec().Value.Features.DiskEncryption = false
+
+	require.NoError(t, st.Update(ctx, cluster))
+}
+
+func TestAdmissionPolicyMachineConfigs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.AdmissionPolicyValidationOptions(innerSt)...)
+
+	policy := omnires.NewAdmissionPolicy(resources.DefaultNamespace, "kubelet-auth")
+	policy.TypedSpec().Value.ResourceTypes = []string{omnires.ConfigPatchType}
+	policy.TypedSpec().Value.Operations = []string{admission.OperationCreate, admission.OperationUpdate, admission.OperationDestroy}
+	policy.TypedSpec().Value.Rules = []*specs.AdmissionPolicySpec_Rule{
+		{
+			Expression: `machine_configs.all(c, !has(c.machine.kubelet.extraArgs) || c.machine.kubelet.extraArgs["anonymous-auth"] != "true")`,
+			Message:    "kubelet authentication can not be disabled",
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, policy))
+
+	// the inputs of the machine config of the cluster machine "machine"
+	cluster := omnires.NewCluster(resources.DefaultNamespace, "test")
+	cluster.TypedSpec().Value.TalosVersion = "1.8.0"
+	cluster.TypedSpec().Value.KubernetesVersion = constants.DefaultKubernetesVersion
+
+	bundle, err := talossecrets.NewBundle(talossecrets.NewFixedClock(time.Now()), machineryconfig.TalosVersion1_8)
+	require.NoError(t, err)
+
+	secretsData, err := json.Marshal(bundle)
+	require.NoError(t, err)
+
+	clusterSecrets := omnires.NewClusterSecrets(resources.DefaultNamespace, "test")
+	clusterSecrets.TypedSpec().Value.Data = secretsData
+
+	loadBalancerConfig := omnires.NewLoadBalancerConfig(resources.DefaultNamespace, "test")
+	loadBalancerConfig.TypedSpec().Value.SiderolinkEndpoint = "https://doesntmatter:6443"
+
+	clusterConfigVersion := omnires.NewClusterConfigVersion(resources.DefaultNamespace, "test")
+	clusterConfigVersion.TypedSpec().Value.Version = "1.8.0"
+
+	machineSet := omnires.NewMachineSet(resources.DefaultNamespace, "test-workers")
+	machineSet.Metadata().Labels().Set(omnires.LabelCluster, "test")
+
+	clusterMachine := omnires.NewClusterMachine(resources.DefaultNamespace, "machine")
+	clusterMachine.Metadata().Labels().Set(omnires.LabelCluster, "test")
+	clusterMachine.Metadata().Labels().Set(omnires.LabelMachineSet, "test-workers")
+	clusterMachine.Metadata().Labels().Set(omnires.LabelWorkerRole, "")
+	clusterMachine.TypedSpec().Value.KubernetesVersion = constants.DefaultKubernetesVersion
+
+	genOptions := omnires.NewMachineConfigGenOptions(resources.DefaultNamespace, "machine")
+	genOptions.TypedSpec().Value.InstallImage = &specs.MachineConfigGenOptionsSpec_InstallImage{
+		TalosVersion:         "1.8.0",
+		SchematicId:          "376567988ad370138ad8b2698212367b8edcb69b5fd68c80be1f2ec7d603b4ba",
+		SchematicInitialized: true,
+		SecureBootStatus:     &specs.SecureBootStatus{},
+	}
+
+	// the patch created before the policy disables the kubelet authentication
+	existingPatch := omnires.NewConfigPatch(resources.DefaultNamespace, "400-anonymous-auth")
+	existingPatch.Metadata().Labels().Set(omnires.LabelCluster, "test")
+
+	require.NoError(t, existingPatch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  kubelet:\n    extraArgs:\n      anonymous-auth: \"true\"\n")))
+
+	for _, res := range []resource.Resource{
+		cluster,
+		clusterSecrets,
+		loadBalancerConfig,
+		clusterConfigVersion,
+		machineSet,
+		clusterMachine,
+		genOptions,
+		siderolink.NewConnectionParams(resources.DefaultNamespace, siderolink.ConfigID),
+		existingPatch,
+	} {
+		require.NoError(t, innerSt.Create(ctx, res))
+	}
+
+	patch := omnires.NewConfigPatch(resources.DefaultNamespace, "500-hostname")
+	patch.Metadata().Labels().Set(omnires.LabelCluster, "test")
+
+	require.NoError(t, patch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  network:\n    hostname: foo\n")))
+
+	// the patch doesn't disable the authentication itself, but the resulting machine config does
+	err = st.Create(ctx, patch)
+	require.True(t, validated.IsValidationError(err))
+	require.ErrorContains(t, err, `denied by admission policy "kubelet-auth": kubelet authentication can not be disabled`)
+
+	require.NoError(t, patch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  kubelet:\n    extraArgs:\n      anonymous-auth: \"false\"\n")))
+	require.NoError(t, st.Create(ctx, patch))
+
+	// the patches of the other clusters are not evaluated against the machine config
+	otherPatch := omnires.NewConfigPatch(resources.DefaultNamespace, "500-other")
+	otherPatch.Metadata().Labels().Set(omnires.LabelCluster, "other")
+
+	require.NoError(t, otherPatch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  network:\n    hostname: foo\n")))
+	require.NoError(t, st.Create(ctx, otherPatch))
+
+	// the destroyed patch is evaluated against the machine config without it, which disables the authentication again
+	err = st.Destroy(ctx, patch.Metadata())
+	require.True(t, validated.IsValidationError(err))
+	require.ErrorContains(t, err, `denied by admission policy "kubelet-auth": kubelet authentication can not be disabled`)
+
+	require.NoError(t, st.Destroy(ctx, existingPatch.Metadata()))
+	require.NoError(t, st.Destroy(ctx, patch.Metadata()))
+
+	// the templates are rendered for each machine, not with the sample data
+	templatePatch := omnires.NewConfigPatch(resources.DefaultNamespace, "500-template")
+	templatePatch.Metadata().Labels().Set(omnires.LabelCluster, "test")
+	templatePatch.Metadata().Labels().Set(omnires.LabelConfigPatchTemplate, "")
+
+	require.NoError(t, templatePatch.TypedSpec().Value.SetUncompressedData(
+		[]byte("machine:\n  kubelet:\n    extraArgs:\n      anonymous-auth: \"{{ eq .Machine.ID \"machine\" }}\"\n"),
+	))
+
+	err = st.Create(ctx, templatePatch)
+	require.True(t, validated.IsValidationError(err))
+	require.ErrorContains(t, err, `denied by admission policy "kubelet-auth": kubelet authentication can not be disabled`)
+}
+
+func TestChangeRequestApproval(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	userContext := func(identity string, userRole role.Role) context.Context {
+		return ctxstore.WithValue(ctxstore.WithValue(ctx, omniauth.IdentityContextKey{Identity: identity}), omniauth.RoleContextKey{Role: userRole})
+	}
+
+	alice := userContext("alice@example.com", role.Operator)
+	bob := userContext("bob@example.com", role.Admin)
+	carol := userContext("carol@example.com", role.Operator)
+	dave := userContext("dave@example.com", role.Reader)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := state.WrapCore(validated.NewState(omni.NewApprovalState(innerSt),
+		slices.Concat(omni.AdmissionPolicyValidationOptions(innerSt), omni.ChangeRequestValidationOptions(innerSt))...,
+	))
+
+	policy := omnires.NewAdmissionPolicy(resources.DefaultNamespace, "prod-destroy")
+	policy.TypedSpec().Value.ResourceTypes = []string{omnires.ClusterType}
+	policy.TypedSpec().Value.Operations = []string{omnires.ChangeRequestOperationDestroy}
+	policy.TypedSpec().Value.Action = specs.AdmissionPolicySpec_REQUIRE_APPROVAL
+	policy.TypedSpec().Value.ApproverRole = string(role.Admin)
+	policy.TypedSpec().Value.MatchExpression = `"env" in labels && labels["env"] == "prod"`
+	policy.TypedSpec().Value.Rules = []*specs.AdmissionPolicySpec_Rule{
+		{
+			Expression: "false",
+			Message:    "destroying prod clusters requires approval",
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, policy))
+
+	cluster := omnires.NewCluster(resources.DefaultNamespace, "test")
+	cluster.Metadata().Labels().Set("env", "prod")
+
+	// the policy doesn't hold the creation
+	require.NoError(t, st.Create(alice, cluster))
+
+	err := st.Destroy(alice, cluster.Metadata())
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.ErrorContains(t, err, "pending approval: destroying prod clusters requires approval")
+
+	_, err = st.Get(ctx, cluster.Metadata())
+	require.NoError(t, err)
+
+	requests, err := safe.StateListAll[*omnires.ChangeRequest](ctx, st)
+	require.NoError(t, err)
+	require.Equal(t, 1, requests.Len())
+
+	request := requests.Get(0)
+
+	assert.Equal(t, omnires.ChangeRequestOperationDestroy, request.TypedSpec().Value.Operation)
+	assert.Equal(t, "test", request.TypedSpec().Value.ResourceId)
+	assert.Equal(t, "alice@example.com", request.TypedSpec().Value.RequestedBy)
+	assert.Equal(t, string(role.Admin), request.TypedSpec().Value.ApproverRole)
+	assert.Equal(t, specs.ChangeRequestSpec_PENDING, request.TypedSpec().Value.Phase)
+
+	// the change requests can't be created by the users
+	require.ErrorContains(t, st.Create(alice, omnires.NewChangeRequest(resources.DefaultNamespace, "forged")), "change requests are created only by Omni")
+
+	review := func(ctx context.Context, reviewer string, phase specs.ChangeRequestSpec_Phase) error {
+		_, err := safe.StateUpdateWithConflicts(ctx, st, request.Metadata(), func(res *omnires.ChangeRequest) error {
+			res.TypedSpec().Value.Phase = phase
+			res.TypedSpec().Value.ReviewedBy = reviewer
+
+			return nil
+		})
+
+		return err
+	}
+
+	require.ErrorContains(t, review(alice, "alice@example.com", specs.ChangeRequestSpec_APPROVED), "can not be approved by the requester")
+	require.ErrorContains(t, review(carol, "carol@example.com", specs.ChangeRequestSpec_APPROVED), `reviewed only by the users with the "Admin" role`)
+	require.ErrorContains(t, review(bob, "carol@example.com", specs.ChangeRequestSpec_APPROVED), `reviewer must be the current user "bob@example.com"`)
+	require.ErrorContains(t, review(bob, "bob@example.com", specs.ChangeRequestSpec_APPLIED), "can only be approved or rejected")
+
+	require.ErrorContains(t, review(dave, "dave@example.com", specs.ChangeRequestSpec_APPROVED), `reviewed only by the users with the "Admin" role`)
+
+	// the cluster-scoped role of the access policy allows the approval
+	accessPolicy := auth.NewAccessPolicy()
+	accessPolicy.TypedSpec().Value.Rules = []*specs.AccessPolicyRule{
+		{
+			Users:    []string{"dave@example.com"},
+			Clusters: []string{"test"},
+			Role:     string(role.Admin),
+		},
+	}
+
+	require.NoError(t, innerSt.Create(ctx, accessPolicy))
+	require.NoError(t, innerSt.Create(ctx, auth.NewIdentity(resources.DefaultNamespace, "dave@example.com")))
+
+	require.NoError(t, review(dave, "dave@example.com", specs.ChangeRequestSpec_APPROVED))
+
+	// the review keeps the requested resource which might be stripped on read
+	stored, err := safe.StateGetByID[*omnires.ChangeRequest](ctx, innerSt, request.Metadata().ID())
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, stored.TypedSpec().Value.Resource)
+	require.ErrorContains(t, review(bob, "bob@example.com", specs.ChangeRequestSpec_REJECTED), "change request is already approved")
+
+	// the writes not matching the policy are not held
+	cluster.Metadata().Labels().Delete("env")
+
+	require.NoError(t, st.Update(alice, cluster))
+	require.NoError(t, st.Destroy(alice, cluster.Metadata()))
+}
+
+func TestChangeRequestRedaction(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	authCtx := ctxstore.WithValue(ctx, omniauth.EnabledAuthContextKey{Enabled: true})
+
+	userContext := func(identity string, userRole role.Role) context.Context {
+		return ctxstore.WithValue(ctxstore.WithValue(authCtx, omniauth.IdentityContextKey{Identity: identity}), omniauth.RoleContextKey{Role: userRole})
+	}
+
+	alice := userContext("alice@example.com", role.Admin)
+	bob := userContext("bob@example.com", role.Admin)
+	dave := userContext("dave@example.com", role.Reader)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := state.WrapCore(validated.NewState(omni.NewApprovalState(innerSt),
+		slices.Concat(omni.AdmissionPolicyValidationOptions(innerSt), omni.ChangeRequestValidationOptions(innerSt))...,
+	))
+
+	policy := omnires.NewAdmissionPolicy(resources.DefaultNamespace, "s3-conf")
+	policy.TypedSpec().Value.ResourceTypes = []string{omnires.EtcdBackupS3ConfType}
+	policy.TypedSpec().Value.Action = specs.AdmissionPolicySpec_REQUIRE_APPROVAL
+	policy.TypedSpec().Value.Rules = []*specs.AdmissionPolicySpec_Rule{
+		{
+			Expression: "false",
+			Message:    "changing the backup storage requires approval",
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, policy))
+
+	s3Conf := omnires.NewEtcdBackupS3Conf()
+	s3Conf.TypedSpec().Value.SecretAccessKey = "secret"
+
+	require.Equal(t, codes.FailedPrecondition, status.Code(st.Create(alice, s3Conf)))
+
+	watchCh := make(chan state.Event)
+
+	require.NoError(t, st.WatchKind(dave, omnires.NewChangeRequest(resources.DefaultNamespace, "").Metadata(), watchCh, state.WithBootstrapContents(true)))
+
+	select {
+	case event := <-watchCh:
+		require.Equal(t, state.Created, event.Type)
+		assert.Empty(t, event.Resource.(*omnires.ChangeRequest).TypedSpec().Value.Resource) //nolint:forcetypeassert,errcheck
+	case <-ctx.Done():
+		t.Fatal("timeout")
+	}
+
+	// the users who can't read the S3 config don't see it in the change request
+	requests, err := safe.StateListAll[*omnires.ChangeRequest](dave, st)
+	require.NoError(t, err)
+	require.Equal(t, 1, requests.Len())
+
+	assert.Equal(t, omnires.EtcdBackupS3ConfType, requests.Get(0).TypedSpec().Value.ResourceType)
+	assert.Empty(t, requests.Get(0).TypedSpec().Value.Resource)
+
+	request, err := safe.StateGetByID[*omnires.ChangeRequest](dave, st, requests.Get(0).Metadata().ID())
+	require.NoError(t, err)
+
+	assert.Empty(t, request.TypedSpec().Value.Resource)
+
+	// the admins see the requested resource
+	request, err = safe.StateGetByID[*omnires.ChangeRequest](bob, st, request.Metadata().ID())
+	require.NoError(t, err)
+
+	requested, err := omnires.DecodeChangeRequestResource(request.TypedSpec().Value.Resource)
+	require.NoError(t, err)
+
+	assert.Equal(t, "secret", requested.(*omnires.EtcdBackupS3Conf).TypedSpec().Value.SecretAccessKey) //nolint:forcetypeassert,errcheck
+
+	// the stored change request is not modified
+	stored, err := safe.StateGetByID[*omnires.ChangeRequest](ctx, innerSt, request.Metadata().ID())
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, stored.TypedSpec().Value.Resource)
+}
+
 func TestEtcdBackupValidation(t *testing.T) {
 	t.Parallel()
 
@@ -954,6 +1478,37 @@ func TestMachineClassValidation(t *testing.T) {
 
 	require.True(t, validated.IsValidationError(err), "expected validation error")
 
+	// invalid expression
+
+	machineClass.TypedSpec().Value.MatchLabels = nil
+	machineClass.TypedSpec().Value.MatchExpression = "machine.hardware.arch"
+
+	err = st.Create(ctx, machineClass)
+
+	require.Error(t, err)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+
+	// expression without labels
+
+	machineClass.TypedSpec().Value.MatchExpression = `machine.hardware.arch == "amd64"`
+
+	require.NoError(t, st.Create(ctx, machineClass))
+	require.NoError(t, st.Destroy(ctx, machineClass.Metadata()))
+
+	// expression with auto provision
+
+	machineClass.TypedSpec().Value.AutoProvision = &specs.MachineClassSpec_Provision{ProviderId: "exists"}
+
+	err = st.Create(ctx, machineClass)
+
+	require.Error(t, err)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+
+	machineClass.TypedSpec().Value.AutoProvision = nil
+	machineClass.TypedSpec().Value.MatchExpression = ""
+
 	// both modes set
 
 	machineClass.TypedSpec().Value.MatchLabels = []string{"abcd"}
@@ -1113,6 +1668,38 @@ func TestSchematicConfigurationValidation(t *testing.T) {
 	require.NoError(t, st.Update(ctx, res))
 }
 
+func TestAddonValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	st := validated.NewState(innerSt, omni.AddonValidationOptions()...)
+
+	res := omnires.NewAddon(resources.DefaultNamespace, "test")
+
+	require.True(t, validated.IsValidationError(st.Create(ctx, res)), "expected validation error")
+
+	res.TypedSpec().Value.Manifests = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n"
+	res.TypedSpec().Value.ClusterSelector = "env in ("
+
+	require.True(t, validated.IsValidationError(st.Create(ctx, res)), "expected validation error")
+
+	res.TypedSpec().Value.ClusterSelector = "env=prod"
+
+	require.NoError(t, st.Create(ctx, res))
+
+	res.TypedSpec().Value.HelmChart = &specs.AddonSpec_HelmChart{Repository: "oci://ghcr.io/example", Name: "test"}
+
+	require.True(t, validated.IsValidationError(st.Update(ctx, res)), "expected validation error")
+
+	res.TypedSpec().Value.Manifests = ""
+
+	require.NoError(t, st.Update(ctx, res))
+}
+
 func TestMachineRequestSetValidation(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,208 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package selfbackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"text/tabwriter"
+
+	"filippo.io/age"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+)
+
+// RestoreOptions configures the restore.
+type RestoreOptions struct {
+	// MaxDBVersion is the latest DB version supported by this Omni version, the snapshots of the newer versions are rejected.
+	MaxDBVersion uint64
+
+	// RekeySideroLink generates the new SideroLink Wireguard key, it is also generated if the snapshot has no valid key.
+	RekeySideroLink bool
+
+	// Force allows restoring to the instance which already has clusters, machines or users.
+	Force bool
+}
+
+// Report describes the restored snapshot.
+type Report struct {
+	// Restored is the number of the restored resources by "namespace/type".
+	Restored map[string]int
+	// Skipped are the resources which already exist in the state and are owned by another controller.
+	Skipped           []string
+	Manifest          Manifest
+	SideroLinkRekeyed bool
+}
+
+// Write the human-readable summary of the report.
+func (r *Report) Write(w io.Writer) error {
+	fmt.Fprintf(w, "restored snapshot of %q created at %s by Omni %s (DB version %d)\n\n", //nolint:errcheck
+		r.Manifest.InstanceName, r.Manifest.CreatedAt.Format("2006-01-02 15:04:05 MST"), r.Manifest.OmniVersion, r.Manifest.DBVersion)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(tw, "RESOURCE\tRESTORED\tIN SNAPSHOT") //nolint:errcheck
+
+	for _, key := range slices.Sorted(maps.Keys(r.Manifest.Resources)) {
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", key, r.Restored[key], r.Manifest.Resources[key]) //nolint:errcheck
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, skipped := range r.Skipped {
+		fmt.Fprintf(w, "\nskipped %s: it is managed by the running Omni\n", skipped) //nolint:errcheck
+	}
+
+	if r.SideroLinkRekeyed {
+		fmt.Fprintln(w, "\nSideroLink Wireguard key was regenerated, the machines reconnect after they are provisioned again") //nolint:errcheck
+	}
+
+	return nil
+}
+
+// Restore rehydrates the state from the encrypted snapshot.
+//
+// The resources are restored with their metadata, the resources which already exist in the state (e.g. the ones created
+// on the startup) are overwritten if they have the same owner.
+func Restore(ctx context.Context, st state.State, r io.Reader, identity age.Identity, opts RestoreOptions) (*Report, error) {
+	reader, err := NewReader(r, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Restored: map[string]int{},
+	}
+
+	if dbVersion := reader.Manifest().DBVersion; dbVersion > opts.MaxDBVersion {
+		return nil, fmt.Errorf("snapshot DB version %d is newer than the supported version %d, restore it with the newer Omni", dbVersion, opts.MaxDBVersion)
+	}
+
+	if !opts.Force {
+		if err = checkEmpty(ctx, st); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		res, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, err
+		}
+
+		if res.Metadata().Type() == siderolink.ConfigType && res.Metadata().ID() == siderolink.ConfigID {
+			if report.SideroLinkRekeyed, err = rekeySideroLink(res, opts.RekeySideroLink); err != nil {
+				return nil, err
+			}
+		}
+
+		restored, err := restoreResource(ctx, st, res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore %s: %w", resource.String(res), err)
+		}
+
+		if !restored {
+			report.Skipped = append(report.Skipped, resource.String(res))
+
+			continue
+		}
+
+		report.Restored[res.Metadata().Namespace()+"/"+res.Metadata().Type()]++
+	}
+
+	report.Manifest = reader.Manifest()
+
+	return report, nil
+}
+
+// checkEmpty verifies that the state is a fresh Omni instance.
+func checkEmpty(ctx context.Context, st state.State) error {
+	for _, md := range []resource.Metadata{
+		*omni.NewCluster(resources.DefaultNamespace, "").Metadata(),
+		*omni.NewMachine(resources.DefaultNamespace, "").Metadata(),
+		*auth.NewIdentity(resources.DefaultNamespace, "").Metadata(),
+	} {
+		list, err := st.List(ctx, md)
+		if err != nil {
+			return err
+		}
+
+		if len(list.Items) > 0 {
+			return fmt.Errorf("the state already has %s resources, the snapshot can be restored only to the fresh instance", md.Type())
+		}
+	}
+
+	return nil
+}
+
+func restoreResource(ctx context.Context, st state.State, res resource.Resource) (bool, error) {
+	err := st.Create(ctx, res, state.WithCreateOwner(res.Metadata().Owner()))
+	if err == nil {
+		return true, nil
+	}
+
+	if !state.IsConflictError(err) {
+		return false, err
+	}
+
+	existing, err := st.Get(ctx, res.Metadata())
+	if err != nil {
+		return false, err
+	}
+
+	if existing.Metadata().Owner() != res.Metadata().Owner() {
+		return false, nil
+	}
+
+	res.Metadata().SetVersion(existing.Metadata().Version())
+
+	if err = st.Update(ctx, res, state.WithUpdateOwner(res.Metadata().Owner()), state.WithExpectedPhaseAny()); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func rekeySideroLink(res resource.Resource, force bool) (bool, error) {
+	cfg, ok := res.(*siderolink.Config)
+	if !ok {
+		return false, fmt.Errorf("unexpected resource type %T", res)
+	}
+
+	spec := cfg.TypedSpec().Value
+
+	if !force {
+		if _, err := wgtypes.ParseKey(spec.PrivateKey); err == nil {
+			return false, nil
+		}
+	}
+
+	privateKey, err := wgtypes.GeneratePrivateKey()
+	if err != nil {
+		return false, fmt.Errorf("error generating key: %w", err)
+	}
+
+	spec.PrivateKey = privateKey.String()
+	spec.PublicKey = privateKey.PublicKey().String()
+
+	return true, nil
+}
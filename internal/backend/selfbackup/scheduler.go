@@ -0,0 +1,146 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package selfbackup
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"time"
+
+	"filippo.io/age"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// StorePrefix is the key prefix of the snapshots in the etcd backup store.
+const StorePrefix = "omni-backups/"
+
+// StoreGetter returns the etcd backup store the snapshots are uploaded to.
+type StoreGetter interface {
+	GetStore() (etcdbackup.Store, error)
+}
+
+// Scheduler periodically uploads the encrypted snapshots of the Omni state to the etcd backup store
+// and removes the snapshots older than the retention period.
+type Scheduler struct {
+	state  state.State
+	stores StoreGetter
+	logger *zap.Logger
+	params config.SelfBackupParams
+}
+
+// NewScheduler initializes Scheduler.
+func NewScheduler(st state.State, stores StoreGetter, params config.SelfBackupParams, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		state:  st,
+		stores: stores,
+		params: params,
+		logger: logger,
+	}
+}
+
+// Run the scheduler until the context is canceled.
+func (s *Scheduler) Run(ctx context.Context) error {
+	if s.params.Interval == 0 {
+		<-ctx.Done()
+
+		return nil
+	}
+
+	identity, err := LoadIdentity(s.params.KeyFile, true, s.logger)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.params.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			key := Key(now)
+
+			if err = s.upload(ctx, key, identity.Recipient()); err != nil {
+				s.logger.Warn("failed to store the Omni state snapshot", zap.String("key", key), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *Scheduler) upload(ctx context.Context, key string, recipient age.Recipient) error {
+	store, err := s.stores.GetStore()
+	if err != nil {
+		return err
+	}
+
+	objectStore, ok := store.(etcdbackup.ObjectStore)
+	if !ok {
+		return etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	reader, writer := io.Pipe()
+
+	eg := panichandler.NewErrGroup()
+
+	eg.Go(func() error {
+		_, err := Snapshot(ctx, s.state, writer, recipient)
+
+		writer.CloseWithError(err)
+
+		return err
+	})
+
+	eg.Go(func() error {
+		err := objectStore.PutObject(ctx, key, reader)
+
+		reader.CloseWithError(err)
+
+		return err
+	})
+
+	if err = eg.Wait(); err != nil {
+		return err
+	}
+
+	s.logger.Info("stored the Omni state snapshot", zap.String("key", key))
+
+	if s.params.Retention == 0 {
+		return nil
+	}
+
+	return Prune(ctx, objectStore, time.Now().Add(-s.params.Retention))
+}
+
+// Prune removes the stored snapshots older than the given time.
+func Prune(ctx context.Context, objectStore etcdbackup.ObjectStore, before time.Time) error {
+	objects, err := objectStore.ListObjects(ctx, StorePrefix)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+
+	for _, object := range objects {
+		if object.Timestamp.Before(before) {
+			errs = errors.Join(errs, objectStore.DeleteObject(ctx, object.Key))
+		}
+	}
+
+	return errs
+}
+
+// Key returns the store key of the snapshot.
+func Key(timestamp time.Time) string {
+	return path.Join(StorePrefix, timestamp.UTC().Format("20060102T150405Z")+".tar.gz.age")
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package selfbackup implements the encrypted snapshots of the Omni state and their restore.
+//
+// The snapshot is an age encrypted gzipped tar archive. The first entry is the manifest describing the snapshot,
+// it is followed by an entry per resource type and namespace, which contains the resources in the same protobuf
+// representation as the state storage uses, each prefixed with its length.
+package selfbackup
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/store"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/oidc"
+	resourceregistry "github.com/siderolabs/omni/client/pkg/omni/resources/registry"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
+	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/version"
+)
+
+const (
+	// FormatVersion is the version of the snapshot format.
+	FormatVersion = 1
+
+	manifestEntry  = "manifest.json"
+	resourcesEntry = "resources"
+
+	// maxResourceSize is the maximum size of a single marshaled resource in the snapshot.
+	maxResourceSize = 64 * 1024 * 1024
+)
+
+// Namespaces are the namespaces of the primary and the secondary storage included in the snapshots.
+//
+// The ephemeral, virtual and external namespaces are not persisted, and the resources of the infra provider specific
+// namespaces are not registered in Omni, so they are not included.
+var Namespaces = []resource.Namespace{
+	resources.DefaultNamespace,
+	resources.InfraProviderNamespace,
+	oidc.NamespaceName,
+	resources.MetricsNamespace,
+}
+
+// Manifest describes the snapshot.
+type Manifest struct {
+	CreatedAt time.Time `json:"createdAt"`
+	// Resources is the number of the resources in the snapshot by "namespace/type".
+	//
+	// The manifest is written before the resources, so they are counted as the snapshot is written or read,
+	// see [Snapshot] and [Reader.Manifest].
+	Resources    map[string]int `json:"-"`
+	OmniVersion  string         `json:"omniVersion"`
+	InstanceName string         `json:"instanceName"`
+	AccountID    string         `json:"accountId"`
+	Format       int            `json:"format"`
+	DBVersion    uint64         `json:"dbVersion"`
+}
+
+// LoadIdentity reads the age X25519 identity used to encrypt the snapshots from the key file.
+//
+// If generate is true, the key is generated if the file doesn't exist.
+func LoadIdentity(keyFile string, generate bool, logger *zap.Logger) (*age.X25519Identity, error) {
+	data, err := os.ReadFile(keyFile)
+	if err == nil {
+		return age.ParseX25519Identity(strings.TrimSpace(string(data)))
+	}
+
+	if !errors.Is(err, fs.ErrNotExist) || !generate {
+		return nil, fmt.Errorf("failed to read self-backup key: %w", err)
+	}
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate self-backup key: %w", err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(keyFile), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create self-backup key directory: %w", err)
+	}
+
+	if err = os.WriteFile(keyFile, []byte(identity.String()+"\n"), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write self-backup key: %w", err)
+	}
+
+	logger.Warn("generated self-backup key, copy it outside of the Omni instance, it is required to restore the snapshots", zap.String("path", keyFile))
+
+	return identity, nil
+}
+
+// Snapshot writes the snapshot of the Omni state encrypted for the recipient.
+//
+// The resources are written to the snapshot a resource type at a time as they are listed, so that the whole state is not held in memory.
+// The returned manifest has the number of the written resources.
+func Snapshot(ctx context.Context, st state.State, w io.Writer, recipient age.Recipient) (*Manifest, error) {
+	manifest := &Manifest{
+		Format:       FormatVersion,
+		CreatedAt:    time.Now().UTC(),
+		OmniVersion:  version.Tag,
+		InstanceName: config.Config.Name,
+		AccountID:    config.Config.AccountID,
+		Resources:    map[string]int{},
+	}
+
+	dbVersion, err := safe.StateGetByID[*system.DBVersion](ctx, st, system.DBVersionID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get the DB version: %w", err)
+	}
+
+	if dbVersion != nil {
+		manifest.DBVersion = dbVersion.TypedSpec().Value.Version
+	}
+
+	encrypter, err := age.Encrypt(w, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(encrypter)
+	tw := tar.NewWriter(gz)
+
+	manifestData, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = writeEntry(tw, manifestEntry, manifestData, manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	var data []byte
+
+	for _, ns := range Namespaces {
+		for _, rd := range resourceregistry.Resources {
+			resourceType := rd.ResourceDefinition().Type
+
+			list, err := st.List(ctx, resource.NewMetadata(ns, resourceType, "", resource.VersionUndefined))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s in namespace %q: %w", resourceType, ns, err)
+			}
+
+			if len(list.Items) == 0 {
+				continue
+			}
+
+			data = data[:0]
+
+			for _, res := range list.Items {
+				marshaled, err := store.ProtobufMarshaler{}.MarshalResource(res)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal %s: %w", resource.String(res), err)
+				}
+
+				data = binary.AppendUvarint(data, uint64(len(marshaled)))
+				data = append(data, marshaled...)
+			}
+
+			if err = writeEntry(tw, path.Join(resourcesEntry, ns, resourceType), data, manifest.CreatedAt); err != nil {
+				return nil, err
+			}
+
+			manifest.Resources[ns+"/"+resourceType] = len(list.Items)
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+
+	if err = gz.Close(); err != nil {
+		return nil, err
+	}
+
+	if err = encrypter.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(len(data)),
+		Mode:     0o600,
+		ModTime:  modTime,
+	}); err != nil {
+		return err
+	}
+
+	_, err := tw.Write(data)
+
+	return err
+}
+
+// Reader reads the resources from the snapshot.
+type Reader struct {
+	tr       *tar.Reader
+	entry    *bufio.Reader
+	manifest Manifest
+}
+
+// NewReader decrypts the snapshot with the identity and reads its manifest.
+func NewReader(r io.Reader, identity age.Identity) (*Reader, error) {
+	decrypter, err := age.Decrypt(r, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the snapshot: %w", err)
+	}
+
+	gz, err := gzip.NewReader(decrypter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the snapshot: %w", err)
+	}
+
+	reader := &Reader{
+		tr: tar.NewReader(gz),
+	}
+
+	header, err := reader.tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the snapshot manifest: %w", err)
+	}
+
+	if header.Name != manifestEntry {
+		return nil, fmt.Errorf("unexpected first snapshot entry %q", header.Name)
+	}
+
+	if err = json.NewDecoder(reader.tr).Decode(&reader.manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode the snapshot manifest: %w", err)
+	}
+
+	if reader.manifest.Format != FormatVersion {
+		return nil, fmt.Errorf("unsupported snapshot format %d", reader.manifest.Format)
+	}
+
+	reader.manifest.Resources = map[string]int{}
+
+	return reader, nil
+}
+
+// Manifest returns the snapshot manifest, its resources are the ones read so far.
+func (r *Reader) Manifest() Manifest {
+	manifest := r.manifest
+	manifest.Resources = maps.Clone(r.manifest.Resources)
+
+	return manifest
+}
+
+// Next returns the next resource in the snapshot, or [io.EOF] if there are no more resources.
+func (r *Reader) Next() (resource.Resource, error) {
+	for {
+		if r.entry != nil {
+			size, err := binary.ReadUvarint(r.entry)
+			if err == nil {
+				return r.readResource(size)
+			}
+
+			if !errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("failed to read the snapshot: %w", err)
+			}
+		}
+
+		header, err := r.tr.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.HasPrefix(header.Name, resourcesEntry+"/") {
+			return nil, fmt.Errorf("unexpected snapshot entry %q", header.Name)
+		}
+
+		r.entry = bufio.NewReader(r.tr)
+	}
+}
+
+func (r *Reader) readResource(size uint64) (resource.Resource, error) {
+	if size > maxResourceSize {
+		return nil, fmt.Errorf("resource is larger than %d bytes", maxResourceSize)
+	}
+
+	data := make([]byte, size)
+
+	if _, err := io.ReadFull(r.entry, data); err != nil {
+		return nil, fmt.Errorf("failed to read the snapshot: %w", err)
+	}
+
+	res, err := store.ProtobufMarshaler{}.UnmarshalResource(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the resource: %w", err)
+	}
+
+	r.manifest.Resources[res.Metadata().Namespace()+"/"+res.Metadata().Type()]++
+
+	return res, nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package selfbackup_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
+	"github.com/siderolabs/omni/internal/backend/selfbackup"
+)
+
+func newState(ctx context.Context, t *testing.T, dbVersion uint64) state.State {
+	t.Helper()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	version := system.NewDBVersion(resources.DefaultNamespace, system.DBVersionID)
+	version.TypedSpec().Value.Version = dbVersion
+
+	require.NoError(t, st.Create(ctx, version))
+
+	return st
+}
+
+func snapshot(ctx context.Context, t *testing.T, identity *age.X25519Identity) []byte {
+	t.Helper()
+
+	st := newState(ctx, t, 10)
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "talos-default")
+	cluster.TypedSpec().Value.KubernetesVersion = "1.31.1"
+	cluster.Metadata().Labels().Set("env", "prod")
+	cluster.Metadata().Finalizers().Add("ClusterController")
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	status := omni.NewClusterStatus(resources.DefaultNamespace, "talos-default")
+	status.TypedSpec().Value.Ready = true
+
+	require.NoError(t, st.Create(ctx, status, state.WithCreateOwner("ClusterStatusController")))
+
+	privateKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	config := siderolink.NewConfig(siderolink.Namespace)
+	config.TypedSpec().Value.PrivateKey = privateKey.String()
+	config.TypedSpec().Value.PublicKey = privateKey.PublicKey().String()
+	config.TypedSpec().Value.JoinToken = "token"
+
+	require.NoError(t, st.Create(ctx, config))
+	require.NoError(t, st.Create(ctx, siderolink.NewDeprecatedLinkCounter(siderolink.CounterNamespace, "machine")))
+
+	// ephemeral resources are not included
+	require.NoError(t, st.Create(ctx, omni.NewClusterStatus(resources.EphemeralNamespace, "talos-default")))
+
+	var buf bytes.Buffer
+
+	manifest, err := selfbackup.Snapshot(ctx, st, &buf, identity.Recipient())
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 10, manifest.DBVersion)
+	assert.Equal(t, map[string]int{
+		resources.DefaultNamespace + "/" + system.DBVersionType:                  1,
+		resources.DefaultNamespace + "/" + omni.ClusterType:                      1,
+		resources.DefaultNamespace + "/" + omni.ClusterStatusType:                1,
+		resources.DefaultNamespace + "/" + siderolink.ConfigType:                 1,
+		siderolink.CounterNamespace + "/" + siderolink.DeprecatedLinkCounterType: 1,
+	}, manifest.Resources)
+
+	return buf.Bytes()
+}
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	data := snapshot(ctx, t, identity)
+
+	st := newState(ctx, t, 12)
+
+	report, err := selfbackup.Restore(ctx, st, bytes.NewReader(data), identity, selfbackup.RestoreOptions{MaxDBVersion: 12})
+	require.NoError(t, err)
+
+	assert.Len(t, report.Restored, 5)
+	assert.Equal(t, report.Restored, report.Manifest.Resources)
+	assert.Empty(t, report.Skipped)
+	assert.False(t, report.SideroLinkRekeyed)
+
+	dbVersion, err := safe.StateGetByID[*system.DBVersion](ctx, st, system.DBVersionID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, dbVersion.TypedSpec().Value.Version)
+
+	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, st, "talos-default")
+	require.NoError(t, err)
+	assert.Equal(t, "1.31.1", cluster.TypedSpec().Value.KubernetesVersion)
+	assert.True(t, cluster.Metadata().Finalizers().Has("ClusterController"))
+
+	env, _ := cluster.Metadata().Labels().Get("env")
+	assert.Equal(t, "prod", env)
+
+	status, err := safe.StateGetByID[*omni.ClusterStatus](ctx, st, "talos-default")
+	require.NoError(t, err)
+	assert.Equal(t, "ClusterStatusController", status.Metadata().Owner())
+
+	_, err = st.Get(ctx, siderolink.NewDeprecatedLinkCounter(siderolink.CounterNamespace, "machine").Metadata())
+	require.NoError(t, err)
+
+	_, err = st.Get(ctx, omni.NewClusterStatus(resources.EphemeralNamespace, "talos-default").Metadata())
+	require.True(t, state.IsNotFoundError(err))
+
+	var out strings.Builder
+
+	require.NoError(t, report.Write(&out))
+	assert.Contains(t, out.String(), resources.DefaultNamespace+"/"+omni.ClusterType)
+
+	// the instance which already has clusters is not overwritten
+	_, err = selfbackup.Restore(ctx, st, bytes.NewReader(data), identity, selfbackup.RestoreOptions{MaxDBVersion: 12})
+	require.ErrorContains(t, err, "fresh instance")
+}
+
+func TestRestoreRekey(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	data := snapshot(ctx, t, identity)
+
+	st := newState(ctx, t, 10)
+
+	// the resource owned by the running Omni is kept
+	status := omni.NewClusterStatus(resources.DefaultNamespace, "talos-default")
+
+	require.NoError(t, st.Create(ctx, status, state.WithCreateOwner("AnotherController")))
+
+	report, err := selfbackup.Restore(ctx, st, bytes.NewReader(data), identity, selfbackup.RestoreOptions{
+		MaxDBVersion:    10,
+		RekeySideroLink: true,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, report.SideroLinkRekeyed)
+	assert.Equal(t, []string{resource.String(status)}, report.Skipped)
+
+	config, err := safe.StateGetByID[*siderolink.Config](ctx, st, siderolink.ConfigID)
+	require.NoError(t, err)
+
+	privateKey, err := wgtypes.ParseKey(config.TypedSpec().Value.PrivateKey)
+	require.NoError(t, err)
+
+	assert.Equal(t, privateKey.PublicKey().String(), config.TypedSpec().Value.PublicKey)
+	assert.Equal(t, "token", config.TypedSpec().Value.JoinToken)
+}
+
+func TestRestoreRejected(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	data := snapshot(ctx, t, identity)
+
+	_, err = selfbackup.Restore(ctx, newState(ctx, t, 9), bytes.NewReader(data), identity, selfbackup.RestoreOptions{MaxDBVersion: 9})
+	require.ErrorContains(t, err, "newer than the supported version")
+
+	anotherIdentity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	_, err = selfbackup.Restore(ctx, newState(ctx, t, 10), bytes.NewReader(data), anotherIdentity, selfbackup.RestoreOptions{MaxDBVersion: 10})
+	require.ErrorContains(t, err, "failed to decrypt")
+}
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "omni-backups/20241018T030405Z.tar.gz.age", selfbackup.Key(time.Date(2024, 10, 18, 3, 4, 5, 0, time.UTC)))
+}
@@ -48,6 +48,8 @@ import (
 	"github.com/siderolabs/go-api-signature/pkg/serviceaccount"
 	"github.com/siderolabs/go-retry/retry"
 	talosconstants "github.com/siderolabs/talos/pkg/machinery/constants"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/net/http2"
@@ -80,6 +82,9 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
 	"github.com/siderolabs/omni/internal/backend/saml"
+	"github.com/siderolabs/omni/internal/backend/selfbackup"
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
+	"github.com/siderolabs/omni/internal/backend/supportbundle"
 	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 	"github.com/siderolabs/omni/internal/frontend"
 	"github.com/siderolabs/omni/internal/memconn"
@@ -111,6 +116,7 @@ type Server struct {
 	workloadProxyReconciler *workloadproxy.Reconciler
 	imageFactoryClient      *imagefactory.Client
 	auditor                 Auditor
+	sessionRecorder         *sessionrecording.Recorder
 
 	linkCounterDeltaCh chan<- siderolink.LinkCounterDeltas
 	siderolinkEventsCh chan<- *omnires.MachineStatusSnapshot
@@ -139,6 +145,7 @@ func NewServer(
 	keyFile, certFile string,
 	proxyServer Proxy,
 	auditor Auditor,
+	sessionRecorder *sessionrecording.Recorder,
 	logger *zap.Logger,
 ) (*Server, error) {
 	s := &Server{
@@ -150,6 +157,7 @@ func NewServer(
 		workloadProxyReconciler: workloadProxyReconciler,
 		imageFactoryClient:      imageFactoryClient,
 		auditor:                 auditor,
+		sessionRecorder:         sessionRecorder,
 		linkCounterDeltaCh:      linkCounterDeltaCh,
 		siderolinkEventsCh:      siderolinkEventsCh,
 		proxyServer:             proxyServer,
@@ -196,7 +204,21 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
-	mux, err := s.makeMux(oidcProvider) //nolint:contextcheck
+	etcdBackupStoreFactory := s.omniRuntime.EtcdBackupStoreFactory()
+
+	imageCache := imageFactoryCache(etcdBackupStoreFactory)
+
+	var installerRegistry *factory.Registry
+
+	if imageCache != nil && config.Config.ImageFactoryCache.InstallerRegistryEnabled() {
+		installerRegistry = &factory.Registry{
+			Cache:   imageCache,
+			Logger:  s.logger.With(logging.Component("installer_registry")),
+			BaseURL: config.Config.ImageFactoryBaseURL,
+		}
+	}
+
+	mux, err := s.makeMux(oidcProvider, imageCache, installerRegistry) //nolint:contextcheck
 	if err != nil {
 		return err
 	}
@@ -206,6 +228,43 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
+	redactor, err := supportbundle.NewRedactor(config.Config.SupportBundle.RedactPatterns...)
+	if err != nil {
+		return err
+	}
+
+	supportBundleBuilder := supportbundle.NewBuilder(runtimeState, s.logHandler, s.dnsService, redactor)
+
+	imageCachePrewarmer := &factory.Prewarmer{
+		State:    runtimeState,
+		Cache:    imageCache,
+		Registry: installerRegistry,
+		Logger:   s.logger.With(logging.Component("factory_cache_prewarmer")),
+		BaseURL:  config.Config.ImageFactoryBaseURL,
+		Images:   config.Config.ImageFactoryCache.PrewarmImages,
+		Interval: config.Config.ImageFactoryCache.PrewarmInterval,
+	}
+
+	imageCacheEvictor := &factory.Evictor{
+		Cache:  imageCache,
+		Logger: s.logger.With(logging.Component("factory_cache_evictor")),
+		MaxAge: config.Config.ImageFactoryCache.MaxAge,
+	}
+
+	supportBundleScheduler := supportbundle.NewScheduler(
+		supportBundleBuilder,
+		etcdBackupStoreFactory,
+		config.Config.SupportBundle,
+		s.logger.With(logging.Component("support_bundle_scheduler")),
+	)
+
+	selfBackupScheduler := selfbackup.NewScheduler(
+		runtimeState,
+		etcdBackupStoreFactory,
+		config.Config.SelfBackup,
+		s.logger.With(logging.Component("self_backup_scheduler")),
+	)
+
 	services := grpcomni.MakeServiceServers(
 		s.omniRuntime.State(),
 		s.omniRuntime.CachedState(),
@@ -214,8 +273,11 @@ func (s *Server) Run(ctx context.Context) error {
 		oidcStorage,
 		s.dnsService,
 		s.imageFactoryClient,
+		s.workloadProxyReconciler,
 		s.logger,
 		s.auditor,
+		s.sessionRecorder,
+		supportBundleBuilder,
 	)
 
 	actualSrv, gtwyDialsTo, err := s.serverAndGateway(ctx, services, mux, serverOptions...)
@@ -247,12 +309,17 @@ func (s *Server) Run(ctx context.Context) error {
 		func() error { return apiSrv.Run(ctx) },
 		func() error { return runMetricsServer(ctx, s.metricsBindAddress, s.logger) },
 		func() error {
-			return runK8sProxyServer(ctx, s.k8sProxyBindAddress, oidcStorage, crtData, s.omniRuntime.State(), s.auditor, s.logger)
+			return runK8sProxyServer(ctx, s.k8sProxyBindAddress, oidcStorage, crtData, s.omniRuntime.State(), s.auditor, s.sessionRecorder, s.logger)
 		},
 		func() error { return s.proxyServer.Run(ctx, apiSrv.Handler(), s.logger) },
 		func() error { return s.logHandler.Start(ctx) },
 		func() error { return s.runMachineAPI(ctx) },
 		func() error { return s.auditor.RunCleanup(ctx) },
+		func() error { return s.sessionRecorder.RunCleanup(ctx) },
+		func() error { return supportBundleScheduler.Run(ctx) },
+		func() error { return selfBackupScheduler.Run(ctx) },
+		func() error { return imageCachePrewarmer.Run(ctx) },
+		func() error { return imageCacheEvictor.Run(ctx) },
 	}
 
 	if s.pprofBindAddress != "" {
@@ -286,12 +353,25 @@ func (s *Server) Run(ctx context.Context) error {
 	return eg.Wait()
 }
 
-func (s *Server) makeMux(oidcProvider *oidc.Provider) (*http.ServeMux, error) {
+// imageFactoryCache returns the configured cache of the images generated by the image factory, or nil if it's disabled.
+func imageFactoryCache(stores factory.StoreGetter) factory.Cache {
+	switch {
+	case config.Config.ImageFactoryCache.UseBackupStore:
+		return factory.NewObjectStoreCache(stores)
+	case config.Config.ImageFactoryCache.Dir != "":
+		return factory.NewDirCache(config.Config.ImageFactoryCache.Dir)
+	default:
+		return nil
+	}
+}
+
+func (s *Server) makeMux(oidcProvider *oidc.Provider, imageCache factory.Cache, installerRegistry *factory.Registry) (*http.ServeMux, error) {
 	imageFactoryHandler := handler.NewAuthConfig(
 		handler.NewSignature(
 			&factory.Handler{
 				State:  s.omniRuntime.State(),
 				Logger: s.logger.With(logging.Component("factory_proxy")),
+				Cache:  imageCache,
 			},
 			s.authenticatorFunc(),
 			s.logger,
@@ -316,6 +396,14 @@ func (s *Server) makeMux(oidcProvider *oidc.Provider) (*http.ServeMux, error) {
 		return nil, fmt.Errorf("failed to create mux: %w", err)
 	}
 
+	// the machines pull the installer images without the authentication, the same as from the image factory
+	if installerRegistry != nil {
+		mux.Handle("/v2/", monitoring.NewHandler(
+			logging.NewHandler(installerRegistry, s.logger.With(zap.String("handler", "registry"))),
+			prometheus.Labels{"handler": "registry"},
+		))
+	}
+
 	return mux, err
 }
 
@@ -351,6 +439,7 @@ func (s *Server) makeProxyServer(ctx context.Context, eg *errgroup.Group) (*grpc
 		authres.Enabled(s.authConfig),
 		s.auditor,
 		interceptor.NewSignature(s.authenticatorFunc(), s.logger).Unary(),
+		s.sessionRecorder,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -361,6 +450,7 @@ func (s *Server) makeProxyServer(ctx context.Context, eg *errgroup.Group) (*grpc
 	eg.Go(func() error { return rtr.ResourceWatcher(ctx, s.omniRuntime.State(), s.logger) })
 
 	srv := router.NewServer(rtr,
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		router.Interceptors(s.logger),
 		grpc.ChainStreamInterceptor(
 			grpcutil.StreamSetAuditData(),
@@ -395,6 +485,7 @@ func (s *Server) buildServerOptions() ([]grpc.ServerOption, error) {
 		logLevelOverrideUnaryInterceptor,
 		grpc_zap.UnaryServerInterceptor(s.logger, grpc_zap.WithMessageProducer(messageProducer)),
 		grpcutil.SetUserAgent(),
+		grpcutil.SetTraceID(),
 		grpcutil.SetRealPeerAddress(),
 		grpcutil.SetAuditData(),
 		grpcutil.InterceptBodyToTags(
@@ -415,6 +506,7 @@ func (s *Server) buildServerOptions() ([]grpc.ServerOption, error) {
 		logLevelOverrideStreamInterceptor,
 		grpc_zap.StreamServerInterceptor(s.logger, grpc_zap.WithMessageProducer(messageProducer)),
 		grpcutil.StreamSetUserAgent(),
+		grpcutil.StreamSetTraceID(),
 		grpcutil.StreamSetRealPeerAddress(),
 		grpcutil.StreamSetAuditData(),
 		grpcutil.StreamIntercept(
@@ -446,6 +538,7 @@ func (s *Server) buildServerOptions() ([]grpc.ServerOption, error) {
 
 	return []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.ChainStreamInterceptor(streamInterceptors...),
 		grpc.SharedWriteBuffer(true),
@@ -611,7 +704,7 @@ func (s *Server) runMachineAPI(ctx context.Context) error {
 	return eg.Wait()
 }
 
-func (s *Server) workloadProxyHandler(next http.Handler) (http.Handler, error) {
+func (s *Server) workloadProxyHandler(next http.Handler) (*workloadproxy.HTTPHandler, error) {
 	roleProvider, err := workloadproxy.NewAccessPolicyRoleProvider(s.omniRuntime.State())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create access policy role provider: %w", err)
@@ -628,17 +721,25 @@ func (s *Server) workloadProxyHandler(next http.Handler) (http.Handler, error) {
 		return nil, fmt.Errorf("failed to parse API URL: %w", err)
 	}
 
-	return workloadproxy.NewHTTPHandler(
+	handler, err := workloadproxy.NewHTTPHandler(
 		next,
 		s.workloadProxyReconciler,
 		pgpSignatureValidator,
 		mainURL,
 		config.Config.WorkloadProxying.Subdomain,
 		s.logger.With(logging.Component("workload_proxy_handler")),
+		workloadproxy.WithAccessLogs(config.Config.WorkloadProxying.AccessLogs),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	prometheus.MustRegister(handler)
+
+	return handler, nil
 }
 
-func (s *Server) makeAPIServer(regular http.Handler, grpcServer *grpcServer, data *certData) *apiServer {
+func (s *Server) makeAPIServer(regular *workloadproxy.HTTPHandler, grpcServer *grpcServer, data *certData) *apiServer {
 	wrap := func(fn func(w http.ResponseWriter, req *http.Request)) http.Handler {
 		if data != nil {
 			return http.HandlerFunc(fn)
@@ -651,15 +752,16 @@ func (s *Server) makeAPIServer(regular http.Handler, grpcServer *grpcServer, dat
 	srv := &http.Server{
 		Addr: s.bindAddress,
 		Handler: wrap(func(w http.ResponseWriter, req *http.Request) {
+			// gRPC requests to the exposed services of the workload clusters are handled by the workload proxy
 			if req.ProtoMajor == 2 && strings.HasPrefix(
-				req.Header.Get("Content-Type"), "application/grpc") {
+				req.Header.Get("Content-Type"), "application/grpc") && !regular.IsWorkloadProxyRequest(req) {
 				// grpcServer provides top-level gRPC proxy handler.
 				grpcServer.ServeHTTP(w, setRealIPRequest(req))
 
 				return
 			}
 
-			// handler contains "regular" HTTP handlers
+			// handler contains the workload proxy and "regular" HTTP handlers
 			regular.ServeHTTP(w, req)
 		}),
 	}
@@ -772,9 +874,12 @@ func makeMux(imageHandler, oidcHandler http.Handler, samlHandler *samlsp.Middlew
 	mux := http.NewServeMux()
 
 	muxHandle := func(route string, handler http.Handler, value string) {
-		mux.Handle(route, monitoring.NewHandler(
-			logging.NewHandler(handler, logger.With(zap.String("handler", value))),
-			prometheus.Labels{"handler": value},
+		mux.Handle(route, otelhttp.NewHandler(
+			monitoring.NewHandler(
+				logging.NewHandler(handler, logger.With(zap.String("handler", value))),
+				prometheus.Labels{"handler": value},
+			),
+			value,
 		))
 	}
 
@@ -870,6 +975,7 @@ func runK8sProxyServer(
 	data *certData,
 	runtimeState state.State,
 	wrapper k8sproxy.MiddlewareWrapper,
+	sessionRecorder *sessionrecording.Recorder,
 	logger *zap.Logger,
 ) error {
 	keyFunc := func(_ context.Context, keyID string) (any, error) {
@@ -887,19 +993,49 @@ func runK8sProxyServer(
 		return uuid.TypedSpec().Value.Uuid, nil
 	}
 
-	k8sProxyHandler, err := k8sproxy.NewHandler(keyFunc, clusterUUIDResolver, wrapper, logger)
+	issuedTokenResolver := func(ctx context.Context, tokenID string) (*k8sproxy.IssuedToken, error) {
+		ctx = actor.MarkContextAsInternalActor(ctx)
+
+		issuedKubeconfig, resolveErr := safe.StateGetByID[*authres.IssuedKubeconfig](ctx, runtimeState, tokenID)
+		if resolveErr != nil {
+			return nil, fmt.Errorf("failed to get the issued kubeconfig: %w", resolveErr)
+		}
+
+		return &k8sproxy.IssuedToken{
+			Cluster:    issuedKubeconfig.TypedSpec().Value.Cluster,
+			Subject:    issuedKubeconfig.TypedSpec().Value.Subject,
+			Namespaces: issuedKubeconfig.TypedSpec().Value.Namespaces,
+			Revoked:    issuedKubeconfig.TypedSpec().Value.Revoked,
+		}, nil
+	}
+
+	var untrackedTokensCutoff time.Time
+
+	if config.Config.Auth.UntrackedServiceAccountTokensCutoff != "" {
+		var parseErr error
+
+		untrackedTokensCutoff, parseErr = time.Parse(time.RFC3339, config.Config.Auth.UntrackedServiceAccountTokensCutoff)
+		if parseErr != nil {
+			return fmt.Errorf("invalid untracked service account tokens cutoff: %w", parseErr)
+		}
+	}
+
+	k8sProxyHandler, err := k8sproxy.NewHandler(keyFunc, clusterUUIDResolver, issuedTokenResolver, untrackedTokensCutoff, wrapper, sessionRecorder, logger)
 	if err != nil {
 		return err
 	}
 
 	prometheus.MustRegister(k8sProxyHandler)
 
-	k8sProxy := monitoring.NewHandler(
-		logging.NewHandler(
-			k8sProxyHandler,
-			logger.With(zap.String("handler", "k8s_proxy")),
+	k8sProxy := otelhttp.NewHandler(
+		monitoring.NewHandler(
+			logging.NewHandler(
+				k8sProxyHandler,
+				logger.With(zap.String("handler", "k8s_proxy")),
+			),
+			prometheus.Labels{"handler": "k8s-proxy"},
 		),
-		prometheus.Labels{"handler": "k8s-proxy"},
+		"k8s-proxy",
 	)
 
 	k8sProxyServer := &http.Server{
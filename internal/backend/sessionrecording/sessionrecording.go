@@ -0,0 +1,454 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package sessionrecording records the interactive Talos and Kubernetes sessions proxied through Omni.
+//
+// Each session is stored in two files: the session metadata, and the recorded events encrypted with age for the configured recipient.
+// Omni doesn't hold the matching identity, so the recordings can only be decrypted by the client replaying them.
+package sessionrecording
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"filippo.io/age"
+	"go.uber.org/zap"
+
+	pkgsessionrecording "github.com/siderolabs/omni/client/pkg/sessionrecording"
+)
+
+const (
+	fileExtension         = ".session"
+	metadataFileExtension = ".meta"
+)
+
+// Session types.
+const (
+	TypeTalos      = "talos"
+	TypeKubernetes = "kubernetes"
+)
+
+// Event directions.
+const (
+	DirectionRequest  = pkgsessionrecording.DirectionRequest
+	DirectionResponse = pkgsessionrecording.DirectionResponse
+	DirectionStatus   = pkgsessionrecording.DirectionStatus
+)
+
+// ErrNotFound is returned when the session recording doesn't exist.
+var ErrNotFound = errors.New("session recording not found")
+
+// Metadata describes the recorded session.
+type Metadata struct {
+	StartTime time.Time `json:"start_time"`
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Cluster   string    `json:"cluster"`
+	Identity  string    `json:"identity,omitempty"`
+	Method    string    `json:"method"`
+	Nodes     string    `json:"nodes,omitempty"`
+	Size      int64     `json:"-"`
+}
+
+// Recorder creates and lists the session recordings.
+//
+// A nil Recorder is valid and records nothing.
+type Recorder struct {
+	recipient *age.X25519Recipient
+	logger    *zap.Logger
+	open      map[string]struct{}
+	dir       string
+	clusters  []string
+	retention time.Duration
+	mu        sync.Mutex
+}
+
+// NewRecorder creates a new Recorder storing the sessions in the given directory.
+//
+// The sessions are encrypted for the age X25519 recipient, the matching identity is not required to record them.
+// Only the sessions of the clusters matching the patterns are recorded, all clusters are recorded if no patterns are given.
+func NewRecorder(dir, recipient string, clusters []string, retention time.Duration, logger *zap.Logger) (*Recorder, error) {
+	if recipient == "" {
+		return nil, errors.New("session recording recipient is not set")
+	}
+
+	parsedRecipient, err := age.ParseX25519Recipient(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session recording recipient: %w", err)
+	}
+
+	for _, pattern := range clusters {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid session recording cluster pattern %q: %w", pattern, err)
+		}
+	}
+
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create session recording directory: %w", err)
+	}
+
+	return &Recorder{
+		recipient: parsedRecipient,
+		logger:    logger,
+		open:      map[string]struct{}{},
+		dir:       dir,
+		clusters:  clusters,
+		retention: retention,
+	}, nil
+}
+
+// ShouldRecord returns true if the sessions of the cluster should be recorded.
+func (r *Recorder) ShouldRecord(cluster string) bool {
+	if r == nil {
+		return false
+	}
+
+	if len(r.clusters) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(r.clusters, func(pattern string) bool {
+		matches, _ := path.Match(pattern, cluster) //nolint:errcheck
+
+		return matches
+	})
+}
+
+// Start starts recording a new session.
+func (r *Recorder) Start(md Metadata) (*Session, error) {
+	id := make([]byte, 16)
+
+	if _, err := io.ReadFull(rand.Reader, id); err != nil {
+		return nil, err
+	}
+
+	if md.StartTime.IsZero() {
+		md.StartTime = time.Now()
+	}
+
+	md.ID = md.StartTime.UTC().Format("20060102T150405Z") + "-" + hex.EncodeToString(id)
+
+	if err := r.writeMetadata(md); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filepath.Join(r.dir, md.ID+fileExtension), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session recording: %w", err)
+	}
+
+	encrypter, err := age.Encrypt(f, r.recipient)
+	if err != nil {
+		f.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("failed to create session recording encrypter: %w", err)
+	}
+
+	r.mu.Lock()
+	r.open[md.ID] = struct{}{}
+	r.mu.Unlock()
+
+	return &Session{
+		file:      f,
+		encrypter: encrypter,
+		encoder:   json.NewEncoder(encrypter),
+		logger:    r.logger.With(zap.String("session_id", md.ID)),
+		onClose: func() {
+			r.mu.Lock()
+			delete(r.open, md.ID)
+			r.mu.Unlock()
+		},
+	}, nil
+}
+
+// writeMetadata writes the session metadata to a separate file, so that the sessions can be listed without decrypting them.
+func (r *Recorder) writeMetadata(md Metadata) error {
+	f, err := os.OpenFile(filepath.Join(r.dir, md.ID+metadataFileExtension), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create session recording metadata: %w", err)
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	if err = json.NewEncoder(f).Encode(md); err != nil {
+		return fmt.Errorf("failed to write session recording metadata: %w", err)
+	}
+
+	return f.Close()
+}
+
+func (r *Recorder) readMetadata(id string) (Metadata, error) {
+	f, err := os.Open(filepath.Join(r.dir, id+metadataFileExtension))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Metadata{}, ErrNotFound
+		}
+
+		return Metadata{}, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var md Metadata
+
+	if err = json.NewDecoder(f).Decode(&md); err != nil {
+		return Metadata{}, fmt.Errorf("failed to read session recording metadata: %w", err)
+	}
+
+	info, err := os.Stat(filepath.Join(r.dir, id+fileExtension))
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	md.Size = info.Size()
+
+	return md, nil
+}
+
+// List returns the metadata of all session recordings sorted by the start time.
+func (r *Recorder) List() ([]Metadata, error) {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	recordings := make([]Metadata, 0, len(entries))
+
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), metadataFileExtension)
+		if !ok || entry.IsDir() {
+			continue
+		}
+
+		md, err := r.readMetadata(id)
+		if err != nil {
+			r.logger.Warn("failed to read session recording", zap.String("session_id", id), zap.Error(err))
+
+			continue
+		}
+
+		recordings = append(recordings, md)
+	}
+
+	slices.SortFunc(recordings, func(a, b Metadata) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	return recordings, nil
+}
+
+// Open opens the encrypted session recording for reading.
+//
+// The recording can be decrypted with the identity matching the recipient by [pkgsessionrecording.NewReader].
+func (r *Recorder) Open(id string) (Metadata, io.ReadCloser, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) || strings.HasPrefix(id, ".") {
+		return Metadata{}, nil, fmt.Errorf("invalid session recording id %q", id)
+	}
+
+	md, err := r.readMetadata(id)
+	if err != nil {
+		return Metadata{}, nil, err
+	}
+
+	f, err := os.Open(filepath.Join(r.dir, id+fileExtension))
+	if err != nil {
+		return Metadata{}, nil, err
+	}
+
+	return md, f, nil
+}
+
+// RunCleanup removes the session recordings older than the retention period once an hour.
+func (r *Recorder) RunCleanup(ctx context.Context) error {
+	if r == nil || r.retention == 0 {
+		<-ctx.Done()
+
+		return nil
+	}
+
+	for {
+		if err := r.cleanup(time.Now().Add(-r.retention)); err != nil {
+			r.logger.Warn("failed to cleanup old session recordings", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Hour):
+		}
+	}
+}
+
+// cleanup removes the recordings of the sessions which were last active before the given time.
+//
+// The metadata and the events of a session are removed together, and the sessions which are still being recorded are kept.
+func (r *Recorder) cleanup(before time.Time) error {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+
+	lastActive := map[string]time.Time{}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		id, ok := strings.CutSuffix(entry.Name(), fileExtension)
+		if !ok {
+			if id, ok = strings.CutSuffix(entry.Name(), metadataFileExtension); !ok {
+				continue
+			}
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			errs = errors.Join(errs, err)
+
+			continue
+		}
+
+		if info.ModTime().After(lastActive[id]) {
+			lastActive[id] = info.ModTime()
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, modTime := range lastActive {
+		if _, open := r.open[id]; open || modTime.After(before) {
+			continue
+		}
+
+		for _, ext := range []string{fileExtension, metadataFileExtension} {
+			if err = os.Remove(filepath.Join(r.dir, id+ext)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				errs = errors.Join(errs, err)
+			}
+		}
+	}
+
+	return errs
+}
+
+// Session is a session being recorded.
+//
+// Session methods are safe to be called concurrently.
+type Session struct {
+	file      *os.File
+	encrypter io.WriteCloser
+	encoder   *json.Encoder
+	logger    *zap.Logger
+	onClose   func()
+	mu        sync.Mutex
+	closed    bool
+}
+
+// Record records a chunk of data sent in the given direction.
+//
+// The recording errors are logged, they never interrupt the session.
+func (s *Session) Record(direction string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	if err := s.encoder.Encode(pkgsessionrecording.Event{Time: time.Now(), Direction: direction, Data: data}); err != nil {
+		s.logger.Error("failed to record session event", zap.Error(err))
+	}
+}
+
+// Close finishes the recording, recording the final status of the session.
+func (s *Session) Close(sessionErr error) error {
+	status := "OK"
+
+	if sessionErr != nil && !errors.Is(sessionErr, io.EOF) {
+		status = sessionErr.Error()
+	}
+
+	s.Record(DirectionStatus, []byte(status))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	defer s.onClose()
+
+	return errors.Join(s.encrypter.Close(), s.file.Close())
+}
+
+// StreamRecorder wraps a bidirectional stream, recording the data passing through it.
+//
+// Reads are recorded as the responses, writes as the requests.
+type StreamRecorder struct {
+	io.ReadWriteCloser
+
+	session   *Session
+	closeOnce sync.Once
+}
+
+// NewStreamRecorder creates a new StreamRecorder.
+func NewStreamRecorder(stream io.ReadWriteCloser, session *Session) *StreamRecorder {
+	return &StreamRecorder{
+		ReadWriteCloser: stream,
+		session:         session,
+	}
+}
+
+// Read implements io.Reader.
+func (s *StreamRecorder) Read(p []byte) (int, error) {
+	n, err := s.ReadWriteCloser.Read(p)
+	if n > 0 {
+		s.session.Record(DirectionResponse, p[:n])
+	}
+
+	return n, err
+}
+
+// Write implements io.Writer.
+func (s *StreamRecorder) Write(p []byte) (int, error) {
+	n, err := s.ReadWriteCloser.Write(p)
+	if n > 0 {
+		s.session.Record(DirectionRequest, p[:n])
+	}
+
+	return n, err
+}
+
+// Close implements io.Closer.
+func (s *StreamRecorder) Close() error {
+	err := s.ReadWriteCloser.Close()
+
+	s.closeOnce.Do(func() {
+		if closeErr := s.session.Close(nil); closeErr != nil {
+			s.session.logger.Error("failed to close session recording", zap.Error(closeErr))
+		}
+	})
+
+	return err
+}
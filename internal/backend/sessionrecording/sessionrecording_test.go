@@ -0,0 +1,268 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package sessionrecording_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	pkgsessionrecording "github.com/siderolabs/omni/client/pkg/sessionrecording"
+	"github.com/siderolabs/omni/internal/backend/sessionrecording"
+)
+
+type nopReadWriteCloser struct {
+	io.Reader
+	io.Writer
+}
+
+func (nopReadWriteCloser) Close() error { return nil }
+
+func newRecorder(t *testing.T, dir string, clusters []string) (*sessionrecording.Recorder, *age.X25519Identity) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	recorder, err := sessionrecording.NewRecorder(dir, identity.Recipient().String(), clusters, time.Hour, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	return recorder, identity
+}
+
+func readEvents(t *testing.T, recorder *sessionrecording.Recorder, identity age.Identity, id string) (sessionrecording.Metadata, []pkgsessionrecording.Event) {
+	md, recording, err := recorder.Open(id)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, recording.Close()) })
+
+	reader, err := pkgsessionrecording.NewReader(recording, identity)
+	require.NoError(t, err)
+
+	var events []pkgsessionrecording.Event
+
+	for {
+		event, nextErr := reader.Next()
+		if errors.Is(nextErr, io.EOF) {
+			break
+		}
+
+		require.NoError(t, nextErr)
+
+		events = append(events, event)
+	}
+
+	return md, events
+}
+
+func TestRecordAndRead(t *testing.T) {
+	dir := t.TempDir()
+
+	recorder, identity := newRecorder(t, dir, nil)
+
+	session, err := recorder.Start(sessionrecording.Metadata{
+		Type:     sessionrecording.TypeTalos,
+		Cluster:  "cluster-1",
+		Identity: "user@example.com",
+		Method:   "machine.MachineService/Reboot",
+		Nodes:    "1.1.1.1",
+	})
+	require.NoError(t, err)
+
+	session.Record(sessionrecording.DirectionRequest, []byte("request"))
+	session.Record(sessionrecording.DirectionResponse, []byte("response"))
+
+	require.NoError(t, session.Close(errors.New("connection reset")))
+
+	// the recorded events are encrypted
+	files, err := filepath.Glob(filepath.Join(dir, "*.session"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+
+	assert.False(t, bytes.Contains(data, []byte("request")))
+
+	recordings, err := recorder.List()
+	require.NoError(t, err)
+	require.Len(t, recordings, 1)
+
+	md := recordings[0]
+	assert.Equal(t, "cluster-1", md.Cluster)
+	assert.Equal(t, "user@example.com", md.Identity)
+	assert.Equal(t, "machine.MachineService/Reboot", md.Method)
+	assert.Equal(t, "1.1.1.1", md.Nodes)
+	assert.NotZero(t, md.Size)
+
+	openedMD, events := readEvents(t, recorder, identity, md.ID)
+
+	assert.Equal(t, md.ID, openedMD.ID)
+
+	require.Len(t, events, 3)
+
+	assert.Equal(t, sessionrecording.DirectionRequest, events[0].Direction)
+	assert.Equal(t, []byte("request"), events[0].Data)
+	assert.Equal(t, sessionrecording.DirectionResponse, events[1].Direction)
+	assert.Equal(t, []byte("response"), events[1].Data)
+	assert.Equal(t, sessionrecording.DirectionStatus, events[2].Direction)
+	assert.Equal(t, []byte("connection reset"), events[2].Data)
+
+	_, _, err = recorder.Open("../" + md.ID)
+	require.Error(t, err)
+
+	_, _, err = recorder.Open("missing")
+	require.ErrorIs(t, err, sessionrecording.ErrNotFound)
+
+	// the recording can't be decrypted without the identity matching the recipient
+	otherIdentity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	_, recording, err := recorder.Open(md.ID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, recording.Close()) })
+
+	_, err = pkgsessionrecording.NewReader(recording, otherIdentity)
+	require.Error(t, err)
+}
+
+func TestNewRecorderRecipient(t *testing.T) {
+	_, err := sessionrecording.NewRecorder(t.TempDir(), "", nil, time.Hour, zaptest.NewLogger(t))
+	require.EqualError(t, err, "session recording recipient is not set")
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	// the identity is never accepted in place of the recipient
+	_, err = sessionrecording.NewRecorder(t.TempDir(), identity.String(), nil, time.Hour, zaptest.NewLogger(t))
+	require.ErrorContains(t, err, "invalid session recording recipient")
+}
+
+func TestStreamRecorder(t *testing.T) {
+	recorder, identity := newRecorder(t, t.TempDir(), nil)
+
+	session, err := recorder.Start(sessionrecording.Metadata{Type: sessionrecording.TypeKubernetes, Cluster: "cluster-1"})
+	require.NoError(t, err)
+
+	var written bytes.Buffer
+
+	stream := sessionrecording.NewStreamRecorder(nopReadWriteCloser{Reader: bytes.NewBufferString("stdout"), Writer: &written}, session)
+
+	_, err = stream.Write([]byte("stdin"))
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(stream)
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Close())
+	require.NoError(t, stream.Close())
+
+	assert.Equal(t, "stdout", string(data))
+	assert.Equal(t, "stdin", written.String())
+
+	recordings, err := recorder.List()
+	require.NoError(t, err)
+	require.Len(t, recordings, 1)
+
+	_, events := readEvents(t, recorder, identity, recordings[0].ID)
+
+	directions := make([]string, 0, len(events))
+
+	for _, event := range events {
+		directions = append(directions, event.Direction)
+	}
+
+	assert.Equal(t, []string{sessionrecording.DirectionRequest, sessionrecording.DirectionResponse, sessionrecording.DirectionStatus}, directions)
+}
+
+func TestShouldRecord(t *testing.T) {
+	var nilRecorder *sessionrecording.Recorder
+
+	assert.False(t, nilRecorder.ShouldRecord("cluster-1"))
+
+	recorder, identity := newRecorder(t, t.TempDir(), nil)
+
+	assert.True(t, recorder.ShouldRecord("cluster-1"))
+
+	recorder, _ = newRecorder(t, t.TempDir(), []string{"prod-*", "staging"})
+
+	assert.True(t, recorder.ShouldRecord("prod-1"))
+	assert.True(t, recorder.ShouldRecord("staging"))
+	assert.False(t, recorder.ShouldRecord("staging-2"))
+
+	_, err := sessionrecording.NewRecorder(t.TempDir(), identity.Recipient().String(), []string{"["}, time.Hour, zaptest.NewLogger(t))
+	require.Error(t, err)
+}
+
+func TestCleanup(t *testing.T) {
+	dir := t.TempDir()
+
+	recorder, _ := newRecorder(t, dir, nil)
+
+	for range 3 {
+		session, startErr := recorder.Start(sessionrecording.Metadata{Type: sessionrecording.TypeTalos})
+		require.NoError(t, startErr)
+
+		require.NoError(t, session.Close(nil))
+	}
+
+	// the session which is still being recorded is kept even if it was started before the retention period
+	openSession, err := recorder.Start(sessionrecording.Metadata{Type: sessionrecording.TypeTalos})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, openSession.Close(nil)) })
+
+	recordings, err := recorder.List()
+	require.NoError(t, err)
+	require.Len(t, recordings, 4)
+
+	old := time.Now().Add(-2 * time.Hour)
+
+	setModTime := func(id, pattern string) {
+		files, globErr := filepath.Glob(filepath.Join(dir, id+pattern))
+		require.NoError(t, globErr)
+		require.NotEmpty(t, files)
+
+		for _, file := range files {
+			require.NoError(t, os.Chtimes(file, old, old))
+		}
+	}
+
+	setModTime(recordings[0].ID, ".*")
+	setModTime(recordings[3].ID, ".*")
+
+	// the session started before the retention period, but it was active recently
+	setModTime(recordings[1].ID, ".meta")
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errCh := make(chan error, 1)
+
+	go func() { errCh <- recorder.RunCleanup(ctx) }()
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		remaining, listErr := recorder.List()
+		require.NoError(collect, listErr)
+
+		assert.Equal(collect, []string{recordings[1].ID, recordings[2].ID, recordings[3].ID}, xslices.Map(remaining, func(md sessionrecording.Metadata) string {
+			return md.ID
+		}))
+	}, 10*time.Second, 50*time.Millisecond)
+
+	cancel()
+
+	require.NoError(t, <-errCh)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package supportbundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"sync"
+)
+
+// Redacted is the placeholder which replaces the redacted data.
+const Redacted = "<redacted>"
+
+// DefaultRedactPatterns are always applied to the support bundles.
+//
+// If a pattern has a capture group, only the first group is redacted, otherwise the whole match is.
+var DefaultRedactPatterns = []string{
+	// PEM encoded private keys
+	`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`,
+	// bearer tokens in the HTTP headers and the logs
+	`(?i)\bbearer\s+([a-z0-9\-._~+/]+=*)`,
+	// YAML, JSON and environment style key-value pairs with secret names
+	`(?i)(?:token|secret|password|passwd|private[_-]?key|api[_-]?key|access[_-]?key|credentials?)"?\s*[:=]\s*"?([^\s",]+)`,
+}
+
+// Redactor removes the secrets from the support bundle contents.
+type Redactor struct {
+	rules []*regexp.Regexp
+}
+
+// NewRedactor initializes a Redactor with the default patterns and the additional ones.
+func NewRedactor(patterns ...string) (*Redactor, error) {
+	redactor := &Redactor{}
+
+	for _, pattern := range slices.Concat(DefaultRedactPatterns, patterns) {
+		rule, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile the redact pattern %q: %w", pattern, err)
+		}
+
+		redactor.rules = append(redactor.rules, rule)
+	}
+
+	return redactor, nil
+}
+
+// Redact returns the data with all matches of the rules replaced by the placeholder.
+func (r *Redactor) Redact(data []byte) []byte {
+	for _, rule := range r.rules {
+		matches := rule.FindAllSubmatchIndex(data, -1)
+		if len(matches) == 0 {
+			continue
+		}
+
+		var (
+			result bytes.Buffer
+			last   int
+		)
+
+		for _, match := range matches {
+			start, end := match[0], match[1]
+
+			// redact only the first capture group if the rule has it, and it has matched
+			if len(match) >= 4 && match[2] >= 0 {
+				start, end = match[2], match[3]
+			}
+
+			result.Write(data[last:start])
+			result.WriteString(Redacted)
+
+			last = end
+		}
+
+		result.Write(data[last:])
+
+		data = result.Bytes()
+	}
+
+	return data
+}
+
+// archive is a thread safe zip archive which redacts the files written to it.
+//
+// It implements [bundle.Archive].
+type archive struct {
+	writer   *zip.Writer
+	redactor *Redactor
+	mu       sync.Mutex
+}
+
+func newArchive(w io.Writer, redactor *Redactor) *archive {
+	return &archive{
+		writer:   zip.NewWriter(w),
+		redactor: redactor,
+	}
+}
+
+// Write creates a file in the archive.
+func (a *archive) Write(path string, contents []byte) error {
+	if a.redactor != nil {
+		contents = a.redactor.Redact(contents)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	file, err := a.writer.Create(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(contents)
+
+	return err
+}
+
+// Close finalizes the archive.
+func (a *archive) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.writer.Close()
+}
+
+// sharedArchive is passed to the bundle runs which write to the same archive, so that they don't finalize it.
+type sharedArchive struct {
+	*archive
+}
+
+// Close implements [bundle.Archive].
+func (sharedArchive) Close() error {
+	return nil
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package supportbundle
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-talos-support/support/bundle"
+	"github.com/siderolabs/go-talos-support/support/collectors"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	kubernetesruntime "github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
+	slink "github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+func writeResource(res resource.Resource) *collectors.Collector {
+	filename := fmt.Sprintf("omni/resources/%s-%s.yaml", res.Metadata().Type(), res.Metadata().ID())
+
+	return collectors.NewCollector(filename, func(context.Context, *bundle.Options) ([]byte, error) {
+		raw, err := resource.MarshalYAML(res)
+		if err != nil {
+			return nil, err
+		}
+
+		return yaml.Marshal(raw)
+	})
+}
+
+func (b *Builder) collectLogs(machineID string) *collectors.Collector {
+	filename := fmt.Sprintf("omni/machine-logs/%s.log", machineID)
+
+	return collectors.NewCollector(filename, func(context.Context, *bundle.Options) ([]byte, error) {
+		r, err := b.logHandler.GetReader(slink.MachineID(machineID), false, optional.None[int32]())
+		if err != nil {
+			if slink.IsBufferNotFoundError(err) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		defer r.Close() //nolint:errcheck
+
+		var b bytes.Buffer
+
+		w := bufio.NewWriter(&b)
+
+		for {
+			l, err := r.ReadLine()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+
+				return nil, err
+			}
+
+			_, err = w.WriteString(string(l) + "\n")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return b.Bytes(), nil
+	})
+}
+
+//nolint:gocognit
+func (b *Builder) collectClusterResources(ctx context.Context, cluster string) ([]resource.Resource, error) {
+	st := b.state
+
+	//nolint:prealloc
+	var resources []resource.Resource
+
+	clusterQuery := []state.ListOption{
+		state.WithLabelQuery(
+			resource.LabelEqual(omni.LabelCluster, cluster),
+		),
+	}
+
+	resourcesToGet := []struct {
+		rt          resource.Type
+		id          resource.ID
+		listOptions []state.ListOption
+	}{
+		{
+			rt: omni.ClusterType,
+			id: cluster,
+		},
+		{
+			rt: omni.ClusterTaintType,
+			id: cluster,
+		},
+		{
+			rt: omni.ClusterStatusType,
+			id: cluster,
+		},
+		{
+			rt: omni.KubernetesUpgradeStatusType,
+			id: cluster,
+		},
+		{
+			rt: omni.TalosUpgradeStatusType,
+			id: cluster,
+		},
+		{
+			rt: omni.LoadBalancerStatusType,
+			id: cluster,
+		},
+		{
+			rt: omni.KubernetesUpgradeManifestStatusType,
+			id: cluster,
+		},
+		{
+			rt: omni.ClusterBootstrapStatusType,
+			id: cluster,
+		},
+		{
+			rt:          omni.MachineSetType,
+			listOptions: clusterQuery,
+		},
+		{
+			rt:          omni.MachineSetStatusType,
+			listOptions: clusterQuery,
+		},
+		{
+			rt:          omni.MachineSetNodeType,
+			listOptions: clusterQuery,
+		},
+		{
+			rt:          omni.ClusterMachineType,
+			listOptions: clusterQuery,
+		},
+		{
+			rt:          omni.ClusterMachineStatusType,
+			listOptions: clusterQuery,
+		},
+		{
+			rt:          omni.RedactedClusterMachineConfigType,
+			listOptions: clusterQuery,
+		},
+		{
+			rt:          omni.ClusterMachineTalosVersionType,
+			listOptions: clusterQuery,
+		},
+		{
+			rt:          omni.ClusterMachineIdentityType,
+			listOptions: clusterQuery,
+		},
+		{
+			rt:          omni.SchematicConfigurationType,
+			listOptions: clusterQuery,
+		},
+		{
+			rt:          omni.ExtensionsConfigurationType,
+			listOptions: clusterQuery,
+		},
+	}
+
+	machineIDs := map[string]struct{}{}
+
+	for _, r := range resourcesToGet {
+		rd, err := safe.ReaderGetByID[*meta.ResourceDefinition](ctx, st, strings.ToLower(r.rt))
+		if err != nil {
+			return nil, err
+		}
+
+		md := resource.NewMetadata(rd.TypedSpec().DefaultNamespace, r.rt, r.id, resource.VersionUndefined)
+
+		if md.ID() == "" {
+			var list resource.List
+
+			list, err = st.List(ctx, md, r.listOptions...)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, list.Items...)
+
+			switch md.Type() {
+			case omni.ClusterMachineType:
+				fallthrough
+			case omni.MachineSetNodeType:
+				for _, res := range list.Items {
+					machineIDs[res.Metadata().ID()] = struct{}{}
+				}
+			}
+
+			continue
+		}
+
+		res, err := st.Get(ctx, md)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		resources = append(resources, res)
+	}
+
+	for id := range machineIDs {
+		machineResources, err := b.collectMachineResources(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, machineResources...)
+	}
+
+	return resources, nil
+}
+
+// collectUnassignedResources collects the resources of the machines which are not part of any cluster.
+func (b *Builder) collectUnassignedResources(ctx context.Context) ([]resource.Resource, error) {
+	machineStatuses, err := safe.ReaderListAll[*omni.MachineStatus](ctx, b.state)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []resource.Resource
+
+	for machineStatus := range machineStatuses.All() {
+		if _, ok := machineStatus.Metadata().Labels().Get(omni.LabelCluster); ok {
+			continue
+		}
+
+		machineResources, err := b.collectMachineResources(ctx, machineStatus.Metadata().ID())
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, machineResources...)
+	}
+
+	return resources, nil
+}
+
+func (b *Builder) collectMachineResources(ctx context.Context, id resource.ID) ([]resource.Resource, error) {
+	link, err := safe.ReaderGetByID[*siderolink.Link](ctx, b.state, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	resources := []resource.Resource{link}
+
+	machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, b.state, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return resources, nil
+		}
+
+		return nil, err
+	}
+
+	resources = append(resources, machineStatus)
+
+	labels, err := safe.ReaderGetByID[*system.ResourceLabels[*omni.MachineStatus]](ctx, b.state, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return resources, nil
+		}
+
+		return nil, err
+	}
+
+	return append(resources, labels), nil
+}
+
+func (b *Builder) getTalosClient(ctx context.Context, clusterName string) (*client.Client, error) {
+	talosConfig, err := safe.ReaderGetByID[*omni.TalosConfig](ctx, b.state, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := safe.ReaderGetByID[*omni.ClusterEndpoint](ctx, b.state, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(ctx,
+		client.WithCluster(clusterName),
+		client.WithConfig(omni.NewTalosClientConfig(talosConfig, endpoints.TypedSpec().Value.ManagementAddresses...)),
+	)
+}
+
+func (b *Builder) getKubernetesClient(ctx context.Context, clusterName string) (*kubernetes.Clientset, error) {
+	type kubeRuntime interface {
+		GetClient(ctx context.Context, cluster string) (*kubernetesruntime.Client, error)
+	}
+
+	k8s, err := runtime.LookupInterface[kubeRuntime](kubernetesruntime.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := k8s.GetClient(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Clientset(), nil
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package supportbundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"filippo.io/age"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// StorePrefix is the key prefix of the support bundles in the etcd backup store.
+const StorePrefix = "support-bundles/"
+
+// StoreGetter returns the etcd backup store the scheduled bundles are uploaded to.
+type StoreGetter interface {
+	GetStore() (etcdbackup.Store, error)
+}
+
+// request is a single scheduled bundle.
+type request struct {
+	key  string
+	opts Options
+}
+
+// Scheduler periodically collects the fleet-wide support bundles and the bundles of the clusters which become unhealthy,
+// uploads them encrypted to the etcd backup store and removes the bundles older than the retention period.
+type Scheduler struct {
+	builder  *Builder
+	stores   StoreGetter
+	logger   *zap.Logger
+	healthy  map[string]bool
+	lastTime map[string]time.Time
+	params   config.SupportBundleParams
+}
+
+// NewScheduler initializes Scheduler.
+func NewScheduler(builder *Builder, stores StoreGetter, params config.SupportBundleParams, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		builder:  builder,
+		stores:   stores,
+		params:   params,
+		logger:   logger,
+		healthy:  map[string]bool{},
+		lastTime: map[string]time.Time{},
+	}
+}
+
+// Run the scheduler until the context is canceled.
+func (s *Scheduler) Run(ctx context.Context) error {
+	if s.params.Interval == 0 && !s.params.OnUnhealthy {
+		<-ctx.Done()
+
+		return nil
+	}
+
+	// the bundles contain the cluster details, so they are stored only encrypted, the same as the etcd backups
+	recipient, err := age.ParseX25519Recipient(s.params.Recipient)
+	if err != nil {
+		return fmt.Errorf("invalid support bundle recipient: %w", err)
+	}
+
+	// the bundles are collected in the background, so that the cluster status watch is not blocked
+	requests := make(chan request, 8)
+
+	eg, ctx := panichandler.ErrGroupWithContext(ctx)
+
+	eg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case req := <-requests:
+				if err := s.upload(ctx, req, recipient); err != nil {
+					s.logger.Warn("failed to store the support bundle", zap.String("key", req.key), zap.Error(err))
+				}
+			}
+		}
+	})
+
+	eg.Go(func() error {
+		return s.schedule(ctx, requests)
+	})
+
+	return eg.Wait()
+}
+
+func (s *Scheduler) schedule(ctx context.Context, requests chan<- request) error {
+	var (
+		tickerCh <-chan time.Time
+		events   = make(chan state.Event)
+	)
+
+	if s.params.Interval > 0 {
+		ticker := time.NewTicker(s.params.Interval)
+		defer ticker.Stop()
+
+		tickerCh = ticker.C
+	}
+
+	if s.params.OnUnhealthy {
+		if err := s.builder.state.WatchKind(ctx, omni.NewClusterStatus(resources.DefaultNamespace, "").Metadata(), events, state.WithBootstrapContents(true)); err != nil {
+			return fmt.Errorf("failed to watch cluster statuses: %w", err)
+		}
+	}
+
+	enqueue := func(req request) {
+		select {
+		case requests <- req:
+		default:
+			s.logger.Warn("skipped the support bundle, too many bundles are being collected", zap.String("key", req.key))
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-tickerCh:
+			req, err := s.fleetRequest(ctx, now)
+			if err != nil {
+				s.logger.Warn("failed to schedule the fleet-wide support bundle", zap.Error(err))
+
+				continue
+			}
+
+			enqueue(req)
+		case event := <-events:
+			switch event.Type {
+			case state.Errored:
+				return fmt.Errorf("cluster status watch failed: %w", event.Error)
+			case state.Bootstrapped:
+			case state.Destroyed:
+				delete(s.healthy, event.Resource.Metadata().ID())
+				delete(s.lastTime, event.Resource.Metadata().ID())
+			case state.Created, state.Updated:
+				clusterStatus, ok := event.Resource.(*omni.ClusterStatus)
+				if !ok {
+					return fmt.Errorf("unexpected resource type %T", event.Resource)
+				}
+
+				if req, ok := s.unhealthyRequest(clusterStatus, time.Now()); ok {
+					s.logger.Info("cluster became unhealthy, collecting the support bundle", zap.String("cluster", clusterStatus.Metadata().ID()))
+
+					enqueue(req)
+				}
+			}
+		}
+	}
+}
+
+func (s *Scheduler) fleetRequest(ctx context.Context, now time.Time) (request, error) {
+	clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, s.builder.state)
+	if err != nil {
+		return request{}, err
+	}
+
+	opts := Options{
+		Unassigned: true,
+	}
+
+	for cluster := range clusters.All() {
+		opts.Clusters = append(opts.Clusters, cluster.Metadata().ID())
+	}
+
+	return request{
+		key:  FleetKey(now),
+		opts: opts,
+	}, nil
+}
+
+// unhealthyRequest returns the bundle request if the cluster transitioned from healthy to unhealthy and the cooldown period has passed.
+func (s *Scheduler) unhealthyRequest(clusterStatus *omni.ClusterStatus, now time.Time) (request, bool) {
+	cluster := clusterStatus.Metadata().ID()
+	spec := clusterStatus.TypedSpec().Value
+
+	// scaling and destroying clusters are expected to be not ready
+	if spec.Phase != specs.ClusterStatusSpec_RUNNING {
+		return request{}, false
+	}
+
+	wasHealthy, known := s.healthy[cluster]
+
+	s.healthy[cluster] = spec.Ready
+
+	if !known || !wasHealthy || spec.Ready {
+		return request{}, false
+	}
+
+	if last, ok := s.lastTime[cluster]; ok && now.Sub(last) < s.params.UnhealthyCooldown {
+		return request{}, false
+	}
+
+	s.lastTime[cluster] = now
+
+	return request{
+		key: ClusterKey(cluster, now),
+		opts: Options{
+			Clusters: []string{cluster},
+		},
+	}, true
+}
+
+func (s *Scheduler) upload(ctx context.Context, req request, recipient age.Recipient) error {
+	store, err := s.stores.GetStore()
+	if err != nil {
+		return err
+	}
+
+	objectStore, ok := store.(etcdbackup.ObjectStore)
+	if !ok {
+		return etcdbackup.ErrObjectStoreNotSupported
+	}
+
+	reader, writer := io.Pipe()
+
+	eg := panichandler.NewErrGroup()
+
+	eg.Go(func() error {
+		err := s.build(ctx, writer, req.opts, recipient)
+
+		writer.CloseWithError(err)
+
+		return err
+	})
+
+	eg.Go(func() error {
+		err := objectStore.PutObject(ctx, req.key, reader)
+
+		reader.CloseWithError(err)
+
+		return err
+	})
+
+	if err = eg.Wait(); err != nil {
+		return err
+	}
+
+	s.logger.Info("stored the support bundle", zap.String("key", req.key))
+
+	if s.params.Retention == 0 {
+		return nil
+	}
+
+	return Prune(ctx, objectStore, time.Now().Add(-s.params.Retention))
+}
+
+func (s *Scheduler) build(ctx context.Context, w io.Writer, opts Options, recipient age.Recipient) error {
+	encrypter, err := age.Encrypt(w, recipient)
+	if err != nil {
+		return err
+	}
+
+	if err = s.builder.Build(ctx, encrypter, opts); err != nil {
+		return err
+	}
+
+	return encrypter.Close()
+}
+
+// Prune removes the stored support bundles older than the given time.
+func Prune(ctx context.Context, objectStore etcdbackup.ObjectStore, before time.Time) error {
+	objects, err := objectStore.ListObjects(ctx, StorePrefix)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+
+	for _, object := range objects {
+		if object.Timestamp.Before(before) {
+			errs = errors.Join(errs, objectStore.DeleteObject(ctx, object.Key))
+		}
+	}
+
+	return errs
+}
+
+// FleetKey returns the store key of the fleet-wide bundle.
+func FleetKey(timestamp time.Time) string {
+	return path.Join(StorePrefix, "fleet", bundleName(timestamp))
+}
+
+// ClusterKey returns the store key of the cluster bundle.
+func ClusterKey(cluster string, timestamp time.Time) string {
+	return path.Join(StorePrefix, "clusters", cluster, bundleName(timestamp))
+}
+
+func bundleName(timestamp time.Time) string {
+	return timestamp.UTC().Format("20060102T150405Z") + ".zip.age"
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package supportbundle_test
+
+import (
+	"context"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/supportbundle"
+)
+
+type memoryStore struct {
+	objects map[string]etcdbackup.ObjectInfo
+}
+
+func (m *memoryStore) PutObject(_ context.Context, key string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	m.objects[key] = etcdbackup.ObjectInfo{Key: key, Timestamp: time.Now(), Size: int64(len(data))}
+
+	return nil
+}
+
+func (m *memoryStore) GetObject(context.Context, string) (io.ReadCloser, error) {
+	return nil, etcdbackup.ErrObjectNotFound
+}
+
+func (m *memoryStore) ListObjects(_ context.Context, prefix string) ([]etcdbackup.ObjectInfo, error) {
+	var objects []etcdbackup.ObjectInfo
+
+	for key, object := range m.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, object)
+		}
+	}
+
+	return objects, nil
+}
+
+func (m *memoryStore) DeleteObject(_ context.Context, key string) error {
+	delete(m.objects, key)
+
+	return nil
+}
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	store := &memoryStore{
+		objects: map[string]etcdbackup.ObjectInfo{},
+	}
+
+	for _, object := range []etcdbackup.ObjectInfo{
+		{Key: supportbundle.FleetKey(now.Add(-48 * time.Hour)), Timestamp: now.Add(-48 * time.Hour)},
+		{Key: supportbundle.FleetKey(now), Timestamp: now},
+		{Key: supportbundle.ClusterKey("talos-default", now.Add(-72*time.Hour)), Timestamp: now.Add(-72 * time.Hour)},
+		{Key: supportbundle.ClusterKey("talos-default", now.Add(-time.Hour)), Timestamp: now.Add(-time.Hour)},
+		// not a support bundle
+		{Key: "5f3c1c4e-3e2a-4c4e-9a5e-6b0f5e3c1c4e/FFFFFFFF9A5E6B0F.snapshot", Timestamp: now.Add(-72 * time.Hour)},
+	} {
+		store.objects[object.Key] = object
+	}
+
+	require.NoError(t, supportbundle.Prune(context.Background(), store, now.Add(-24*time.Hour)))
+
+	assert.ElementsMatch(t, []string{
+		supportbundle.FleetKey(now),
+		supportbundle.ClusterKey("talos-default", now.Add(-time.Hour)),
+		"5f3c1c4e-3e2a-4c4e-9a5e-6b0f5e3c1c4e/FFFFFFFF9A5E6B0F.snapshot",
+	}, slices.Collect(maps.Keys(store.objects)))
+}
+
+func TestKeys(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2024, 10, 18, 3, 4, 5, 0, time.UTC)
+
+	assert.Equal(t, "support-bundles/fleet/20241018T030405Z.zip.age", supportbundle.FleetKey(timestamp))
+	assert.Equal(t, "support-bundles/clusters/talos-default/20241018T030405Z.zip.age", supportbundle.ClusterKey("talos-default", timestamp))
+}
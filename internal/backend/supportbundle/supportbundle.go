@@ -0,0 +1,191 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package supportbundle builds the support bundles of the clusters and machines managed by Omni.
+package supportbundle
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/channel"
+	"github.com/siderolabs/go-talos-support/support"
+	"github.com/siderolabs/go-talos-support/support/bundle"
+	"github.com/siderolabs/go-talos-support/support/collectors"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	"github.com/siderolabs/omni/internal/backend/dns"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	slink "github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+// UnassignedFolder is the folder of the fleet-wide bundle which keeps the data of the machines not assigned to any cluster.
+const UnassignedFolder = "unassigned"
+
+// Options configure a single support bundle.
+type Options struct {
+	// Progress receives the collection progress if set, it is not closed by the Builder.
+	Progress chan bundle.Progress
+
+	// Clusters to include in the bundle.
+	Clusters []string
+
+	// Unassigned includes the machines which are not part of any cluster.
+	Unassigned bool
+}
+
+// fleetWide returns true if the bundle covers more than a single cluster.
+//
+// The data of each cluster in the fleet-wide bundle is placed into a separate folder.
+func (o Options) fleetWide() bool {
+	return len(o.Clusters) != 1 || o.Unassigned
+}
+
+// Builder builds the support bundles from the Omni resources, the machine logs and the Talos and Kubernetes APIs of the clusters.
+type Builder struct {
+	state      state.State
+	logHandler *slink.LogHandler
+	dnsService *dns.Service
+	redactor   *Redactor
+}
+
+// NewBuilder initializes Builder.
+func NewBuilder(st state.State, logHandler *slink.LogHandler, dnsService *dns.Service, redactor *Redactor) *Builder {
+	return &Builder{
+		state:      st,
+		logHandler: logHandler,
+		dnsService: dnsService,
+		redactor:   redactor,
+	}
+}
+
+// Build writes the zip archive of the support bundle to the writer.
+func (b *Builder) Build(ctx context.Context, w io.Writer, opts Options) error {
+	archive := newArchive(w, b.redactor)
+
+	for _, cluster := range opts.Clusters {
+		folder := ""
+
+		if opts.fleetWide() {
+			folder = "clusters/" + cluster
+		}
+
+		if err := b.buildCluster(ctx, archive, cluster, folder, opts.Progress); err != nil {
+			return fmt.Errorf("failed to collect the support bundle of the cluster %q: %w", cluster, err)
+		}
+	}
+
+	if opts.Unassigned {
+		if err := b.buildUnassigned(ctx, archive, opts.Progress); err != nil {
+			return fmt.Errorf("failed to collect the support bundle of the unassigned machines: %w", err)
+		}
+	}
+
+	return archive.Close()
+}
+
+func (b *Builder) buildCluster(ctx context.Context, archive *archive, cluster, folder string, progress chan bundle.Progress) error {
+	resources, err := b.collectClusterResources(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	var nodes []string
+
+	cols := b.omniCollectors(resources, func(machineID string) {
+		info := b.dnsService.Resolve(cluster, machineID)
+		if info.GetAddress() != "" {
+			nodes = append(nodes, info.GetAddress())
+		}
+	})
+
+	internalCtx := actor.MarkContextAsInternalActor(ctx)
+
+	talosClient, err := b.getTalosClient(internalCtx, cluster)
+	if err != nil {
+		reportError(ctx, progress, fmt.Errorf("failed to get talos client %w", err))
+	}
+
+	if talosClient != nil {
+		defer talosClient.Close() //nolint:errcheck
+	}
+
+	kubernetesClient, err := b.getKubernetesClient(internalCtx, cluster)
+	if err != nil {
+		reportError(ctx, progress, fmt.Errorf("failed to get kubernetes client %w", err))
+	}
+
+	options := bundle.NewOptions(
+		bundle.WithArchive(sharedArchive{archive}),
+		bundle.WithKubernetesClient(kubernetesClient),
+		bundle.WithTalosClient(talosClient),
+		bundle.WithNodes(nodes...),
+		bundle.WithNumWorkers(4),
+		bundle.WithProgressChan(progress),
+		bundle.WithLogOutput(io.Discard),
+	)
+
+	talosCollectors, err := collectors.GetForOptions(ctx, options)
+	if err != nil {
+		reportError(ctx, progress, err)
+	}
+
+	cols = append(cols, talosCollectors...)
+
+	if folder != "" {
+		cols = collectors.WithFolder(cols, folder)
+	}
+
+	return support.CreateSupportBundle(ctx, options, cols...)
+}
+
+func (b *Builder) buildUnassigned(ctx context.Context, archive *archive, progress chan bundle.Progress) error {
+	resources, err := b.collectUnassignedResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	options := bundle.NewOptions(
+		bundle.WithArchive(sharedArchive{archive}),
+		bundle.WithNumWorkers(4),
+		bundle.WithProgressChan(progress),
+		bundle.WithLogOutput(io.Discard),
+	)
+
+	cols := collectors.WithFolder(b.omniCollectors(resources, func(string) {}), UnassignedFolder)
+
+	return support.CreateSupportBundle(ctx, options, cols...)
+}
+
+// omniCollectors returns the collectors of the Omni resources and the logs of the machines, onMachine is called for each machine.
+func (b *Builder) omniCollectors(resources []resource.Resource, onMachine func(machineID string)) []*collectors.Collector {
+	cols := make([]*collectors.Collector, 0, len(resources))
+
+	for _, res := range resources {
+		if res.Metadata().Type() == siderolink.LinkType {
+			cols = append(cols, b.collectLogs(res.Metadata().ID()))
+
+			onMachine(res.Metadata().ID())
+		}
+
+		cols = append(cols, writeResource(res))
+	}
+
+	return collectors.WithSource(cols, "omni")
+}
+
+func reportError(ctx context.Context, progress chan bundle.Progress, err error) {
+	if progress == nil {
+		return
+	}
+
+	channel.SendWithContext(ctx, progress, bundle.Progress{
+		Source: collectors.Cluster,
+		Error:  err,
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
+	"path/filepath"
 
 	pgpcrypto "github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -17,8 +19,11 @@ import (
 	"github.com/siderolabs/go-api-signature/pkg/pgp"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
@@ -81,54 +86,163 @@ func NewPGPAccessValidator(state state.State, roleProvider RoleProvider, logger
 }
 
 // ValidateAccess validates the access to an exposed service in the given cluster ID,
-// using the PGP public keys in the Omni database.
-func (p *PGPAccessValidator) ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID) error {
-	singatureBytes, err := base64.StdEncoding.DecodeString(publicKeyIDSignatureBase64)
+// using the PGP public keys in the Omni database and the access rules of the exposed service.
+//
+// It returns the identity of the public key owner.
+func (p *PGPAccessValidator) ValidateAccess(ctx context.Context, request AccessRequest) (string, error) {
+	singatureBytes, err := base64.StdEncoding.DecodeString(request.PublicKeyIDSignatureBase64)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	ctx = actor.MarkContextAsInternalActor(ctx)
 
-	publicKey, err := safe.StateGet[*authres.PublicKey](ctx, p.state, authres.NewPublicKey(resources.DefaultNamespace, publicKeyID).Metadata())
+	publicKey, err := safe.StateGet[*authres.PublicKey](ctx, p.state, authres.NewPublicKey(resources.DefaultNamespace, request.PublicKeyID).Metadata())
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	key, err := pgpcrypto.NewKeyFromArmored(string(publicKey.TypedSpec().Value.GetPublicKey()))
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	pgpKey, err := pgp.NewKey(key)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if err = pgpKey.Validate(); err != nil {
-		return err
+		return "", err
 	}
 
-	if err = pgpKey.Verify([]byte(publicKeyID), singatureBytes); err != nil {
-		return err
+	if err = pgpKey.Verify([]byte(request.PublicKeyID), singatureBytes); err != nil {
+		return "", err
+	}
+
+	identity := publicKey.TypedSpec().Value.GetIdentity().GetEmail()
+
+	if request.ServiceAccountOnly {
+		if sa, isServiceAccount := access.ParseServiceAccountFromFullID(identity); !isServiceAccount || sa.IsInfraProvider {
+			return "", fmt.Errorf("identity %q is not a service account", identity)
+		}
 	}
 
 	publicKeyRoleStr := publicKey.TypedSpec().Value.GetRole()
 	if publicKeyRoleStr != "" {
 		publicKeyRole, parseErr := role.Parse(publicKeyRoleStr)
 		if parseErr != nil {
-			return parseErr
+			return "", parseErr
 		}
 
 		ctx = ctxstore.WithValue(ctx, auth.RoleContextKey{Role: publicKeyRole})
 	}
 
-	ctx = ctxstore.WithValue(ctx, auth.IdentityContextKey{Identity: publicKey.TypedSpec().Value.GetIdentity().GetEmail()})
+	ctx = ctxstore.WithValue(ctx, auth.IdentityContextKey{Identity: identity})
+
+	accessRole, err := p.roleProvider.RoleForCluster(ctx, request.ClusterID)
+	if err != nil {
+		return "", err
+	}
+
+	exposedService, err := p.getExposedService(ctx, request.ClusterID, request.Alias)
+	if err != nil {
+		return "", err
+	}
+
+	if exposedService == nil { // no access rules to check
+		if err = accessRole.Check(role.Reader); err != nil {
+			return "", err
+		}
+
+		return identity, nil
+	}
+
+	if err = CheckAccessRules(ctx, p.state, exposedService, accessRole, identity); err != nil {
+		p.logger.Debug("access to the exposed service denied",
+			zap.String("identity", identity), zap.String("exposed_service", exposedService.Metadata().ID()), zap.Error(err))
+
+		return "", err
+	}
+
+	return identity, nil
+}
+
+// getExposedService returns the exposed service with the given alias in the given cluster, or nil if it doesn't exist.
+func (p *PGPAccessValidator) getExposedService(ctx context.Context, clusterID resource.ID, alias string) (*omni.ExposedService, error) {
+	if alias == "" {
+		return nil, nil //nolint:nilnil
+	}
 
-	accessRole, err := p.roleProvider.RoleForCluster(ctx, clusterID)
+	exposedServices, err := safe.StateListAll[*omni.ExposedService](ctx, p.state,
+		state.WithLabelQuery(
+			resource.LabelEqual(omni.LabelCluster, clusterID),
+			resource.LabelEqual(omni.LabelExposedServiceAlias, alias),
+		),
+	)
 	if err != nil {
+		return nil, err
+	}
+
+	if exposedServices.Len() == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	return exposedServices.Get(0), nil
+}
+
+// CheckAccessRules checks the cluster role and the identity of the user against the access rules of the exposed service.
+func CheckAccessRules(ctx context.Context, st state.State, exposedService *omni.ExposedService, accessRole role.Role, identity string) error {
+	spec := exposedService.TypedSpec().Value
+
+	minRole := role.Reader
+
+	if spec.GetMinRole() != "" {
+		var err error
+
+		if minRole, err = role.Parse(spec.GetMinRole()); err != nil {
+			return err
+		}
+	}
+
+	if err := accessRole.Check(minRole); err != nil {
 		return err
 	}
 
-	return accessRole.Check(role.Reader)
+	if len(spec.GetAllowedUsers()) == 0 && len(spec.GetAllowedIdentityLabelSelectors()) == 0 {
+		return nil
+	}
+
+	for _, allowedUser := range spec.GetAllowedUsers() {
+		matches, err := filepath.Match(allowedUser, identity)
+		if err != nil {
+			return err
+		}
+
+		if matches {
+			return nil
+		}
+	}
+
+	if len(spec.GetAllowedIdentityLabelSelectors()) > 0 {
+		identityRes, err := safe.StateGet[*authres.Identity](ctx, st, authres.NewIdentity(resources.DefaultNamespace, identity).Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+
+		if identityRes != nil {
+			for _, selector := range spec.GetAllowedIdentityLabelSelectors() {
+				query, parseErr := labels.ParseQuery(selector)
+				if parseErr != nil {
+					return parseErr
+				}
+
+				if query.Matches(*identityRes.Metadata().Labels()) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return fmt.Errorf("access denied: identity %q is not allowed to access the exposed service", identity)
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
@@ -19,8 +20,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 )
@@ -62,13 +65,21 @@ func TestAccessValidator(t *testing.T) {
 
 	require.NoError(t, st.Create(ctx, publicKey))
 
-	err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString([]byte("invalid-test-signature")), "test-cluster")
+	_, err = accessValidator.ValidateAccess(ctx, workloadproxy.AccessRequest{
+		PublicKeyID:                publicKey.Metadata().ID(),
+		PublicKeyIDSignatureBase64: base64.StdEncoding.EncodeToString([]byte("invalid-test-signature")),
+		ClusterID:                  "test-cluster",
+	})
 	require.Error(t, err)
 
 	signature, err := key.Sign([]byte(publicKey.Metadata().ID()))
 	require.NoError(t, err)
 
-	err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster")
+	_, err = accessValidator.ValidateAccess(ctx, workloadproxy.AccessRequest{
+		PublicKeyID:                publicKey.Metadata().ID(),
+		PublicKeyIDSignatureBase64: base64.StdEncoding.EncodeToString(signature),
+		ClusterID:                  "test-cluster",
+	})
 	require.NoError(t, err)
 
 	require.Len(t, roleProvider.clusterIDs, 1)
@@ -78,6 +89,108 @@ func TestAccessValidator(t *testing.T) {
 
 	roleProvider.role = role.None
 
-	err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster")
+	_, err = accessValidator.ValidateAccess(ctx, workloadproxy.AccessRequest{
+		PublicKeyID:                publicKey.Metadata().ID(),
+		PublicKeyIDSignatureBase64: base64.StdEncoding.EncodeToString(signature),
+		ClusterID:                  "test-cluster",
+	})
 	require.Error(t, err)
 }
+
+func TestAccessValidatorAccessRules(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	roleProvider := &mockRoleProvider{
+		role: role.Reader,
+	}
+
+	accessValidator, err := workloadproxy.NewPGPAccessValidator(st, roleProvider, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	createKey := func(email string) (publicKeyID, signatureBase64 string) {
+		key, keyErr := pgp.GenerateKey("test", "", email, 8*time.Hour)
+		require.NoError(t, keyErr)
+
+		armored, keyErr := key.ArmorPublic()
+		require.NoError(t, keyErr)
+
+		publicKey := auth.NewPublicKey(resources.DefaultNamespace, key.Fingerprint())
+		publicKey.TypedSpec().Value.PublicKey = []byte(armored)
+		publicKey.TypedSpec().Value.Identity = &specs.Identity{Email: email}
+
+		require.NoError(t, st.Create(ctx, publicKey))
+
+		signature, keyErr := key.Sign([]byte(key.Fingerprint()))
+		require.NoError(t, keyErr)
+
+		return key.Fingerprint(), base64.StdEncoding.EncodeToString(signature)
+	}
+
+	userKeyID, userSignature := createKey("user@example.com")
+	saKeyID, saSignature := createKey("ci@serviceaccount.omni.sidero.dev")
+
+	identity := auth.NewIdentity(resources.DefaultNamespace, "user@example.com")
+	identity.Metadata().Labels().Set("team", "platform")
+
+	require.NoError(t, st.Create(ctx, identity))
+
+	exposedService := omni.NewExposedService(resources.DefaultNamespace, "test-cluster/grafana.monitoring")
+	exposedService.Metadata().Labels().Set(omni.LabelCluster, "test-cluster")
+	exposedService.Metadata().Labels().Set(omni.LabelExposedServiceAlias, "grafana")
+	exposedService.TypedSpec().Value.AllowedUsers = []string{"*@serviceaccount.omni.sidero.dev"}
+
+	require.NoError(t, st.Create(ctx, exposedService))
+
+	validate := func(publicKeyID, signatureBase64 string, serviceAccountOnly bool) error {
+		_, validateErr := accessValidator.ValidateAccess(ctx, workloadproxy.AccessRequest{
+			PublicKeyID:                publicKeyID,
+			PublicKeyIDSignatureBase64: signatureBase64,
+			ClusterID:                  "test-cluster",
+			Alias:                      "grafana",
+			ServiceAccountOnly:         serviceAccountOnly,
+		})
+
+		return validateErr
+	}
+
+	// the user is neither in the allowed users nor matches the identity labels
+	require.Error(t, validate(userKeyID, userSignature, false))
+	require.NoError(t, validate(saKeyID, saSignature, true))
+
+	saIdentity, err := accessValidator.ValidateAccess(ctx, workloadproxy.AccessRequest{
+		PublicKeyID:                saKeyID,
+		PublicKeyIDSignatureBase64: saSignature,
+		ClusterID:                  "test-cluster",
+		Alias:                      "grafana",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ci@serviceaccount.omni.sidero.dev", saIdentity)
+
+	// user keys can't be used as bearer tokens
+	require.Error(t, validate(userKeyID, userSignature, true))
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st, exposedService.Metadata(), func(res *omni.ExposedService) error {
+		res.TypedSpec().Value.AllowedIdentityLabelSelectors = []string{"team=platform"}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, validate(userKeyID, userSignature, false))
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st, exposedService.Metadata(), func(res *omni.ExposedService) error {
+		res.TypedSpec().Value.MinRole = string(role.Operator)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Error(t, validate(userKeyID, userSignature, false))
+
+	roleProvider.role = role.Operator
+
+	require.NoError(t, validate(userKeyID, userSignature, false))
+}
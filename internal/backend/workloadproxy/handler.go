@@ -13,22 +13,58 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/pkg/access"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/config"
 )
 
+const bearerPrefix = "Bearer "
+
 // ProxyProvider is a provider of HTTP proxies for the exposed services.
 type ProxyProvider interface {
 	GetProxy(alias string) (http.Handler, resource.ID, error)
 }
 
+// AccessRequest describes a request to access an exposed service.
+type AccessRequest struct {
+	// PublicKeyID is the ID of the public key the request is authenticated with.
+	PublicKeyID string
+
+	// PublicKeyIDSignatureBase64 is the signed & base64'd form of the PublicKeyID.
+	PublicKeyIDSignatureBase64 string
+
+	// ClusterID is the ID of the cluster the exposed service belongs to.
+	ClusterID resource.ID
+
+	// Alias is the alias of the exposed service.
+	Alias string
+
+	// ServiceAccountOnly restricts the access to the service account keys.
+	//
+	// It is set when the request is authenticated using a bearer token instead of the browser cookies.
+	ServiceAccountOnly bool
+}
+
 // AccessValidator validates workload proxy requests against the given cluster by the given public key ID and its signed & base64'd form.
+//
+// On success, it returns the identity the request is authenticated as.
 type AccessValidator interface {
-	ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID) error
+	ValidateAccess(ctx context.Context, request AccessRequest) (identity string, err error)
+}
+
+// HTTPHandlerOption is a functional option for the HTTPHandler.
+type HTTPHandlerOption func(*HTTPHandler)
+
+// WithAccessLogs enables the structured access logs of the requests to the exposed services.
+func WithAccessLogs(enabled bool) HTTPHandlerOption {
+	return func(h *HTTPHandler) {
+		h.accessLogs = enabled
+	}
 }
 
 // HTTPHandler is an HTTP handler that will proxy matching requests to the workload proxy.
@@ -39,13 +75,17 @@ type HTTPHandler struct {
 	logger              *zap.Logger
 	proxyProvider       ProxyProvider
 	accessValidator     AccessValidator
+	metrics             *handlerMetrics
 	mainURL             *url.URL
 	mainDomain          string
 	workloadProxyDomain string
+	accessLogs          bool
 }
 
 // NewHTTPHandler creates a new HTTP handler that will proxy requests to the workload proxy.
-func NewHTTPHandler(next http.Handler, proxyProvider ProxyProvider, accessValidator AccessValidator, mainURL *url.URL, workloadProxySubdomain string, logger *zap.Logger) (*HTTPHandler, error) {
+func NewHTTPHandler(next http.Handler, proxyProvider ProxyProvider, accessValidator AccessValidator, mainURL *url.URL, workloadProxySubdomain string, logger *zap.Logger,
+	opts ...HTTPHandlerOption,
+) (*HTTPHandler, error) {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
@@ -65,20 +105,27 @@ func NewHTTPHandler(next http.Handler, proxyProvider ProxyProvider, accessValida
 	mainDomain := getMainDomain(mainURL)
 	workloadProxyDomain := getWorkloadProxyDomain(workloadProxySubdomain, mainDomain)
 
-	return &HTTPHandler{
+	handler := &HTTPHandler{
 		next:                next,
 		proxyProvider:       proxyProvider,
 		accessValidator:     accessValidator,
+		metrics:             newHandlerMetrics(),
 		mainURL:             mainURL,
 		mainDomain:          mainDomain,
 		workloadProxyDomain: workloadProxyDomain,
 		logger:              logger,
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(handler)
+	}
+
+	return handler, nil
 }
 
 // ServeHTTP implements http.Handler.
 func (h *HTTPHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
-	if !h.isWorkloadProxyRequest(request) {
+	if !h.IsWorkloadProxyRequest(request) {
 		h.next.ServeHTTP(writer, request)
 
 		return
@@ -108,15 +155,49 @@ func (h *HTTPHandler) ServeHTTP(writer http.ResponseWriter, request *http.Reques
 		return
 	}
 
-	h.checkCookies(writer, request, proxy, clusterID)
+	start := time.Now()
+	recorder := &statusRecorder{
+		ResponseWriter: writer,
+		upgrade:        request.Header.Get("Upgrade") != "",
+	}
+
+	var (
+		identity string
+		allowed  bool
+	)
+
+	if publicKeyID, publicKeyIDSignatureBase64, ok := h.getBearerToken(request); ok {
+		identity, allowed = h.checkBearerToken(recorder, request, publicKeyID, publicKeyIDSignatureBase64, clusterID, alias)
+	} else {
+		identity, allowed = h.checkCookies(recorder, request, clusterID, alias)
+	}
+
+	outcome := outcomeProxied
+
+	switch status := recorder.status(); {
+	case allowed:
+		proxy.ServeHTTP(recorder, request)
+	case status >= http.StatusMultipleChoices && status < http.StatusBadRequest:
+		outcome = outcomeRedirected
+	default:
+		outcome = outcomeDenied
+	}
+
+	duration := time.Since(start)
+
+	h.metrics.observe(clusterID, alias, outcome, recorder, duration)
+
+	if h.accessLogs {
+		h.logAccess(request, clusterID, alias, identity, outcome, recorder, duration)
+	}
 }
 
-// isWorkloadProxyRequest checks if the request is for the workload proxy.
+// IsWorkloadProxyRequest checks if the request is for the workload proxy.
 //
 // It supports two formats:
 // - Legacy format: p-g3a4ana-demo.omni.siderolabs.io
 // - New format with a dedicated subdomain for all workload services: g3a4ana-demo.proxy-us.omni.siderolabs.io.
-func (h *HTTPHandler) isWorkloadProxyRequest(request *http.Request) bool {
+func (h *HTTPHandler) IsWorkloadProxyRequest(request *http.Request) bool {
 	host, _, _ := net.SplitHostPort(request.Host) //nolint:errcheck
 
 	if host == "" {
@@ -135,25 +216,85 @@ func (h *HTTPHandler) isWorkloadProxyRequest(request *http.Request) bool {
 	return false
 }
 
-func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Request, proxy http.Handler, clusterID resource.ID) {
+// getBearerToken returns the workload proxy bearer token of the request.
+//
+// The signature cookies take precedence, and the Authorization headers which don't carry a workload proxy bearer token
+// belong to the exposed service itself, so they are passed through to it as is.
+func (h *HTTPHandler) getBearerToken(request *http.Request) (publicKeyID, publicKeyIDSignatureBase64 string, ok bool) {
+	if cookiePublicKeyID, cookieSignature := h.getSignatureCookies(request); cookiePublicKeyID != "" && cookieSignature != "" {
+		return "", "", false
+	}
+
+	token, found := strings.CutPrefix(request.Header.Get("Authorization"), bearerPrefix)
+	if !found {
+		return "", "", false
+	}
+
+	publicKeyID, publicKeyIDSignatureBase64, err := access.ParseWorkloadProxyBearerToken(token)
+	if err != nil {
+		return "", "", false
+	}
+
+	return publicKeyID, publicKeyIDSignatureBase64, true
+}
+
+// checkBearerToken authenticates the non-browser requests using a service account bearer token.
+//
+// Unlike the cookie-based authentication, it never redirects to the login page, but responds with an error status instead.
+//
+// It returns the authenticated identity, or false if the request was denied and the response is already written.
+func (h *HTTPHandler) checkBearerToken(writer http.ResponseWriter, request *http.Request, publicKeyID, publicKeyIDSignatureBase64 string,
+	clusterID resource.ID, alias string,
+) (string, bool) {
+	identity, err := h.accessValidator.ValidateAccess(request.Context(), AccessRequest{
+		PublicKeyID:                publicKeyID,
+		PublicKeyIDSignatureBase64: publicKeyIDSignatureBase64,
+		ClusterID:                  clusterID,
+		Alias:                      alias,
+		ServiceAccountOnly:         true,
+	})
+	if err != nil {
+		h.logger.Warn("failed to validate bearer token access", zap.Error(err))
+
+		http.Error(writer, "access denied", http.StatusForbidden)
+
+		return "", false
+	}
+
+	// do not leak the token to the exposed service
+	request.Header.Del("Authorization")
+
+	return identity, true
+}
+
+// checkCookies authenticates the browser requests using the signature cookies.
+//
+// It returns the authenticated identity, or false if the request was redirected and the response is already written.
+func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Request, clusterID resource.ID, alias string) (string, bool) {
 	publicKeyID, publicKeyIDSignatureBase64 := h.getSignatureCookies(request)
 	if publicKeyID == "" || publicKeyIDSignatureBase64 == "" {
 		h.redirectToLogin(writer, request)
 
-		return
+		return "", false
 	}
 
-	if err := h.accessValidator.ValidateAccess(request.Context(), publicKeyID, publicKeyIDSignatureBase64, clusterID); err != nil {
+	identity, err := h.accessValidator.ValidateAccess(request.Context(), AccessRequest{
+		PublicKeyID:                publicKeyID,
+		PublicKeyIDSignatureBase64: publicKeyIDSignatureBase64,
+		ClusterID:                  clusterID,
+		Alias:                      alias,
+	})
+	if err != nil {
 		h.logger.Warn("failed to validate access", zap.Error(err))
 
 		forbiddenURL := h.mainURL.JoinPath("/forbidden").String()
 
 		http.Redirect(writer, request, forbiddenURL, http.StatusSeeOther)
 
-		return
+		return "", false
 	}
 
-	proxy.ServeHTTP(writer, request)
+	return identity, true
 }
 
 // parseServiceAliasFromHost parses the service alias from the request host.
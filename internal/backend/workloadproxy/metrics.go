@@ -0,0 +1,148 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// Request outcomes of the workload proxy.
+const (
+	// outcomeProxied is the outcome of the requests which reached the exposed service.
+	outcomeProxied = "proxied"
+
+	// outcomeRedirected is the outcome of the requests redirected to the login or the forbidden page.
+	outcomeRedirected = "redirected"
+
+	// outcomeDenied is the outcome of the requests rejected by the workload proxy itself.
+	outcomeDenied = "denied"
+)
+
+type handlerMetrics struct {
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	responseBytes   *prometheus.CounterVec
+}
+
+func newHandlerMetrics() *handlerMetrics {
+	return &handlerMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_workload_proxy_requests_total",
+			Help: "Number of requests to the exposed services by the cluster, the service alias, the outcome (proxied, redirected or denied) and the response status code.",
+		}, []string{"cluster", "alias", "outcome", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "omni_workload_proxy_request_duration_seconds",
+			Help:    "Duration of the requests proxied to the exposed services by the cluster and the service alias.",
+			Buckets: []float64{0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"cluster", "alias"}),
+		responseBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_workload_proxy_response_bytes_total",
+			Help: "Number of response body bytes sent by the exposed services by the cluster and the service alias.",
+		}, []string{"cluster", "alias"}),
+	}
+}
+
+// observe records the request, the duration and the response size are recorded only for the requests which reached the exposed service.
+func (m *handlerMetrics) observe(clusterID resource.ID, alias, outcome string, writer *statusRecorder, duration time.Duration) {
+	m.requests.WithLabelValues(clusterID, alias, outcome, strconv.Itoa(writer.status())).Inc()
+
+	if outcome != outcomeProxied {
+		return
+	}
+
+	m.requestDuration.WithLabelValues(clusterID, alias).Observe(duration.Seconds())
+	m.responseBytes.WithLabelValues(clusterID, alias).Add(float64(writer.bytesWritten))
+}
+
+// Describe implements prom.Collector interface.
+func (h *HTTPHandler) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(h, ch)
+}
+
+// Collect implements prom.Collector interface.
+func (h *HTTPHandler) Collect(ch chan<- prometheus.Metric) {
+	h.metrics.requests.Collect(ch)
+	h.metrics.requestDuration.Collect(ch)
+	h.metrics.responseBytes.Collect(ch)
+}
+
+var _ prometheus.Collector = &HTTPHandler{}
+
+// logAccess writes a structured access log entry for a request to an exposed service.
+func (h *HTTPHandler) logAccess(request *http.Request, clusterID resource.ID, alias, identity, outcome string, writer *statusRecorder, duration time.Duration) {
+	h.logger.Info("workload proxy access",
+		zap.String("cluster", clusterID),
+		zap.String("alias", alias),
+		zap.String("identity", identity),
+		zap.String("outcome", outcome),
+		zap.String("method", request.Method),
+		zap.String("host", request.Host),
+		zap.String("path", request.URL.Path),
+		zap.String("remote_addr", request.RemoteAddr),
+		zap.String("user_agent", request.UserAgent()),
+		zap.Int("status", writer.status()),
+		zap.Int64("response_bytes", writer.bytesWritten),
+		zap.Duration("duration", duration),
+	)
+}
+
+// statusRecorder records the status code and the number of bytes written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+
+	statusCode   int
+	bytesWritten int64
+	upgrade      bool
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	if r.statusCode == 0 {
+		r.statusCode = statusCode
+	}
+
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.statusCode == 0 {
+		r.statusCode = http.StatusOK
+	}
+
+	n, err := r.ResponseWriter.Write(b)
+	r.bytesWritten += int64(n)
+
+	return n, err
+}
+
+// Flush implements http.Flusher, it is required for the streaming responses.
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap allows http.ResponseController to access the underlying writer, e.g., to hijack the connection for the protocol upgrades.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *statusRecorder) status() int {
+	switch {
+	case r.statusCode != 0:
+		return r.statusCode
+	case r.upgrade:
+		// hijacked connections (e.g., websockets) never write the status through the recorder
+		return http.StatusSwitchingProtocols
+	default:
+		return http.StatusOK
+	}
+}
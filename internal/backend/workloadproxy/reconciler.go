@@ -6,6 +6,8 @@
 package workloadproxy
 
 import (
+	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -23,10 +25,23 @@ import (
 	"github.com/siderolabs/tcpproxy"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"golang.org/x/net/http2"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
 )
 
+// Upstream describes the upstream of an exposed service.
+type Upstream struct {
+	// Addresses are the addresses of the exposed service on the cluster nodes.
+	Addresses []string
+
+	// Protocol is the application protocol of the exposed service.
+	Protocol specs.ExposedServiceSpec_Protocol
+}
+
 type lbStatus struct {
-	lb *loadbalancer.TCP
+	lb       *loadbalancer.TCP
+	protocol specs.ExposedServiceSpec_Protocol
 }
 
 // Close closes the load balancer and waits for it to terminate.
@@ -86,7 +101,7 @@ func NewReconciler(logger *zap.Logger, logLevel zapcore.Level) *Reconciler {
 }
 
 // Reconcile reconciles the workload proxy load balancers for a cluster.
-func (registry *Reconciler) Reconcile(cluster resource.ID, aliasToUpstreamAddresses map[string][]string) error {
+func (registry *Reconciler) Reconcile(cluster resource.ID, aliasToUpstream map[string]Upstream) error {
 	registry.logger.Log(registry.logLevel, "reconcile LBs", zap.String("cluster", cluster))
 
 	registry.mu.Lock()
@@ -96,7 +111,7 @@ func (registry *Reconciler) Reconcile(cluster resource.ID, aliasToUpstreamAddres
 
 	// drop removed LBs
 	for alias := range registry.clusterToAliasToLBStatus[cluster] {
-		if _, ok := aliasToUpstreamAddresses[alias]; ok { // still present
+		if _, ok := aliasToUpstream[alias]; ok { // still present
 			continue
 		}
 
@@ -105,8 +120,8 @@ func (registry *Reconciler) Reconcile(cluster resource.ID, aliasToUpstreamAddres
 	}
 
 	// ensure new LBs
-	for alias, upstreamAddresses := range aliasToUpstreamAddresses {
-		if err := registry.ensureLB(cluster, alias, upstreamAddresses); err != nil {
+	for alias, serviceUpstream := range aliasToUpstream {
+		if err := registry.ensureLB(cluster, alias, serviceUpstream); err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("failed to register LB: %w", err))
 		}
 	}
@@ -115,7 +130,9 @@ func (registry *Reconciler) Reconcile(cluster resource.ID, aliasToUpstreamAddres
 }
 
 // ensureLB ensures that a load balancer exists and started for the given cluster and alias, targeting the given upstream addresses.
-func (registry *Reconciler) ensureLB(cluster resource.ID, alias string, upstreamAddresses []string) error {
+func (registry *Reconciler) ensureLB(cluster resource.ID, alias string, serviceUpstream Upstream) error {
+	upstreamAddresses := serviceUpstream.Addresses
+
 	registry.logger.Log(registry.logLevel, "ensure LB", zap.String("cluster", cluster), zap.String("alias", alias), zap.Strings("upstream_addresses", upstreamAddresses))
 
 	hostPort := registry.hostPortForAlias(cluster, alias)
@@ -161,6 +178,8 @@ func (registry *Reconciler) ensureLB(cluster resource.ID, alias string, upstream
 		return fmt.Errorf("failed to reconcile route for %q/%q: %w", cluster, alias, err)
 	}
 
+	lbSts.protocol = serviceUpstream.Protocol
+
 	registry.aliasToCluster[alias] = cluster
 
 	if aliasToLB := registry.clusterToAliasToLBStatus[cluster]; aliasToLB == nil {
@@ -173,6 +192,8 @@ func (registry *Reconciler) ensureLB(cluster resource.ID, alias string, upstream
 }
 
 // GetProxy returns a proxy for the exposed service, targeting the load balancer for the given alias.
+//
+// Raw TCP services are not proxied over HTTP, so nil proxy is returned for them.
 func (registry *Reconciler) GetProxy(alias string) (http.Handler, resource.ID, error) {
 	registry.mu.Lock()
 	defer registry.mu.Unlock()
@@ -183,7 +204,7 @@ func (registry *Reconciler) GetProxy(alias string) (http.Handler, resource.ID, e
 	}
 
 	lbSts := registry.clusterToAliasToLBStatus[clusterID][alias]
-	if lbSts == nil || lbSts.lb == nil {
+	if lbSts == nil || lbSts.lb == nil || lbSts.protocol == specs.ExposedServiceSpec_TCP {
 		return nil, "", nil
 	}
 
@@ -195,6 +216,22 @@ func (registry *Reconciler) GetProxy(alias string) (http.Handler, resource.ID, e
 	}
 
 	proxy := httputil.NewSingleHostReverseProxy(targetURL)
+
+	if lbSts.protocol == specs.ExposedServiceSpec_GRPC {
+		// gRPC requires HTTP/2 end-to-end, so talk prior-knowledge HTTP/2 (h2c) to the upstream
+		proxy.Transport = &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return registry.connProvider.DialContext(ctx, network, addr)
+			},
+		}
+
+		// flush immediately to support streaming RPCs
+		proxy.FlushInterval = -1
+
+		return proxy, clusterID, nil
+	}
+
 	proxy.Transport = &http.Transport{
 		DialContext: registry.connProvider.DialContext,
 	}
@@ -202,6 +239,38 @@ func (registry *Reconciler) GetProxy(alias string) (http.Handler, resource.ID, e
 	return proxy, clusterID, nil
 }
 
+// DialService opens a raw TCP connection to the exposed service with the given alias through its load balancer.
+//
+// It returns a nil connection if there is no exposed service with the given alias.
+func (registry *Reconciler) DialService(ctx context.Context, alias string) (net.Conn, resource.ID, error) {
+	registry.mu.Lock()
+
+	clusterID, ok := registry.aliasToCluster[alias]
+	if !ok {
+		registry.mu.Unlock()
+
+		return nil, "", nil
+	}
+
+	lbSts := registry.clusterToAliasToLBStatus[clusterID][alias]
+	if lbSts == nil || lbSts.lb == nil {
+		registry.mu.Unlock()
+
+		return nil, "", nil
+	}
+
+	hostPort := registry.hostPortForAlias(clusterID, alias)
+
+	registry.mu.Unlock()
+
+	conn, err := registry.connProvider.DialContext(ctx, "tcp", hostPort)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to dial %q/%q: %w", clusterID, alias, err)
+	}
+
+	return conn, clusterID, nil
+}
+
 func (registry *Reconciler) removeLB(cluster resource.ID, alias string) {
 	registry.logger.Log(registry.logLevel, "remove LB", zap.String("cluster", cluster), zap.String("alias", alias))
 
@@ -6,12 +6,17 @@
 package workloadproxy_test
 
 import (
+	"context"
+	"io"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 )
 
@@ -20,14 +25,25 @@ func TestReconciler(t *testing.T) {
 
 	reconciler := workloadproxy.NewReconciler(zaptest.NewLogger(t), zapcore.InfoLevel)
 
-	err := reconciler.Reconcile("cluster1", map[string][]string{
+	err := reconciler.Reconcile("cluster1", map[string]workloadproxy.Upstream{
 		"alias1": {
-			"upstream1",
-			"upstream2",
+			Addresses: []string{
+				"upstream1",
+				"upstream2",
+			},
 		},
 		"alias2": {
-			"upstream3",
-			"upstream4",
+			Addresses: []string{
+				"upstream3",
+				"upstream4",
+			},
+			Protocol: specs.ExposedServiceSpec_GRPC,
+		},
+		"alias3": {
+			Addresses: []string{
+				"upstream5",
+			},
+			Protocol: specs.ExposedServiceSpec_TCP,
 		},
 	})
 	require.NoError(t, err)
@@ -44,6 +60,13 @@ func TestReconciler(t *testing.T) {
 	require.NotNil(t, proxy)
 	require.Equal(t, "cluster1", id)
 
+	// raw TCP services are not proxied over HTTP
+	proxy, id, err = reconciler.GetProxy("alias3")
+	require.NoError(t, err)
+
+	require.Nil(t, proxy)
+	require.Zero(t, id)
+
 	err = reconciler.Reconcile("cluster2", nil)
 	require.NoError(t, err)
 
@@ -53,9 +76,68 @@ func TestReconciler(t *testing.T) {
 	require.NotNil(t, proxy)
 	require.Equal(t, "cluster1", id)
 
-	proxy, id, err = reconciler.GetProxy("alias3")
+	proxy, id, err = reconciler.GetProxy("alias4")
 	require.NoError(t, err)
 
 	require.Nil(t, proxy)
 	require.Zero(t, id)
 }
+
+func TestReconcilerDialService(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	go func() {
+		for {
+			conn, acceptErr := listener.Accept()
+			if acceptErr != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close() //nolint:errcheck
+
+				io.Copy(conn, conn) //nolint:errcheck
+			}()
+		}
+	}()
+
+	reconciler := workloadproxy.NewReconciler(zaptest.NewLogger(t), zapcore.InfoLevel)
+
+	require.NoError(t, reconciler.Reconcile("cluster1", map[string]workloadproxy.Upstream{
+		"alias1": {
+			Addresses: []string{listener.Addr().String()},
+			Protocol:  specs.ExposedServiceSpec_TCP,
+		},
+	}))
+
+	t.Cleanup(func() { require.NoError(t, reconciler.Reconcile("cluster1", nil)) })
+
+	conn, id, err := reconciler.DialService(ctx, "alias1")
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+	require.Equal(t, "cluster1", id)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+
+	buf := make([]byte, 4)
+
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	require.Equal(t, "ping", string(buf))
+
+	missingConn, id, err := reconciler.DialService(ctx, "alias2")
+	require.NoError(t, err)
+	require.Nil(t, missingConn)
+	require.Zero(t, id)
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package addon implements rendering of the addons into the Kubernetes objects, and applying them to the workload clusters.
+package addon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/addon/helm"
+)
+
+// helmHookAnnotation marks the Helm hooks.
+const helmHookAnnotation = "helm.sh/hook"
+
+// DefaultNamespace is the namespace of the namespaced objects if the addon doesn't specify one.
+const DefaultNamespace = "default"
+
+// Renderer renders the addons into the Kubernetes objects.
+type Renderer struct {
+	fetcher *helm.Fetcher
+}
+
+// NewRenderer creates a new addon renderer.
+func NewRenderer(fetcher *helm.Fetcher) *Renderer {
+	return &Renderer{
+		fetcher: fetcher,
+	}
+}
+
+// Namespace returns the namespace of the addon.
+func Namespace(spec *specs.AddonSpec) string {
+	if spec.Namespace != "" {
+		return spec.Namespace
+	}
+
+	return DefaultNamespace
+}
+
+// Validate validates the addon spec without rendering it.
+func Validate(addon *omni.Addon) error {
+	spec := addon.TypedSpec().Value
+
+	if (spec.Manifests == "") == (spec.HelmChart == nil) {
+		return errors.New("exactly one of the manifests or the Helm chart is required")
+	}
+
+	if _, err := omni.AddonClusterSelectorQuery(addon); err != nil {
+		return err
+	}
+
+	if spec.Manifests != "" {
+		_, err := ParseManifests(spec.Manifests)
+
+		return err
+	}
+
+	if spec.HelmChart.Repository == "" || spec.HelmChart.Name == "" {
+		return errors.New("Helm chart repository and name are required") //nolint:stylecheck
+	}
+
+	_, err := helm.ParseValues(spec.HelmChart.Values)
+
+	return err
+}
+
+// Objects are the rendered objects of the addon.
+type Objects struct {
+	// Objects are the addon objects ordered for the installation.
+	Objects []*unstructured.Unstructured
+	// PreHooks are the Helm hooks applied before the objects on the installation or the upgrade of the addon.
+	PreHooks []*unstructured.Unstructured
+	// PostHooks are the Helm hooks applied after the objects on the installation or the upgrade of the addon.
+	PostHooks []*unstructured.Unstructured
+}
+
+// Render renders the addon into the Kubernetes objects, the upgrade selects the Helm hooks of the upgrade instead of the installation.
+//
+// The objects and the hooks are labeled with the addon ID.
+func (r *Renderer) Render(ctx context.Context, addon *omni.Addon, capabilities helm.Capabilities, upgrade bool) (Objects, error) {
+	spec := addon.TypedSpec().Value
+
+	manifests := helm.Manifests{
+		Manifests: []string{spec.Manifests},
+	}
+
+	if spec.HelmChart != nil {
+		chart, err := r.fetcher.Fetch(ctx, spec.HelmChart.Repository, spec.HelmChart.Name, spec.HelmChart.Version)
+		if err != nil {
+			return Objects{}, err
+		}
+
+		values, err := helm.ParseValues(spec.HelmChart.Values)
+		if err != nil {
+			return Objects{}, err
+		}
+
+		releaseName := spec.HelmChart.ReleaseName
+		if releaseName == "" {
+			releaseName = addon.Metadata().ID()
+		}
+
+		release := helm.Release{
+			Name:      releaseName,
+			Namespace: Namespace(spec),
+			Upgrade:   upgrade,
+		}
+
+		if manifests, err = helm.Render(chart, values, release, capabilities); err != nil {
+			return Objects{}, err
+		}
+	}
+
+	var (
+		objects Objects
+		err     error
+	)
+
+	if objects.Objects, err = ParseManifests(manifests.Manifests...); err != nil {
+		return Objects{}, err
+	}
+
+	if objects.PreHooks, err = ParseManifests(manifests.PreHooks...); err != nil {
+		return Objects{}, err
+	}
+
+	if objects.PostHooks, err = ParseManifests(manifests.PostHooks...); err != nil {
+		return Objects{}, err
+	}
+
+	// the hooks in the plain manifests are not installed
+	objects.Objects = slices.DeleteFunc(objects.Objects, func(obj *unstructured.Unstructured) bool {
+		_, hook := obj.GetAnnotations()[helmHookAnnotation]
+
+		return hook
+	})
+
+	for _, obj := range slices.Concat(objects.Objects, objects.PreHooks, objects.PostHooks) {
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+
+		labels[omni.LabelAddon] = addon.Metadata().ID()
+
+		obj.SetLabels(labels)
+	}
+
+	SortObjects(objects.Objects)
+
+	return objects, nil
+}
+
+// ParseManifests parses the multi-document YAML manifests, the empty documents are skipped.
+func ParseManifests(documents ...string) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+
+	for _, document := range documents {
+		decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader([]byte(document)), 4096)
+
+		for {
+			obj := &unstructured.Unstructured{}
+
+			if err := decoder.Decode(&obj.Object); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+
+				return nil, fmt.Errorf("failed to parse the manifests: %w", err)
+			}
+
+			if len(obj.Object) == 0 {
+				continue
+			}
+
+			if obj.GetAPIVersion() == "" || obj.GetKind() == "" || obj.GetName() == "" {
+				return nil, fmt.Errorf("manifest %q of kind %q doesn't have the apiVersion, the kind or the name", obj.GetName(), obj.GetKind())
+			}
+
+			objects = append(objects, obj)
+		}
+	}
+
+	return objects, nil
+}
+
+// installOrder is the order of the object kinds for the installation, the same as Helm uses.
+var installOrder = []string{
+	"Namespace",
+	"NetworkPolicy",
+	"ResourceQuota",
+	"LimitRange",
+	"PodSecurityPolicy",
+	"PodDisruptionBudget",
+	"ServiceAccount",
+	"Secret",
+	"SecretList",
+	"ConfigMap",
+	"StorageClass",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"CustomResourceDefinition",
+	"ClusterRole",
+	"ClusterRoleList",
+	"ClusterRoleBinding",
+	"ClusterRoleBindingList",
+	"Role",
+	"RoleList",
+	"RoleBinding",
+	"RoleBindingList",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicationController",
+	"ReplicaSet",
+	"Deployment",
+	"HorizontalPodAutoscaler",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"IngressClass",
+	"Ingress",
+	"APIService",
+}
+
+// SortObjects sorts the objects in the installation order, the objects of the unknown kinds go last.
+func SortObjects(objects []*unstructured.Unstructured) {
+	rank := func(obj *unstructured.Unstructured) int {
+		if index := slices.Index(installOrder, obj.GetKind()); index >= 0 {
+			return index
+		}
+
+		return len(installOrder)
+	}
+
+	slices.SortStableFunc(objects, func(a, b *unstructured.Unstructured) int {
+		return rank(a) - rank(b)
+	})
+}
+
+// ObjectRef returns the reference to the object.
+func ObjectRef(obj *unstructured.Unstructured) *specs.AddonStatusSpec_Object {
+	return &specs.AddonStatusSpec_Object{
+		ApiVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// ObjectFromRef returns the object with the referenced identity.
+func ObjectFromRef(ref *specs.AddonStatusSpec_Object) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+
+	obj.SetAPIVersion(ref.ApiVersion)
+	obj.SetKind(ref.Kind)
+	obj.SetNamespace(ref.Namespace)
+	obj.SetName(ref.Name)
+
+	return obj
+}
+
+// RemovedObjects returns the references to the objects which are not in the current set of the objects.
+func RemovedObjects(previous, current []*specs.AddonStatusSpec_Object) []*specs.AddonStatusSpec_Object {
+	key := func(ref *specs.AddonStatusSpec_Object) string {
+		group, _, _ := strings.Cut(ref.ApiVersion, "/")
+		if !strings.Contains(ref.ApiVersion, "/") {
+			group = ""
+		}
+
+		return strings.Join([]string{group, ref.Kind, ref.Namespace, ref.Name}, "/")
+	}
+
+	keys := make(map[string]struct{}, len(current))
+
+	for _, ref := range current {
+		keys[key(ref)] = struct{}{}
+	}
+
+	var removed []*specs.AddonStatusSpec_Object
+
+	for _, ref := range previous {
+		if _, ok := keys[key(ref)]; !ok {
+			removed = append(removed, ref)
+		}
+	}
+
+	return removed
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package addon_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/addon"
+	"github.com/siderolabs/omni/internal/pkg/addon/helm"
+)
+
+const manifests = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: echo
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: apps
+---
+# empty document
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: echo
+  labels:
+    app: echo
+`
+
+func TestRender(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	res := omni.NewAddon(resources.DefaultNamespace, "echo")
+	res.TypedSpec().Value.Manifests = manifests
+
+	require.NoError(t, addon.Validate(res))
+
+	rendered, err := addon.NewRenderer(helm.NewFetcher(nil)).Render(ctx, res, helm.Capabilities{KubeVersion: "1.31.0"}, false)
+	require.NoError(t, err)
+
+	objects := rendered.Objects
+
+	kinds := make([]string, 0, len(objects))
+
+	for _, obj := range objects {
+		kinds = append(kinds, obj.GetKind())
+
+		assert.Equal(t, "echo", obj.GetLabels()[omni.LabelAddon])
+	}
+
+	// the hooks are skipped, the objects are in the installation order
+	assert.Equal(t, []string{"Namespace", "ServiceAccount", "Deployment"}, kinds)
+	assert.Equal(t, "echo", objects[1].GetLabels()["app"])
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		spec *specs.AddonSpec
+		name string
+		err  string
+	}{
+		{
+			name: "empty",
+			spec: &specs.AddonSpec{},
+			err:  "exactly one of",
+		},
+		{
+			name: "both",
+			spec: &specs.AddonSpec{
+				Manifests: manifests,
+				HelmChart: &specs.AddonSpec_HelmChart{Repository: "oci://ghcr.io/example", Name: "echo"},
+			},
+			err: "exactly one of",
+		},
+		{
+			name: "invalid manifests",
+			spec: &specs.AddonSpec{Manifests: "kind: ConfigMap\n"},
+			err:  "doesn't have the apiVersion",
+		},
+		{
+			name: "invalid selector",
+			spec: &specs.AddonSpec{Manifests: manifests, ClusterSelector: "env in ("},
+			err:  "invalid cluster selector",
+		},
+		{
+			name: "missing chart name",
+			spec: &specs.AddonSpec{HelmChart: &specs.AddonSpec_HelmChart{Repository: "https://charts.example.com"}},
+			err:  "are required",
+		},
+		{
+			name: "invalid values",
+			spec: &specs.AddonSpec{HelmChart: &specs.AddonSpec_HelmChart{Repository: "https://charts.example.com", Name: "echo", Values: "- a\n- b\n"}},
+			err:  "failed to parse the values",
+		},
+		{
+			name: "valid chart",
+			spec: &specs.AddonSpec{
+				HelmChart:       &specs.AddonSpec_HelmChart{Repository: "https://charts.example.com", Name: "echo", Values: "replicas: 2\n"},
+				ClusterSelector: "env=prod",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			res := omni.NewAddon(resources.DefaultNamespace, "test")
+			res.TypedSpec().Value = tt.spec
+
+			err := addon.Validate(res)
+			if tt.err == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestRemovedObjects(t *testing.T) {
+	previous := []*specs.AddonStatusSpec_Object{
+		{ApiVersion: "apps/v1", Kind: "Deployment", Namespace: "apps", Name: "echo"},
+		{ApiVersion: "v1", Kind: "ConfigMap", Namespace: "apps", Name: "echo"},
+		{ApiVersion: "v1", Kind: "Secret", Namespace: "apps", Name: "echo"},
+	}
+
+	current := []*specs.AddonStatusSpec_Object{
+		// the version change doesn't remove the object
+		{ApiVersion: "apps/v1beta2", Kind: "Deployment", Namespace: "apps", Name: "echo"},
+		{ApiVersion: "v1", Kind: "ConfigMap", Namespace: "apps", Name: "echo"},
+	}
+
+	removed := addon.RemovedObjects(previous, current)
+
+	require.Len(t, removed, 1)
+	assert.Equal(t, "Secret", removed[0].Kind)
+
+	obj := addon.ObjectFromRef(removed[0])
+	assert.Equal(t, removed[0], addon.ObjectRef(obj))
+}
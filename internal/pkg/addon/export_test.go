@@ -0,0 +1,8 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package addon
+
+var DeleteObjects = deleteObjects
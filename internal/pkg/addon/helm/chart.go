@@ -0,0 +1,31 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package helm implements loading and rendering of the Helm charts for the addons.
+//
+// The charts are loaded and rendered with the Helm chart loader and template engine, the same as the Helm client does.
+package helm
+
+import (
+	"bytes"
+	"fmt"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+)
+
+// LoadArchive loads the chart from the gzipped tarball, the chart dependencies are loaded from the charts directory of the archive.
+func LoadArchive(data []byte) (*chart.Chart, error) {
+	loaded, err := loader.LoadArchive(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the chart archive: %w", err)
+	}
+
+	if loaded.Metadata.Type == "library" {
+		return nil, fmt.Errorf("library chart %q can not be installed", loaded.Name())
+	}
+
+	return loaded, nil
+}
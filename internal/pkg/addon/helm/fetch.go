@@ -0,0 +1,273 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"helm.sh/helm/v3/pkg/chart"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// maxArchiveSize is the maximum size of the chart archive and the chart repository index.
+	maxArchiveSize = 32 * 1024 * 1024
+
+	// chartLayerMediaType is the media type of the chart archive layer in the OCI registry.
+	chartLayerMediaType = types.MediaType("application/vnd.cncf.helm.chart.content.v1.tar+gzip")
+
+	ociScheme = "oci://"
+)
+
+// Fetcher downloads the charts from the chart repositories and the OCI registries.
+//
+// The archives of the charts with the pinned versions are cached in memory, as the chart versions are immutable.
+// The chart is loaded from the archive on each fetch, as the rendering modifies the loaded chart.
+type Fetcher struct {
+	client *http.Client
+	cache  map[string][]byte
+	mu     sync.Mutex
+}
+
+// NewFetcher creates a new chart fetcher.
+func NewFetcher(client *http.Client) *Fetcher {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Fetcher{
+		client: client,
+		cache:  map[string][]byte{},
+	}
+}
+
+// Fetch downloads and loads the chart, the latest version is used if the version is empty.
+func (f *Fetcher) Fetch(ctx context.Context, repository, chartName, version string) (*chart.Chart, error) {
+	key := repository + "|" + chartName + "|" + version
+
+	f.mu.Lock()
+	data, ok := f.cache[key]
+	f.mu.Unlock()
+
+	if !ok {
+		var err error
+
+		if strings.HasPrefix(repository, ociScheme) {
+			data, err = f.fetchOCI(ctx, strings.TrimPrefix(repository, ociScheme), chartName, version)
+		} else {
+			data, err = f.fetchRepository(ctx, repository, chartName, version)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch chart %q from %q: %w", chartName, repository, err)
+		}
+	}
+
+	loaded, err := LoadArchive(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok && version != "" {
+		f.mu.Lock()
+		f.cache[key] = data
+		f.mu.Unlock()
+	}
+
+	return loaded, nil
+}
+
+type repositoryIndex struct {
+	Entries map[string][]struct {
+		Version string   `json:"version"`
+		URLs    []string `json:"urls"`
+	} `json:"entries"`
+}
+
+func (f *Fetcher) fetchRepository(ctx context.Context, repository, chartName, version string) ([]byte, error) {
+	base, err := url.Parse(strings.TrimSuffix(repository, "/") + "/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository URL: %w", err)
+	}
+
+	indexData, err := f.get(ctx, base.JoinPath("index.yaml").String())
+	if err != nil {
+		return nil, err
+	}
+
+	var index repositoryIndex
+
+	if err = yaml.Unmarshal(indexData, &index); err != nil {
+		return nil, fmt.Errorf("failed to parse the repository index: %w", err)
+	}
+
+	entries := index.Entries[chartName]
+	versions := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		versions = append(versions, entry.Version)
+	}
+
+	if version, err = resolveVersion(versions, version); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.Version != version {
+			continue
+		}
+
+		if len(entry.URLs) == 0 {
+			return nil, fmt.Errorf("chart version %q doesn't have the download URL", version)
+		}
+
+		archiveURL, err := base.Parse(entry.URLs[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid chart URL: %w", err)
+		}
+
+		return f.get(ctx, archiveURL.String())
+	}
+
+	return nil, fmt.Errorf("chart version %q is not found", version)
+}
+
+func (f *Fetcher) fetchOCI(ctx context.Context, repository, chartName, version string) ([]byte, error) {
+	repo, err := name.NewRepository(strings.TrimSuffix(repository, "/") + "/" + chartName)
+	if err != nil {
+		return nil, err
+	}
+
+	if version == "" {
+		tags, err := remote.List(repo, remote.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		versions := make([]string, 0, len(tags))
+
+		for _, tag := range tags {
+			// the OCI tags can't have "+", so Helm replaces it with "_"
+			versions = append(versions, strings.ReplaceAll(tag, "_", "+"))
+		}
+
+		if version, err = resolveVersion(versions, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	image, err := remote.Image(repo.Tag(strings.ReplaceAll(version, "+", "_")), remote.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := image.Layers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, layer := range layers {
+		mediaType, err := layer.MediaType()
+		if err != nil {
+			return nil, err
+		}
+
+		if mediaType != chartLayerMediaType {
+			continue
+		}
+
+		reader, err := layer.Compressed()
+		if err != nil {
+			return nil, err
+		}
+
+		defer reader.Close() //nolint:errcheck
+
+		return readLimited(reader)
+	}
+
+	return nil, errors.New("the OCI artifact is not a Helm chart")
+}
+
+func (f *Fetcher) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q fetching %q", resp.Status, url)
+	}
+
+	return readLimited(resp.Body)
+}
+
+func readLimited(reader io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, maxArchiveSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxArchiveSize {
+		return nil, fmt.Errorf("response is larger than %d bytes", maxArchiveSize)
+	}
+
+	return data, nil
+}
+
+// resolveVersion returns the requested version if it's available, or the latest stable version if no version is requested.
+func resolveVersion(versions []string, version string) (string, error) {
+	if version != "" {
+		for _, available := range versions {
+			if available == version {
+				return version, nil
+			}
+		}
+
+		return "", fmt.Errorf("chart version %q is not found", version)
+	}
+
+	var (
+		latest       semver.Version
+		latestString string
+	)
+
+	for _, available := range versions {
+		parsed, err := semver.ParseTolerant(available)
+		if err != nil || len(parsed.Pre) > 0 {
+			continue
+		}
+
+		if latestString == "" || parsed.GT(latest) {
+			latest = parsed
+			latestString = available
+		}
+	}
+
+	if latestString == "" {
+		return "", errors.New("no stable chart versions are found")
+	}
+
+	return latestString, nil
+}
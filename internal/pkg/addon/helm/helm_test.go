@@ -0,0 +1,365 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package helm_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/pkg/addon/helm"
+)
+
+func archive(t *testing.T, chartName string, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     chartName + "/" + name,
+			Mode:     0o644,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}))
+
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	return buf.Bytes()
+}
+
+var testChart = map[string]string{
+	"Chart.yaml": `apiVersion: v2
+name: echo
+version: 1.2.0
+appVersion: "2.0"
+`,
+	"values.yaml": `replicas: 1
+image:
+  repository: ghcr.io/example/echo
+  tag: ""
+labels:
+  app: echo
+monitoring: false
+`,
+	"templates/_helpers.tpl": `{{- define "echo.fullname" -}}
+{{ .Release.Name }}-{{ .Chart.Name }}
+{{- end -}}`,
+	"templates/NOTES.txt": `Thank you for installing {{ .Chart.Name }}.`,
+	"templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ include "echo.fullname" . }}
+  namespace: {{ .Release.Namespace }}
+  labels:
+    {{- toYaml .Values.labels | nindent 4 }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    spec:
+      containers:
+        - name: echo
+          image: {{ .Values.image.repository }}:{{ .Values.image.tag | default .Chart.AppVersion }}
+          {{- if semverCompare ">=1.30" .Capabilities.KubeVersion.Version }}
+          args: ["--modern"]
+          {{- end }}
+`,
+	"templates/servicemonitor.yaml": `{{- if and .Values.monitoring (.Capabilities.APIVersions.Has "monitoring.coreos.com/v1") }}
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: {{ include "echo.fullname" . }}
+{{- end }}
+`,
+	"crds/crd.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: echoes.example.com
+`,
+}
+
+func TestRender(t *testing.T) {
+	chart, err := helm.LoadArchive(archive(t, "echo", testChart))
+	require.NoError(t, err)
+
+	assert.Equal(t, "echo", chart.Name())
+
+	// the null values remove the default values of the chart
+	values, err := helm.ParseValues(`replicas: 3
+labels:
+  app: null
+  tier: backend
+monitoring: true
+`)
+	require.NoError(t, err)
+
+	manifests, err := helm.Render(chart, values, helm.Release{Name: "test", Namespace: "apps"}, helm.Capabilities{
+		KubeVersion: "1.31.1",
+		APIVersions: []string{"monitoring.coreos.com/v1"},
+	})
+	require.NoError(t, err)
+	require.Len(t, manifests.Manifests, 3)
+
+	assert.Contains(t, manifests.Manifests[0], "kind: CustomResourceDefinition")
+
+	assert.Equal(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-echo
+  namespace: apps
+  labels:
+    tier: backend
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+        - name: echo
+          image: ghcr.io/example/echo:2.0
+          args: ["--modern"]`, manifests.Manifests[1])
+
+	assert.Contains(t, manifests.Manifests[2], "kind: ServiceMonitor")
+
+	// the template conditions are evaluated against the cluster capabilities
+	chart, err = helm.LoadArchive(archive(t, "echo", testChart))
+	require.NoError(t, err)
+
+	manifests, err = helm.Render(chart, nil, helm.Release{Name: "test", Namespace: "apps"}, helm.Capabilities{KubeVersion: "1.29.0"})
+	require.NoError(t, err)
+	require.Len(t, manifests.Manifests, 2)
+
+	assert.NotContains(t, manifests.Manifests[1], "--modern")
+	assert.Contains(t, manifests.Manifests[1], "replicas: 1")
+	assert.Contains(t, manifests.Manifests[1], "app: echo")
+}
+
+func TestRenderSubcharts(t *testing.T) {
+	files := map[string]string{
+		"Chart.yaml": `apiVersion: v2
+name: parent
+version: 0.1.0
+dependencies:
+  - name: child
+    version: 0.1.0
+    condition: child.enabled
+`,
+		"values.yaml": `child:
+  enabled: true
+  greeting: hello
+`,
+		"files/config/a.conf": "a = 1\n",
+		"files/config/b.conf": "b = 2\n",
+		"files/ignored.txt":   "ignored",
+		"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-config
+data:
+  {{- (.Files.Glob "files/config/*.conf").AsConfig | nindent 2 }}
+`,
+		"charts/child/Chart.yaml": `apiVersion: v2
+name: child
+version: 0.1.0
+`,
+		"charts/child/values.yaml": `greeting: hi
+`,
+		"charts/child/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-{{ .Chart.Name }}
+data:
+  greeting: {{ .Values.greeting }}
+`,
+	}
+
+	render := func(values string) []string {
+		chart, err := helm.LoadArchive(archive(t, "parent", files))
+		require.NoError(t, err)
+
+		parsed, err := helm.ParseValues(values)
+		require.NoError(t, err)
+
+		manifests, err := helm.Render(chart, parsed, helm.Release{Name: "test", Namespace: "apps"}, helm.Capabilities{KubeVersion: "1.31.1"})
+		require.NoError(t, err)
+
+		return manifests.Manifests
+	}
+
+	manifests := render(`child:
+  greeting: hey
+`)
+	require.Len(t, manifests, 2)
+
+	assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-child
+data:
+  greeting: hey`, manifests[0])
+
+	assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+data:
+  a.conf: |
+    a = 1
+  b.conf: |
+    b = 2`, manifests[1])
+
+	// the disabled subcharts are not rendered
+	manifests = render(`child:
+  enabled: false
+`)
+	require.Len(t, manifests, 1)
+	assert.Contains(t, manifests[0], "name: test-config")
+}
+
+func TestRenderHooks(t *testing.T) {
+	files := map[string]string{
+		"Chart.yaml": `apiVersion: v2
+name: hooks
+version: 0.1.0
+`,
+		"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  upgrade: "{{ .Release.IsUpgrade }}"
+`,
+		"templates/hooks.yaml": `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install,pre-upgrade
+    helm.sh/hook-weight: "5"
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install,pre-upgrade
+    helm.sh/hook-weight: "-5"
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: notify
+  annotations:
+    helm.sh/hook: post-upgrade
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test
+  annotations:
+    helm.sh/hook: test
+`,
+	}
+
+	render := func(upgrade bool) helm.Manifests {
+		chart, err := helm.LoadArchive(archive(t, "hooks", files))
+		require.NoError(t, err)
+
+		manifests, err := helm.Render(chart, nil, helm.Release{Name: "test", Namespace: "apps", Upgrade: upgrade}, helm.Capabilities{KubeVersion: "1.31.1"})
+		require.NoError(t, err)
+
+		return manifests
+	}
+
+	manifests := render(false)
+
+	require.Len(t, manifests.Manifests, 1)
+	assert.Contains(t, manifests.Manifests[0], `upgrade: "false"`)
+
+	// the hooks are ordered by their weights
+	require.Len(t, manifests.PreHooks, 2)
+	assert.Contains(t, manifests.PreHooks[0], "kind: ServiceAccount")
+	assert.Contains(t, manifests.PreHooks[1], "kind: Job")
+	assert.Empty(t, manifests.PostHooks)
+
+	manifests = render(true)
+
+	require.Len(t, manifests.Manifests, 1)
+	assert.Contains(t, manifests.Manifests[0], `upgrade: "true"`)
+	assert.Len(t, manifests.PreHooks, 2)
+	require.Len(t, manifests.PostHooks, 1)
+	assert.Contains(t, manifests.PostHooks[0], "name: notify")
+}
+
+func TestLoadArchiveUnsupported(t *testing.T) {
+	_, err := helm.LoadArchive(archive(t, "lib", map[string]string{
+		"Chart.yaml": "apiVersion: v2\nname: lib\nversion: 0.1.0\ntype: library\n",
+	}))
+	require.ErrorContains(t, err, "library chart")
+}
+
+func TestFetchRepository(t *testing.T) {
+	data := archive(t, "echo", testChart)
+	requests := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/charts/index.yaml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(`apiVersion: v1
+entries:
+  echo:
+    - version: 1.3.0-rc.1
+      urls: [echo-1.3.0-rc.1.tgz]
+    - version: 1.2.0
+      urls: [echo-1.2.0.tgz]
+    - version: 1.1.0
+      urls: [echo-1.1.0.tgz]
+`)) //nolint:errcheck
+	})
+	mux.HandleFunc("/charts/echo-1.2.0.tgz", func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		w.Write(data) //nolint:errcheck
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	fetcher := helm.NewFetcher(server.Client())
+
+	// the latest stable version is picked
+	chart, err := fetcher.Fetch(ctx, server.URL+"/charts", "echo", "")
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.0", chart.Metadata.Version)
+
+	// the pinned version is cached
+	for range 2 {
+		_, err = fetcher.Fetch(ctx, server.URL+"/charts", "echo", "1.2.0")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, requests)
+
+	_, err = fetcher.Fetch(ctx, server.URL+"/charts", "echo", "2.0.0")
+	require.ErrorContains(t, err, "not found")
+}
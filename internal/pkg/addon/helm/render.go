@@ -0,0 +1,143 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package helm
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"sigs.k8s.io/yaml"
+)
+
+// notesFileSuffix is the suffix of the chart notes, which are not installed.
+const notesFileSuffix = "NOTES.txt"
+
+// Release describes the release the chart is rendered for.
+type Release struct {
+	Name      string
+	Namespace string
+	// Upgrade is true if the release is already installed, it selects the upgrade hooks instead of the install hooks.
+	Upgrade bool
+}
+
+// Capabilities describes the cluster the chart is rendered for.
+type Capabilities struct {
+	// KubeVersion is the Kubernetes version of the cluster, e.g. 1.31.2.
+	KubeVersion string
+	// APIVersions are the API versions served by the cluster, both as "group/version" and "group/version/Kind".
+	APIVersions []string
+}
+
+// Manifests are the rendered manifests of the chart.
+type Manifests struct {
+	// Manifests are the CRDs of the chart and its subcharts followed by the rendered templates in the installation order.
+	Manifests []string
+	// PreHooks are the pre-install or the pre-upgrade hooks ordered by their weights.
+	PreHooks []string
+	// PostHooks are the post-install or the post-upgrade hooks ordered by their weights.
+	PostHooks []string
+}
+
+// ParseValues parses the YAML values.
+func ParseValues(data string) (map[string]any, error) {
+	values := map[string]any{}
+
+	if err := yaml.Unmarshal([]byte(data), &values); err != nil {
+		return nil, fmt.Errorf("failed to parse the values: %w", err)
+	}
+
+	if values == nil {
+		values = map[string]any{}
+	}
+
+	return values, nil
+}
+
+// Render renders the chart with its enabled subcharts, the values are merged over the default values of the chart the same way Helm does.
+//
+// The hooks of the other events than the install or the upgrade of the release, the notes and the empty documents are skipped.
+// The lookup function returns no objects, as the chart is rendered without the access to the cluster.
+func Render(ch *chart.Chart, values map[string]any, rel Release, capabilities Capabilities) (Manifests, error) {
+	kubeVersion, err := chartutil.ParseKubeVersion(capabilities.KubeVersion)
+	if err != nil {
+		return Manifests{}, fmt.Errorf("invalid Kubernetes version %q: %w", capabilities.KubeVersion, err)
+	}
+
+	if err = chartutil.ProcessDependenciesWithMerge(ch, values); err != nil {
+		return Manifests{}, fmt.Errorf("failed to process the chart dependencies: %w", err)
+	}
+
+	caps := chartutil.DefaultCapabilities.Copy()
+	caps.KubeVersion = *kubeVersion
+	caps.APIVersions = chartutil.VersionSet(capabilities.APIVersions)
+
+	renderValues, err := chartutil.ToRenderValues(ch, values, chartutil.ReleaseOptions{
+		Name:      rel.Name,
+		Namespace: rel.Namespace,
+		Revision:  1,
+		IsInstall: !rel.Upgrade,
+		IsUpgrade: rel.Upgrade,
+	}, caps)
+	if err != nil {
+		return Manifests{}, err
+	}
+
+	files, err := engine.Render(ch, renderValues)
+	if err != nil {
+		return Manifests{}, err
+	}
+
+	for name := range files {
+		if strings.HasSuffix(name, notesFileSuffix) {
+			delete(files, name)
+		}
+	}
+
+	hooks, manifests, err := releaseutil.SortManifests(files, caps.APIVersions, releaseutil.InstallOrder)
+	if err != nil {
+		return Manifests{}, err
+	}
+
+	var rendered Manifests
+
+	for _, crd := range ch.CRDObjects() {
+		rendered.Manifests = append(rendered.Manifests, string(crd.File.Data))
+	}
+
+	for _, manifest := range manifests {
+		rendered.Manifests = append(rendered.Manifests, manifest.Content)
+	}
+
+	preEvent, postEvent := rel.events()
+
+	slices.SortStableFunc(hooks, func(a, b *release.Hook) int { return a.Weight - b.Weight })
+
+	for _, hook := range hooks {
+		switch {
+		case slices.Contains(hook.Events, preEvent):
+			rendered.PreHooks = append(rendered.PreHooks, hook.Manifest)
+		case slices.Contains(hook.Events, postEvent):
+			rendered.PostHooks = append(rendered.PostHooks, hook.Manifest)
+		}
+	}
+
+	return rendered, nil
+}
+
+// events returns the hook events of the release installation or upgrade.
+func (r Release) events() (release.HookEvent, release.HookEvent) {
+	if r.Upgrade {
+		return release.HookPreUpgrade, release.HookPostUpgrade
+	}
+
+	return release.HookPreInstall, release.HookPostInstall
+}
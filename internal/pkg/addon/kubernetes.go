@@ -0,0 +1,340 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package addon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/go-kubernetes/kubernetes/manifests"
+	"github.com/siderolabs/go-retry/retry"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+)
+
+// APIVersions returns the API versions served by the cluster, both as "group/version" and "group/version/Kind".
+func APIVersions(config *rest.Config) ([]string, error) {
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resourceLists, err := client.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	var versions []string
+
+	for _, list := range resourceLists {
+		versions = append(versions, list.GroupVersion)
+
+		for _, res := range list.APIResources {
+			versions = append(versions, list.GroupVersion+"/"+res.Kind)
+		}
+	}
+
+	return versions, nil
+}
+
+func newRESTMapper(config *rest.Config) (meta.RESTMapper, error) {
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	groupResources, err := restmapper.GetAPIGroupResources(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return restmapper.NewDiscoveryRESTMapper(groupResources), nil
+}
+
+// Apply applies the objects to the cluster, the namespaced objects without the namespace are put into the given namespace.
+//
+// It returns the number of the objects which were created or updated.
+func Apply(ctx context.Context, config *rest.Config, namespace string, objects []*unstructured.Unstructured, logger *zap.Logger) (int, error) {
+	mapper, err := newRESTMapper(config)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, obj := range objects {
+		mapping, err := mapper.RESTMapping(obj.GroupVersionKind().GroupKind(), obj.GroupVersionKind().Version)
+		if err != nil {
+			// the kind might be defined by the CRD of the addon, which is not created yet, the next sync sets the namespace
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return 0, err
+		}
+
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace && obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+	}
+
+	errCh := make(chan error, 1)
+	resultCh := make(chan manifests.SyncResult)
+
+	panichandler.Go(func() {
+		errCh <- manifests.Sync(ctx, objects, rest.CopyConfig(config), false, resultCh)
+	}, logger)
+
+	changed := 0
+
+	for {
+		select {
+		case err := <-errCh:
+			return changed, err
+		case result := <-resultCh:
+			if !result.Skipped {
+				changed++
+			}
+		}
+	}
+}
+
+// Delete deletes the referenced objects of the addon from the cluster, the objects which are already gone are skipped.
+//
+// The objects which are no longer labeled with the addon ID are kept, as they were taken over by another addon or by the user.
+func Delete(ctx context.Context, config *rest.Config, addonID string, objects []*unstructured.Unstructured) error {
+	mapper, err := newRESTMapper(config)
+	if err != nil {
+		return err
+	}
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	return deleteObjects(ctx, client, mapper, addonID, objects)
+}
+
+func deleteObjects(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, addonID string, objects []*unstructured.Unstructured) error {
+	// delete in the reverse installation order
+	for i := len(objects) - 1; i >= 0; i-- {
+		obj := objects[i]
+
+		dr, err := resourceClient(client, mapper, obj)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return err
+		}
+
+		if _, err = deleteOwned(ctx, dr, obj, addonID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteOwned deletes the live object if it is labeled with the addon ID, it returns true if the object was deleted.
+//
+// The object is deleted with the UID and the resource version preconditions, so the object which was replaced or changed after the check is kept.
+func deleteOwned(ctx context.Context, dr dynamic.ResourceInterface, obj *unstructured.Unstructured, addonID string) (bool, error) {
+	live, err := dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	if live.GetLabels()[omni.LabelAddon] != addonID {
+		return false, nil
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	uid := live.GetUID()
+	resourceVersion := live.GetResourceVersion()
+
+	err = dr.Delete(ctx, obj.GetName(), metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+		Preconditions: &metav1.Preconditions{
+			UID:             &uid,
+			ResourceVersion: &resourceVersion,
+		},
+	})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to delete %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	return true, nil
+}
+
+func resourceClient(client dynamic.Interface, mapper meta.RESTMapper, obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	mapping, err := mapper.RESTMapping(obj.GroupVersionKind().GroupKind(), obj.GroupVersionKind().Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return client.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+	}
+
+	return client.Resource(mapping.Resource), nil
+}
+
+// HookTimeout is the timeout of the Helm hooks, the same as the default timeout of Helm.
+const HookTimeout = 5 * time.Minute
+
+// ApplyHooks applies the Helm hooks one by one, the hook Jobs and Pods are awaited to complete before the next hook is applied.
+//
+// The hooks left from the previous installation or upgrade are deleted before the hooks are applied again,
+// the same as with the default "before-hook-creation" delete policy of Helm.
+func ApplyHooks(ctx context.Context, config *rest.Config, namespace string, hooks []*unstructured.Unstructured, logger *zap.Logger) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	mapper, err := newRESTMapper(config)
+	if err != nil {
+		return err
+	}
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		mapping, err := mapper.RESTMapping(hook.GroupVersionKind().GroupKind(), hook.GroupVersionKind().Version)
+		if err != nil {
+			return fmt.Errorf("hook %s %s: %w", hook.GetKind(), hook.GetName(), err)
+		}
+
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace && hook.GetNamespace() == "" {
+			hook.SetNamespace(namespace)
+		}
+
+		dr, err := resourceClient(client, mapper, hook)
+		if err != nil {
+			return err
+		}
+
+		if err = deleteHook(ctx, dr, hook); err != nil {
+			return err
+		}
+
+		if _, err = Apply(ctx, config, namespace, []*unstructured.Unstructured{hook}, logger); err != nil {
+			return fmt.Errorf("failed to apply hook %s %s: %w", hook.GetKind(), hook.GetName(), err)
+		}
+
+		if err = waitForHook(ctx, dr, hook); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteHook deletes the hook of the addon left from the previous run and waits for it to be gone.
+func deleteHook(ctx context.Context, dr dynamic.ResourceInterface, hook *unstructured.Unstructured) error {
+	deleted, err := deleteOwned(ctx, dr, hook, hook.GetLabels()[omni.LabelAddon])
+	if err != nil || !deleted {
+		return err
+	}
+
+	return retry.Constant(HookTimeout, retry.WithUnits(time.Second)).RetryWithContext(ctx, func(ctx context.Context) error {
+		_, err := dr.Get(ctx, hook.GetName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return retry.ExpectedErrorf("hook %s %s is still being deleted", hook.GetKind(), hook.GetName())
+	})
+}
+
+// waitForHook waits for the hook Job or Pod to complete, the hooks of the other kinds are ready once applied.
+func waitForHook(ctx context.Context, dr dynamic.ResourceInterface, hook *unstructured.Unstructured) error {
+	var done func(obj *unstructured.Unstructured) (bool, error)
+
+	switch hook.GroupVersionKind().GroupKind() {
+	case batchv1.SchemeGroupVersion.WithKind("Job").GroupKind():
+		done = func(obj *unstructured.Unstructured) (bool, error) {
+			conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions") //nolint:errcheck
+
+			for _, condition := range conditions {
+				condition, ok := condition.(map[string]any)
+				if !ok || condition["status"] != string(corev1.ConditionTrue) {
+					continue
+				}
+
+				switch condition["type"] {
+				case string(batchv1.JobComplete):
+					return true, nil
+				case string(batchv1.JobFailed):
+					return false, fmt.Errorf("hook job %s failed: %v", hook.GetName(), condition["message"])
+				}
+			}
+
+			return false, nil
+		}
+	case corev1.SchemeGroupVersion.WithKind("Pod").GroupKind():
+		done = func(obj *unstructured.Unstructured) (bool, error) {
+			phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase") //nolint:errcheck
+
+			switch corev1.PodPhase(phase) { //nolint:exhaustive
+			case corev1.PodSucceeded:
+				return true, nil
+			case corev1.PodFailed:
+				return false, fmt.Errorf("hook pod %s failed", hook.GetName())
+			}
+
+			return false, nil
+		}
+	default:
+		return nil
+	}
+
+	return retry.Constant(HookTimeout, retry.WithUnits(2*time.Second)).RetryWithContext(ctx, func(ctx context.Context) error {
+		obj, err := dr.Get(ctx, hook.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		ok, err := done(obj)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return retry.ExpectedErrorf("hook %s %s is not completed", hook.GetKind(), hook.GetName())
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package addon_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/addon"
+)
+
+func configMap(name string, labels map[string]string) *unstructured.Unstructured {
+	obj := addon.ObjectFromRef(&specs.AddonStatusSpec_Object{
+		ApiVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  "apps",
+		Name:       name,
+	})
+
+	obj.SetLabels(labels)
+
+	return obj
+}
+
+func TestDeleteOwned(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(gvr.GroupVersion().WithKind("ConfigMap"), meta.RESTScopeNamespace)
+
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		configMap("owned", map[string]string{omni.LabelAddon: "echo"}),
+		configMap("other-addon", map[string]string{omni.LabelAddon: "other"}),
+		configMap("unlabeled", nil),
+	)
+
+	refs := []*unstructured.Unstructured{
+		configMap("owned", nil),
+		configMap("other-addon", nil),
+		configMap("unlabeled", nil),
+		configMap("gone", nil),
+	}
+
+	require.NoError(t, addon.DeleteObjects(ctx, client, mapper, "echo", refs))
+
+	// only the object still labeled with the addon ID is deleted
+	_, err := client.Resource(gvr).Namespace("apps").Get(ctx, "owned", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	for _, name := range []string{"other-addon", "unlabeled"} {
+		_, err = client.Resource(gvr).Namespace("apps").Get(ctx, name, metav1.GetOptions{})
+		assert.NoError(t, err, name)
+	}
+}
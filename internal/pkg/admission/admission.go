@@ -0,0 +1,556 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package admission implements the user-defined admission policies evaluated on the resource writes.
+//
+// The policy expressions are CEL expressions with access to the following variables:
+//
+//   - id: the ID of the resource;
+//   - labels: the labels of the resource;
+//   - spec: the spec of the resource, with the proto field names, e.g. spec.features.disk_encryption;
+//   - operation: "create", "update" or "destroy";
+//   - old_spec, old_labels: the spec and the labels before the write, empty on create;
+//   - config: the machine config document of the config patch, templates are rendered with the sample data, empty for the other resources;
+//   - documents: all documents of the config patch, empty for the other resources;
+//   - machine_configs: the machine config documents generated for the cluster machines targeted by the config patch with the config patch applied,
+//     the config patch is applied on top of the current generated machine config, the current machine configs are used on destroy, empty for the other resources;
+//   - latest_kubernetes_version: the latest Kubernetes version supported by Omni.
+//
+// The functions semver_major(string), semver_minor(string) and semver_compare(string, string) help to compare the versions.
+//
+// Examples:
+//
+//	!("env" in labels) || labels["env"] != "prod" || (spec.features.disk_encryption && spec.backup_configuration.enabled)
+//	!has(config.machine) || !has(config.machine.kubelet) || !has(config.machine.kubelet.extraArgs) || config.machine.kubelet.extraArgs["anonymous-auth"] != "true"
+//	machine_configs.all(c, !has(c.machine.kubelet.extraArgs) || c.machine.kubelet.extraArgs["anonymous-auth"] != "true")
+//	semver_minor(spec.kubernetes_version) >= semver_minor(latest_kubernetes_version) - 2
+package admission
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/celexpr"
+)
+
+const (
+	// OperationCreate is the value of the operation variable on create.
+	OperationCreate = omni.ChangeRequestOperationCreate
+	// OperationUpdate is the value of the operation variable on update.
+	OperationUpdate = omni.ChangeRequestOperationUpdate
+	// OperationDestroy is the value of the operation variable on destroy, including the teardown.
+	OperationDestroy = omni.ChangeRequestOperationDestroy
+)
+
+type protoSpec interface {
+	GetValue() proto.Message
+}
+
+// specMessage returns an empty spec message of the resource type.
+func specMessage(resourceType resource.Type) (proto.Message, error) {
+	res, err := protobuf.CreateResource(resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("unknown resource type %q", resourceType)
+	}
+
+	spec, ok := res.Spec().(protoSpec)
+	if !ok {
+		return nil, fmt.Errorf("resource type %q doesn't have a protobuf spec", resourceType)
+	}
+
+	return spec.GetValue(), nil
+}
+
+func semverFunction(name string, f func(semver.Version) int64) cel.EnvOption {
+	return cel.Function(name,
+		cel.Overload(name+"_string", []*cel.Type{cel.StringType}, cel.IntType,
+			cel.UnaryBinding(func(value ref.Val) ref.Val {
+				version, err := semver.ParseTolerant(value.(types.String).Value().(string)) //nolint:forcetypeassert,errcheck
+				if err != nil {
+					return types.NewErr("%s: %s", name, err)
+				}
+
+				return types.Int(f(version))
+			}),
+		),
+	)
+}
+
+var (
+	envsMu sync.Mutex
+	envs   = map[resource.Type]*cel.Env{}
+)
+
+// getEnv returns the CEL environment with the spec variables typed as the spec of the resource type.
+func getEnv(resourceType resource.Type) (*cel.Env, error) {
+	envsMu.Lock()
+	defer envsMu.Unlock()
+
+	if env, ok := envs[resourceType]; ok {
+		return env, nil
+	}
+
+	msg, err := specMessage(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	specType := cel.ObjectType(string(msg.ProtoReflect().Descriptor().FullName()))
+
+	env, err := cel.NewEnv(
+		cel.Types(msg),
+		cel.Variable("id", cel.StringType),
+		cel.Variable("labels", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("spec", specType),
+		cel.Variable("operation", cel.StringType),
+		cel.Variable("old_labels", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("old_spec", specType),
+		cel.Variable("config", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("documents", cel.ListType(cel.DynType)),
+		cel.Variable("machine_configs", cel.ListType(cel.DynType)),
+		cel.Variable("latest_kubernetes_version", cel.StringType),
+		cel.CrossTypeNumericComparisons(true),
+		semverFunction("semver_major", func(v semver.Version) int64 { return int64(v.Major) }),
+		semverFunction("semver_minor", func(v semver.Version) int64 { return int64(v.Minor) }),
+		cel.Function("semver_compare",
+			cel.Overload("semver_compare_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.IntType,
+				cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+					a, err := semver.ParseTolerant(lhs.(types.String).Value().(string)) //nolint:forcetypeassert,errcheck
+					if err != nil {
+						return types.NewErr("semver_compare: %s", err)
+					}
+
+					b, err := semver.ParseTolerant(rhs.(types.String).Value().(string)) //nolint:forcetypeassert,errcheck
+					if err != nil {
+						return types.NewErr("semver_compare: %s", err)
+					}
+
+					return types.Int(a.Compare(b))
+				}),
+			),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	envs[resourceType] = env
+
+	return env, nil
+}
+
+type rule struct {
+	program cel.Program
+	source  string
+	message string
+}
+
+type typePolicy struct {
+	match cel.Program
+	rules []rule
+}
+
+// Policy is a compiled admission policy.
+type Policy struct {
+	types        map[resource.Type]*typePolicy
+	operations   map[string]struct{}
+	id           resource.ID
+	approverRole role.Role
+	action       specs.AdmissionPolicySpec_Action
+}
+
+// adminResourceTypes are the resource types which only the admins are allowed to write.
+var adminResourceTypes = map[resource.Type]struct{}{
+	authres.AccessPolicyType:  {},
+	authres.IdentityType:      {},
+	authres.SAMLLabelRuleType: {},
+	authres.UserType:          {},
+	omni.EtcdBackupS3ConfType: {},
+	omni.AdmissionPolicyType:  {},
+}
+
+// WriteRole returns the minimum role of the users allowed to write the resources of the given type.
+func WriteRole(resourceType resource.Type) role.Role {
+	if _, ok := adminResourceTypes[resourceType]; ok {
+		return role.Admin
+	}
+
+	return role.Operator
+}
+
+// Compile compiles the admission policy for each of its resource types.
+func Compile(policy *omni.AdmissionPolicy) (*Policy, error) {
+	spec := policy.TypedSpec().Value
+
+	if len(spec.ResourceTypes) == 0 {
+		return nil, errors.New("at least one resource type is required")
+	}
+
+	if len(spec.Rules) == 0 {
+		return nil, errors.New("at least one rule is required")
+	}
+
+	compiled := &Policy{
+		id:         policy.Metadata().ID(),
+		types:      make(map[resource.Type]*typePolicy, len(spec.ResourceTypes)),
+		operations: map[string]struct{}{},
+		action:     spec.Action,
+	}
+
+	operations := spec.Operations
+	if len(operations) == 0 {
+		operations = []string{OperationCreate, OperationUpdate}
+	}
+
+	for _, operation := range operations {
+		switch operation {
+		case OperationCreate, OperationUpdate, OperationDestroy:
+			compiled.operations[operation] = struct{}{}
+		default:
+			return nil, fmt.Errorf("unknown operation %q, must be one of %q, %q or %q", operation, OperationCreate, OperationUpdate, OperationDestroy)
+		}
+	}
+
+	if spec.Action == specs.AdmissionPolicySpec_REQUIRE_APPROVAL {
+		// the approvers should be allowed to make the held writes themselves
+		compiled.approverRole = role.Operator
+
+		for _, resourceType := range spec.ResourceTypes {
+			if writeRole := WriteRole(resourceType); writeRole.Check(compiled.approverRole) == nil {
+				compiled.approverRole = writeRole
+			}
+		}
+
+		if spec.ApproverRole != "" {
+			approverRole, err := role.Parse(spec.ApproverRole)
+			if err != nil {
+				return nil, err
+			}
+
+			if approverRole.Check(compiled.approverRole) != nil {
+				return nil, fmt.Errorf("approver role should be at least %q", compiled.approverRole)
+			}
+
+			compiled.approverRole = approverRole
+		}
+	}
+
+	for _, resourceType := range spec.ResourceTypes {
+		if resourceType == omni.AdmissionPolicyType {
+			return nil, errors.New("admission policies can not be applied to the admission policies")
+		}
+
+		env, err := getEnv(resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		tp := &typePolicy{}
+
+		if spec.MatchExpression != "" {
+			if tp.match, err = celexpr.CompileBool(env, spec.MatchExpression); err != nil {
+				return nil, fmt.Errorf("invalid match expression for %q: %w", resourceType, err)
+			}
+		}
+
+		for i, r := range spec.Rules {
+			if r.Message == "" {
+				return nil, fmt.Errorf("rule %d: message is required", i)
+			}
+
+			program, err := celexpr.CompileBool(env, r.Expression)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: invalid expression for %q: %w", i, resourceType, err)
+			}
+
+			tp.rules = append(tp.rules, rule{
+				program: program,
+				source:  r.Expression,
+				message: r.Message,
+			})
+		}
+
+		compiled.types[resourceType] = tp
+	}
+
+	return compiled, nil
+}
+
+// ID returns the ID of the policy.
+func (p *Policy) ID() resource.ID {
+	return p.id
+}
+
+// AppliesTo returns true if the policy has the rules for the resource type and the operation.
+func (p *Policy) AppliesTo(resourceType resource.Type, operation string) bool {
+	_, ok := p.types[resourceType]
+	_, opOk := p.operations[operation]
+
+	return ok && opOk
+}
+
+// RequiresApproval returns true if the writes violating the policy are held for the approval instead of being denied.
+func (p *Policy) RequiresApproval() bool {
+	return p.action == specs.AdmissionPolicySpec_REQUIRE_APPROVAL
+}
+
+// ApproverRole returns the minimum role of the user approving the writes held by the policy.
+func (p *Policy) ApproverRole() role.Role {
+	return p.approverRole
+}
+
+// Violation is returned by Evaluate when the write violates the policy rules.
+type Violation struct {
+	PolicyID resource.ID
+	Messages []string
+}
+
+// Error implements error interface.
+func (v *Violation) Error() string {
+	return fmt.Sprintf("denied by admission policy %q: %s", v.PolicyID, strings.Join(v.Messages, "; "))
+}
+
+// Input is the resource write evaluated by the policies.
+type Input struct {
+	// Resource is the created or the updated resource.
+	Resource resource.Resource
+	// Existing is the resource before the write, nil on create.
+	Existing resource.Resource
+	// Operation is the write operation, defaults to OperationCreate or OperationUpdate depending on whether the existing resource is set.
+	Operation string
+	// LatestKubernetesVersion is called only if the expressions access the latest_kubernetes_version variable.
+	LatestKubernetesVersion func() (string, error)
+	// MachineConfigs returns the machine configs of the cluster machines targeted by the config patch as they are after the write.
+	//
+	// It is called only if the expressions access the machine_configs variable.
+	MachineConfigs func() ([][]byte, error)
+}
+
+// Evaluate evaluates the policy against the write, the rules violations are returned as *Violation.
+//
+// The rules failing to evaluate are violated.
+func (p *Policy) Evaluate(in Input) error {
+	tp, ok := p.types[in.Resource.Metadata().Type()]
+	if !ok {
+		return nil
+	}
+
+	vars, err := activation(in)
+	if err != nil {
+		return fmt.Errorf("admission policy %q: %w", p.id, err)
+	}
+
+	if tp.match != nil {
+		matched, err := celexpr.EvalBool(tp.match, vars)
+		if err != nil {
+			return fmt.Errorf("admission policy %q: failed to evaluate the match expression: %w", p.id, err)
+		}
+
+		if !matched {
+			return nil
+		}
+	}
+
+	var denials []string
+
+	for _, r := range tp.rules {
+		admitted, err := celexpr.EvalBool(r.program, vars)
+		if err != nil {
+			denials = append(denials, fmt.Sprintf("%s (failed to evaluate %q: %s)", r.message, r.source, err))
+
+			continue
+		}
+
+		if !admitted {
+			denials = append(denials, r.message)
+		}
+	}
+
+	if len(denials) == 0 {
+		return nil
+	}
+
+	return &Violation{
+		PolicyID: p.id,
+		Messages: denials,
+	}
+}
+
+func activation(in Input) (map[string]any, error) {
+	spec, ok := in.Resource.Spec().(protoSpec)
+	if !ok {
+		return nil, fmt.Errorf("resource type %q doesn't have a protobuf spec", in.Resource.Metadata().Type())
+	}
+
+	vars := map[string]any{
+		"id":         in.Resource.Metadata().ID(),
+		"labels":     rawLabels(in.Resource.Metadata().Labels()),
+		"spec":       spec.GetValue(),
+		"operation":  OperationCreate,
+		"old_labels": map[string]string{},
+		"old_spec":   spec.GetValue().ProtoReflect().Type().New().Interface(),
+		"latest_kubernetes_version": func() ref.Val {
+			if in.LatestKubernetesVersion == nil {
+				return types.String("")
+			}
+
+			version, err := in.LatestKubernetesVersion()
+			if err != nil {
+				return types.NewErr("failed to get the latest Kubernetes version: %s", err)
+			}
+
+			return types.String(version)
+		},
+	}
+
+	if in.Existing != nil {
+		if existingSpec, ok := in.Existing.Spec().(protoSpec); ok {
+			vars["old_spec"] = existingSpec.GetValue()
+		}
+
+		vars["operation"] = OperationUpdate
+		vars["old_labels"] = rawLabels(in.Existing.Metadata().Labels())
+	}
+
+	if in.Operation != "" {
+		vars["operation"] = in.Operation
+	}
+
+	vars["config"] = map[string]any{}
+	vars["documents"] = []any{}
+	vars["machine_configs"] = []any{}
+
+	patch, ok := in.Resource.(*omni.ConfigPatch)
+	if !ok {
+		return vars, nil
+	}
+
+	data, err := patchData(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if vars["config"], vars["documents"], err = decodeDocuments(data); err != nil {
+		return nil, fmt.Errorf("failed to decode the config patch: %w", err)
+	}
+
+	vars["machine_configs"] = func() ref.Val {
+		configs, err := machineConfigs(in)
+		if err != nil {
+			return types.NewErr("failed to get the machine configs: %s", err)
+		}
+
+		return types.DefaultTypeAdapter.NativeToValue(configs)
+	}
+
+	return vars, nil
+}
+
+// patchData returns the data of the config patch, the templates are rendered with the sample data.
+//
+// The machine configs are generated with the templates rendered for each machine.
+func patchData(patch *omni.ConfigPatch) ([]byte, error) {
+	buffer, err := patch.TypedSpec().Value.GetUncompressedData()
+	if err != nil {
+		return nil, err
+	}
+
+	defer buffer.Free()
+
+	if omni.IsConfigPatchTemplate(patch) {
+		return omni.RenderConfigPatchTemplate(buffer.Data(), omni.ConfigPatchTemplateSampleData)
+	}
+
+	return bytes.Clone(buffer.Data()), nil
+}
+
+// machineConfigs decodes the machine configs of the cluster machines targeted by the config patch.
+func machineConfigs(in Input) ([]any, error) {
+	result := []any{}
+
+	if in.MachineConfigs == nil {
+		return result, nil
+	}
+
+	configs, err := in.MachineConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cfg := range configs {
+		config, _, err := decodeDocuments(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the machine config: %w", err)
+		}
+
+		result = append(result, config)
+	}
+
+	return result, nil
+}
+
+// decodeDocuments decodes the documents of the config patch or the machine config.
+//
+// The config is the machine config document, i.e. the document without the kind.
+func decodeDocuments(data []byte) (map[string]any, []any, error) {
+	config := map[string]any{}
+	documents := []any{}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+
+	foundConfig := false
+
+	for {
+		var document map[string]any
+
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, nil, err
+		}
+
+		if document == nil {
+			continue
+		}
+
+		documents = append(documents, document)
+
+		if _, hasKind := document["kind"]; !hasKind && !foundConfig {
+			config = document
+			foundConfig = true
+		}
+	}
+
+	return config, documents, nil
+}
+
+func rawLabels(labels *resource.Labels) map[string]string {
+	result := make(map[string]string, len(labels.Raw()))
+
+	for key, value := range labels.Raw() {
+		result[key] = value
+	}
+
+	return result
+}
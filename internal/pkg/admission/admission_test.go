@@ -0,0 +1,210 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package admission_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/admission"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+func newPolicy(resourceTypes []string, match string, rules ...*specs.AdmissionPolicySpec_Rule) *omni.AdmissionPolicy {
+	policy := omni.NewAdmissionPolicy(resources.DefaultNamespace, "policy")
+
+	policy.TypedSpec().Value.ResourceTypes = resourceTypes
+	policy.TypedSpec().Value.MatchExpression = match
+	policy.TypedSpec().Value.Rules = rules
+
+	return policy
+}
+
+func withApproval(policy *omni.AdmissionPolicy, approverRole string, operations ...string) *omni.AdmissionPolicy {
+	policy.TypedSpec().Value.Action = specs.AdmissionPolicySpec_REQUIRE_APPROVAL
+	policy.TypedSpec().Value.ApproverRole = approverRole
+	policy.TypedSpec().Value.Operations = operations
+
+	return policy
+}
+
+func TestCompile(t *testing.T) {
+	for _, test := range []struct {
+		policy        *omni.AdmissionPolicy
+		name          string
+		expectedError string
+	}{
+		{
+			name: "valid",
+			policy: newPolicy([]string{omni.ClusterType, omni.MachineSetType}, `"env" in labels && labels["env"] == "prod"`,
+				&specs.AdmissionPolicySpec_Rule{Expression: `id != ""`, Message: "id is required"},
+			),
+		},
+		{
+			name:          "no rules",
+			policy:        newPolicy([]string{omni.ClusterType}, ""),
+			expectedError: "at least one rule is required",
+		},
+		{
+			name:          "no types",
+			policy:        newPolicy(nil, "", &specs.AdmissionPolicySpec_Rule{Expression: "true", Message: "m"}),
+			expectedError: "at least one resource type is required",
+		},
+		{
+			name:          "unknown type",
+			policy:        newPolicy([]string{"Foos.omni.sidero.dev"}, "", &specs.AdmissionPolicySpec_Rule{Expression: "true", Message: "m"}),
+			expectedError: "unknown resource type",
+		},
+		{
+			name:          "self",
+			policy:        newPolicy([]string{omni.AdmissionPolicyType}, "", &specs.AdmissionPolicySpec_Rule{Expression: "true", Message: "m"}),
+			expectedError: "can not be applied to the admission policies",
+		},
+		{
+			name:          "unknown field",
+			policy:        newPolicy([]string{omni.ClusterType}, "", &specs.AdmissionPolicySpec_Rule{Expression: "spec.foo", Message: "m"}),
+			expectedError: "undefined field 'foo'",
+		},
+		{
+			name:          "not bool",
+			policy:        newPolicy([]string{omni.ClusterType}, "", &specs.AdmissionPolicySpec_Rule{Expression: "spec.kubernetes_version", Message: "m"}),
+			expectedError: "expression should evaluate to bool",
+		},
+		{
+			name:          "no message",
+			policy:        newPolicy([]string{omni.ClusterType}, "", &specs.AdmissionPolicySpec_Rule{Expression: "true"}),
+			expectedError: "message is required",
+		},
+		{
+			name: "approval",
+			policy: withApproval(newPolicy([]string{omni.ClusterType}, "", &specs.AdmissionPolicySpec_Rule{Expression: "true", Message: "m"}),
+				"Admin", admission.OperationDestroy),
+		},
+		{
+			name: "unknown operation",
+			policy: withApproval(newPolicy([]string{omni.ClusterType}, "", &specs.AdmissionPolicySpec_Rule{Expression: "true", Message: "m"}),
+				"", "delete"),
+			expectedError: `unknown operation "delete"`,
+		},
+		{
+			name: "approver role too low",
+			policy: withApproval(newPolicy([]string{omni.ClusterType}, "", &specs.AdmissionPolicySpec_Rule{Expression: "true", Message: "m"}),
+				"Reader"),
+			expectedError: `approver role should be at least "Operator"`,
+		},
+		{
+			name: "approver role lower than write role",
+			policy: withApproval(newPolicy([]string{omni.ClusterType, omni.EtcdBackupS3ConfType}, "", &specs.AdmissionPolicySpec_Rule{Expression: "true", Message: "m"}),
+				"Operator"),
+			expectedError: `approver role should be at least "Admin"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := admission.Compile(test.policy)
+			if test.expectedError == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	prodPolicy, err := admission.Compile(newPolicy([]string{omni.ClusterType}, `"env" in labels && labels["env"] == "prod"`,
+		&specs.AdmissionPolicySpec_Rule{Expression: "spec.features.disk_encryption", Message: "prod clusters must have disk encryption"},
+		&specs.AdmissionPolicySpec_Rule{Expression: "spec.backup_configuration.enabled", Message: "prod clusters must have backups"},
+		&specs.AdmissionPolicySpec_Rule{
+			Expression: "semver_minor(spec.kubernetes_version) >= semver_minor(latest_kubernetes_version) - 1",
+			Message:    "Kubernetes version is too old",
+		},
+		&specs.AdmissionPolicySpec_Rule{
+			Expression: `operation == "create" || semver_compare(spec.kubernetes_version, old_spec.kubernetes_version) >= 0`,
+			Message:    "Kubernetes downgrades are not allowed",
+		},
+	))
+	require.NoError(t, err)
+
+	require.True(t, prodPolicy.AppliesTo(omni.ClusterType, admission.OperationUpdate))
+	require.False(t, prodPolicy.AppliesTo(omni.ClusterType, admission.OperationDestroy))
+	require.False(t, prodPolicy.AppliesTo(omni.MachineSetType, admission.OperationCreate))
+	require.False(t, prodPolicy.RequiresApproval())
+
+	approvalPolicy, err := admission.Compile(withApproval(newPolicy([]string{omni.ClusterType}, "",
+		&specs.AdmissionPolicySpec_Rule{Expression: "false", Message: "m"}), "", admission.OperationDestroy))
+	require.NoError(t, err)
+
+	require.True(t, approvalPolicy.RequiresApproval())
+	require.Equal(t, role.Operator, approvalPolicy.ApproverRole())
+
+	// the approver role defaults to the role required to write the resources
+	s3ConfPolicy, err := admission.Compile(withApproval(newPolicy([]string{omni.EtcdBackupS3ConfType}, "",
+		&specs.AdmissionPolicySpec_Rule{Expression: "false", Message: "m"}), ""))
+	require.NoError(t, err)
+
+	require.Equal(t, role.Admin, s3ConfPolicy.ApproverRole())
+	require.True(t, approvalPolicy.AppliesTo(omni.ClusterType, admission.OperationDestroy))
+	require.False(t, approvalPolicy.AppliesTo(omni.ClusterType, admission.OperationUpdate))
+
+	latest := func() (string, error) { return "1.31.2", nil }
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "prod")
+	cluster.TypedSpec().Value.KubernetesVersion = "1.29.0"
+
+	// not matched
+	require.NoError(t, prodPolicy.Evaluate(admission.Input{Resource: cluster, LatestKubernetesVersion: latest}))
+
+	cluster.Metadata().Labels().Set("env", "prod")
+
+	err = prodPolicy.Evaluate(admission.Input{Resource: cluster, LatestKubernetesVersion: latest})
+	require.EqualError(t, err, `denied by admission policy "policy": prod clusters must have disk encryption; prod clusters must have backups; Kubernetes version is too old`)
+
+	cluster.TypedSpec().Value.Features = &specs.ClusterSpec_Features{DiskEncryption: true}
+	cluster.TypedSpec().Value.BackupConfiguration = &specs.EtcdBackupConf{Enabled: true}
+	cluster.TypedSpec().Value.KubernetesVersion = "1.30.1"
+
+	require.NoError(t, prodPolicy.Evaluate(admission.Input{Resource: cluster, LatestKubernetesVersion: latest}))
+
+	updated := cluster.DeepCopy().(*omni.Cluster) //nolint:forcetypeassert,errcheck
+	updated.TypedSpec().Value.KubernetesVersion = "1.30.0"
+
+	require.EqualError(t, prodPolicy.Evaluate(admission.Input{Resource: updated, Existing: cluster, LatestKubernetesVersion: latest}),
+		`denied by admission policy "policy": Kubernetes downgrades are not allowed`)
+
+	patchPolicy, err := admission.Compile(newPolicy([]string{omni.ConfigPatchType}, "",
+		&specs.AdmissionPolicySpec_Rule{
+			Expression: `!has(config.machine) || !has(config.machine.kubelet) || !has(config.machine.kubelet.extraArgs) ||
+				config.machine.kubelet.extraArgs["anonymous-auth"] != "true"`,
+			Message: "kubelet authentication can not be disabled",
+		},
+		&specs.AdmissionPolicySpec_Rule{
+			Expression: `documents.all(d, !has(d.kind) || d.kind != "SideroLinkConfig")`,
+			Message:    "SideroLink config can not be patched",
+		},
+	))
+	require.NoError(t, err)
+
+	patch := omni.NewConfigPatch(resources.DefaultNamespace, "patch")
+
+	require.NoError(t, patch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  network:\n    hostname: foo\n")))
+	require.NoError(t, patchPolicy.Evaluate(admission.Input{Resource: patch}))
+
+	require.NoError(t, patch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  kubelet:\n    extraArgs:\n      anonymous-auth: \"true\"\n---\napiVersion: v1alpha1\nkind: SideroLinkConfig\n")))
+	require.EqualError(t, patchPolicy.Evaluate(admission.Input{Resource: patch}),
+		`denied by admission policy "policy": kubelet authentication can not be disabled; SideroLink config can not be patched`)
+
+	// the templates are rendered with the sample data
+	patch.Metadata().Labels().Set(omni.LabelConfigPatchTemplate, "")
+
+	require.NoError(t, patch.TypedSpec().Value.SetUncompressedData([]byte("machine:\n  kubelet:\n    extraArgs:\n      anonymous-auth: \"{{ if eq .Machine.Role \"worker\" }}true{{ end }}\"\n")))
+	require.ErrorContains(t, patchPolicy.Evaluate(admission.Input{Resource: patch}), "kubelet authentication can not be disabled")
+}
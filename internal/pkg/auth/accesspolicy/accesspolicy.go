@@ -16,6 +16,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
@@ -135,6 +136,10 @@ func Validate(accessPolicy *auth.AccessPolicy) error {
 		}
 	}
 
+	if err := validateTalosAPIRules(accessPolicySpec); err != nil {
+		validationErrs = multierror.Append(validationErrs, err)
+	}
+
 	// check tests
 	for _, test := range accessPolicySpec.GetTests() {
 		testName := test.GetName()
@@ -220,37 +225,6 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 	}
 
 	impersonateGroups := make([]string, 0, len(accessPolicySpec.GetRules()))
-
-	match := func(md *resource.Metadata, exactMatchValue, matchPattern string, selectors []string) (bool, error) {
-		if exactMatchValue != "" && md.ID() == exactMatchValue {
-			return true, nil
-		}
-
-		if matchPattern != "" {
-			matches, err := filepath.Match(matchPattern, md.ID())
-			if err != nil {
-				return false, fmt.Errorf("invalid match pattern %q for %s", matchPattern, md)
-			}
-
-			if matches {
-				return true, nil
-			}
-		}
-
-		if len(selectors) != 0 && md.Labels() != nil {
-			query, err := labels.ParseSelectors([]string{strings.Join(selectors, ",")})
-			if err != nil {
-				return false, err
-			}
-
-			if query.Matches(*md.Labels()) {
-				return true, nil
-			}
-		}
-
-		return false, nil
-	}
-
 	matchesAllClusters := false
 
 	for _, rule := range accessPolicySpec.GetRules() {
@@ -272,7 +246,7 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 				}
 
 				for _, groupUser := range group.GetUsers() {
-					matches, err := match(identityMD, groupUser.GetName(), groupUser.GetMatch(), groupUser.GetLabelSelectors())
+					matches, err := matchMetadata(identityMD, groupUser.GetName(), groupUser.GetMatch(), groupUser.GetLabelSelectors())
 					if err != nil {
 						return CheckResult{}, err
 					}
@@ -290,43 +264,13 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 			continue
 		}
 
-		clusterMatches := false
-
-		for _, ruleCluster := range rule.GetClusters() {
-			if ruleCluster == clusterMD.ID() {
-				clusterMatches = true
-
-				break
-			}
-
-			if strings.HasPrefix(ruleCluster, GroupPrefix) {
-				groupName := ruleCluster[len(GroupPrefix):]
-
-				group, groupOk := accessPolicySpec.GetClusterGroups()[groupName]
-				if !groupOk {
-					continue
-				}
-
-				for _, groupCluster := range group.GetClusters() {
-					if groupCluster.GetMatch() == "*" {
-						clusterMatches = true
-						matchesAllClusters = true
-
-						break
-					}
-
-					matches, err := match(clusterMD, groupCluster.GetName(), groupCluster.GetMatch(), nil)
-					if err != nil {
-						return CheckResult{}, err
-					}
-
-					if matches {
-						clusterMatches = true
+		clusterMatches, matchesAll, err := matchCluster(accessPolicySpec, rule.GetClusters(), clusterMD)
+		if err != nil {
+			return CheckResult{}, err
+		}
 
-						break
-					}
-				}
-			}
+		if matchesAll {
+			matchesAllClusters = true
 		}
 
 		if !clusterMatches {
@@ -353,3 +297,79 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 		KubernetesImpersonateGroups: impersonateGroups,
 	}, nil
 }
+
+// matchCluster checks whether the cluster matches any of the rule clusters, which are either the cluster names or the cluster groups.
+//
+// The second return value is true if the cluster is matched by a group which matches all clusters.
+func matchCluster(accessPolicySpec *specs.AccessPolicySpec, ruleClusters []string, clusterMD *resource.Metadata) (bool, bool, error) {
+	clusterMatches, matchesAllClusters := false, false
+
+	for _, ruleCluster := range ruleClusters {
+		if ruleCluster == clusterMD.ID() {
+			return true, matchesAllClusters, nil
+		}
+
+		if !strings.HasPrefix(ruleCluster, GroupPrefix) {
+			continue
+		}
+
+		groupName := ruleCluster[len(GroupPrefix):]
+
+		group, groupOk := accessPolicySpec.GetClusterGroups()[groupName]
+		if !groupOk {
+			continue
+		}
+
+		for _, groupCluster := range group.GetClusters() {
+			if groupCluster.GetMatch() == "*" {
+				clusterMatches = true
+				matchesAllClusters = true
+
+				break
+			}
+
+			matches, err := matchMetadata(clusterMD, groupCluster.GetName(), groupCluster.GetMatch(), nil)
+			if err != nil {
+				return false, false, err
+			}
+
+			if matches {
+				clusterMatches = true
+
+				break
+			}
+		}
+	}
+
+	return clusterMatches, matchesAllClusters, nil
+}
+
+func matchMetadata(md *resource.Metadata, exactMatchValue, matchPattern string, selectors []string) (bool, error) {
+	if exactMatchValue != "" && md.ID() == exactMatchValue {
+		return true, nil
+	}
+
+	if matchPattern != "" {
+		matches, err := filepath.Match(matchPattern, md.ID())
+		if err != nil {
+			return false, fmt.Errorf("invalid match pattern %q for %s", matchPattern, md)
+		}
+
+		if matches {
+			return true, nil
+		}
+	}
+
+	if len(selectors) != 0 && md.Labels() != nil {
+		query, err := labels.ParseSelectors([]string{strings.Join(selectors, ",")})
+		if err != nil {
+			return false, err
+		}
+
+		if query.Matches(*md.Labels()) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -10,6 +10,7 @@ import (
 	_ "embed"
 	"testing"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,6 +20,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
 )
 
 //go:embed testdata/acl-valid.yaml
@@ -179,3 +181,120 @@ func getAccessPolicy(t *testing.T, raw []byte) *auth.AccessPolicy {
 
 	return policy
 }
+
+//go:embed testdata/acl-talos-api.yaml
+var aclTalosAPIRaw []byte
+
+func TestCheckTalosAPI(t *testing.T) {
+	accessPolicy := getAccessPolicy(t, aclTalosAPIRaw)
+
+	require.NoError(t, accesspolicy.Validate(accessPolicy))
+
+	lockedMachine := omni.NewMachineStatus(resources.DefaultNamespace, "locked-machine").Metadata()
+	lockedMachine.Labels().Set("locked", "")
+
+	otherMachine := omni.NewMachineStatus(resources.DefaultNamespace, "other-machine").Metadata()
+
+	for _, tt := range []struct {
+		name    string
+		request accesspolicy.TalosAPIRequest
+		allowed bool
+	}{
+		{
+			name: "operator reset in production",
+			request: accesspolicy.TalosAPIRequest{
+				FullMethodName: "/machine.MachineService/Reset",
+				Role:           role.Operator,
+				ClusterMD:      omni.NewCluster(resources.DefaultNamespace, "prod-1").Metadata(),
+			},
+		},
+		{
+			name: "operator reboot in production",
+			request: accesspolicy.TalosAPIRequest{
+				FullMethodName: "/machine.MachineService/Reboot",
+				Role:           role.Operator,
+				ClusterMD:      omni.NewCluster(resources.DefaultNamespace, "prod-1").Metadata(),
+			},
+			allowed: true,
+		},
+		{
+			name: "admin reset in production",
+			request: accesspolicy.TalosAPIRequest{
+				FullMethodName: "/machine.MachineService/Reset",
+				Role:           role.Admin,
+				ClusterMD:      omni.NewCluster(resources.DefaultNamespace, "prod-1").Metadata(),
+			},
+			allowed: true,
+		},
+		{
+			name: "operator reset in staging",
+			request: accesspolicy.TalosAPIRequest{
+				FullMethodName: "/machine.MachineService/Reset",
+				Role:           role.Operator,
+				ClusterMD:      omni.NewCluster(resources.DefaultNamespace, "staging").Metadata(),
+			},
+			allowed: true,
+		},
+		{
+			name: "locked machine reboot",
+			request: accesspolicy.TalosAPIRequest{
+				FullMethodName: "/machine.MachineService/Reboot",
+				Role:           role.Admin,
+				MachineMDs:     []*resource.Metadata{otherMachine, lockedMachine},
+			},
+		},
+		{
+			name: "locked machine resource read",
+			request: accesspolicy.TalosAPIRequest{
+				FullMethodName: "/resource.ResourceService/Get",
+				Role:           role.Admin,
+				MachineMDs:     []*resource.Metadata{lockedMachine},
+			},
+			allowed: true,
+		},
+		{
+			name: "other machine reboot",
+			request: accesspolicy.TalosAPIRequest{
+				FullMethodName: "/machine.MachineService/Reboot",
+				Role:           role.Admin,
+				MachineMDs:     []*resource.Metadata{otherMachine},
+			},
+			allowed: true,
+		},
+		{
+			name: "unknown machine reboot",
+			request: accesspolicy.TalosAPIRequest{
+				FullMethodName:  "/machine.MachineService/Reboot",
+				Role:            role.Admin,
+				UnknownMachines: true,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := accesspolicy.CheckTalosAPI(accessPolicy, tt.request)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.allowed, result.Allowed)
+
+			if !tt.allowed {
+				assert.NotEmpty(t, result.Reason)
+			}
+		})
+	}
+}
+
+func TestValidateInvalidTalosAPIRules(t *testing.T) {
+	accessPolicy := getAccessPolicy(t, aclTalosAPIRaw)
+
+	rules := accessPolicy.TypedSpec().Value.TalosApiRules
+
+	rules[0].Roles = []string{"non-existent"}
+	rules[0].DeniedMethods = []string{"machine.MachineService/[Reset"}
+	rules[1].AllowedMethods = nil
+
+	err := accesspolicy.Validate(accessPolicy)
+	assert.ErrorContains(t, err, "3 errors occurred")
+	assert.ErrorContains(t, err, "unknown role")
+	assert.ErrorContains(t, err, "invalid Talos API method pattern")
+	assert.ErrorContains(t, err, "talos API rule 1: neither allowed nor denied methods are set")
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package accesspolicy
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// TalosAPIRequest describes a Talos API call proxied through Omni.
+type TalosAPIRequest struct {
+	// ClusterMD is the metadata of the target cluster, nil if the target machines are not part of a cluster.
+	ClusterMD *resource.Metadata
+
+	// FullMethodName is the gRPC full method name, e.g. "/machine.MachineService/Reset".
+	FullMethodName string
+
+	// Role is the role of the caller.
+	Role role.Role
+
+	// MachineMDs are the metadata of the target machines, carrying their labels.
+	MachineMDs []*resource.Metadata
+
+	// UnknownMachines is set if some of the target machines can't be determined,
+	// the rules with the machine label selectors apply to such requests.
+	UnknownMachines bool
+}
+
+// TalosAPICheckResult is the result of a Talos API access check.
+type TalosAPICheckResult struct {
+	// Reason explains why the call is denied.
+	Reason string
+
+	Allowed bool
+}
+
+// CheckTalosAPI checks the Talos API call against the Talos API rules of the access policy.
+//
+// Each rule which matches the request must permit the method: the method must not be in the denied methods of the rule,
+// and if the rule has the allowed methods set, the method must be in there.
+func CheckTalosAPI(accessPolicy *auth.AccessPolicy, request TalosAPIRequest) (TalosAPICheckResult, error) {
+	accessPolicySpec := accessPolicy.TypedSpec().Value
+	method := strings.TrimLeft(request.FullMethodName, "/")
+
+	for i, rule := range accessPolicySpec.GetTalosApiRules() {
+		applies, err := talosAPIRuleApplies(accessPolicySpec, rule, request)
+		if err != nil {
+			return TalosAPICheckResult{}, err
+		}
+
+		if !applies {
+			continue
+		}
+
+		denied, err := matchMethod(rule.GetDeniedMethods(), method)
+		if err != nil {
+			return TalosAPICheckResult{}, err
+		}
+
+		if denied {
+			return TalosAPICheckResult{
+				Reason: fmt.Sprintf("method is denied by the Talos API rule %d", i),
+			}, nil
+		}
+
+		if len(rule.GetAllowedMethods()) == 0 {
+			continue
+		}
+
+		allowed, err := matchMethod(rule.GetAllowedMethods(), method)
+		if err != nil {
+			return TalosAPICheckResult{}, err
+		}
+
+		if !allowed {
+			return TalosAPICheckResult{
+				Reason: fmt.Sprintf("method is not allowed by the Talos API rule %d", i),
+			}, nil
+		}
+	}
+
+	return TalosAPICheckResult{
+		Allowed: true,
+	}, nil
+}
+
+func talosAPIRuleApplies(accessPolicySpec *specs.AccessPolicySpec, rule *specs.AccessPolicyTalosAPIRule, request TalosAPIRequest) (bool, error) {
+	if len(rule.GetRoles()) != 0 {
+		roleMatches := false
+
+		for _, ruleRole := range rule.GetRoles() {
+			parsedRole, err := role.Parse(ruleRole)
+			if err != nil {
+				return false, err
+			}
+
+			if parsedRole == request.Role {
+				roleMatches = true
+
+				break
+			}
+		}
+
+		if !roleMatches {
+			return false, nil
+		}
+	}
+
+	if len(rule.GetClusters()) != 0 {
+		if request.ClusterMD == nil {
+			return false, nil
+		}
+
+		clusterMatches, _, err := matchCluster(accessPolicySpec, rule.GetClusters(), request.ClusterMD)
+		if err != nil {
+			return false, err
+		}
+
+		if !clusterMatches {
+			return false, nil
+		}
+	}
+
+	if len(rule.GetMachineLabelSelectors()) != 0 {
+		if request.UnknownMachines {
+			return true, nil
+		}
+
+		// the rule applies if any of the target machines is selected, so multi-node calls can't be used to bypass it
+		for _, machineMD := range request.MachineMDs {
+			matches, err := matchMetadata(machineMD, "", "", rule.GetMachineLabelSelectors())
+			if err != nil {
+				return false, err
+			}
+
+			if matches {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func matchMethod(patterns []string, method string) (bool, error) {
+	for _, pattern := range patterns {
+		matches, err := path.Match(strings.TrimLeft(pattern, "/"), method)
+		if err != nil {
+			return false, fmt.Errorf("invalid Talos API method pattern %q: %w", pattern, err)
+		}
+
+		if matches {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func validateTalosAPIRules(accessPolicySpec *specs.AccessPolicySpec) error {
+	var validationErrs error
+
+	for i, rule := range accessPolicySpec.GetTalosApiRules() {
+		if len(rule.GetAllowedMethods()) == 0 && len(rule.GetDeniedMethods()) == 0 {
+			validationErrs = multierror.Append(validationErrs, fmt.Errorf("talos API rule %d: neither allowed nor denied methods are set", i))
+		}
+
+		for _, ruleRole := range rule.GetRoles() {
+			if _, err := role.Parse(ruleRole); err != nil {
+				validationErrs = multierror.Append(validationErrs, fmt.Errorf("talos API rule %d: %w", i, err))
+			}
+		}
+
+		for _, ruleCluster := range rule.GetClusters() {
+			if ruleCluster == "" {
+				validationErrs = multierror.Append(validationErrs, fmt.Errorf("talos API rule %d: empty cluster", i))
+			}
+		}
+
+		if len(rule.GetMachineLabelSelectors()) != 0 {
+			if _, err := labels.ParseSelectors([]string{strings.Join(rule.GetMachineLabelSelectors(), ",")}); err != nil {
+				validationErrs = multierror.Append(validationErrs, fmt.Errorf("talos API rule %d: %w", i, err))
+			}
+		}
+
+		for _, pattern := range slices.Concat(rule.GetAllowedMethods(), rule.GetDeniedMethods()) {
+			if pattern == "" {
+				validationErrs = multierror.Append(validationErrs, fmt.Errorf("talos API rule %d: empty method", i))
+
+				continue
+			}
+
+			if _, err := matchMethod([]string{pattern}, ""); err != nil {
+				validationErrs = multierror.Append(validationErrs, fmt.Errorf("talos API rule %d: %w", i, err))
+			}
+		}
+	}
+
+	return validationErrs
+}
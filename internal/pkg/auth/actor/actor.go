@@ -15,7 +15,9 @@ import (
 )
 
 // internalActorContextKey is the key for internal actor context.
-type internalActorContextKey struct{}
+type internalActorContextKey struct {
+	internal bool
+}
 
 // tnfraProviderContextKey forces infra provider role and sets infrastructure provider name in the context.
 type infraProviderContextKey struct {
@@ -24,6 +26,13 @@ type infraProviderContextKey struct {
 
 // MarkContextAsInternalActor returns a new derived context from the given context, marked as an internal actor.
 func MarkContextAsInternalActor(ctx context.Context) context.Context {
+	return ctxstore.WithValue(ctx, internalActorContextKey{internal: true})
+}
+
+// UnmarkContextAsInternalActor returns a new derived context from the given context, not marked as an internal actor.
+//
+// It is used for the writes made by Omni on behalf of the users, which are subject to the same validations as the user writes.
+func UnmarkContextAsInternalActor(ctx context.Context) context.Context {
 	return ctxstore.WithValue(ctx, internalActorContextKey{})
 }
 
@@ -41,9 +50,9 @@ func MarkContextAsInfraProvider(ctx context.Context, name string) context.Contex
 
 // ContextIsInternalActor returns true if the given context is marked as an internal actor.
 func ContextIsInternalActor(ctx context.Context) bool {
-	_, ok := ctxstore.Value[internalActorContextKey](ctx)
+	value, _ := ctxstore.Value[internalActorContextKey](ctx)
 
-	return ok
+	return value.internal
 }
 
 // ContextInfraProvider returns id of the infra provider if it's set in the context.
@@ -0,0 +1,46 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package celexpr implements the helpers shared by the CEL expressions evaluated by Omni.
+package celexpr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+)
+
+// CostLimit limits the evaluation cost of a single expression, the cost grows with the list sizes and the number of the comprehension steps.
+const CostLimit = 100000
+
+// CompileBool parses and checks the expression, the expression should evaluate to a bool.
+func CompileBool(env *cel.Env, source string) (cel.Program, error) {
+	ast, issues := env.Compile(source)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression should evaluate to bool, got %s", ast.OutputType())
+	}
+
+	return env.Program(ast, cel.CostLimit(CostLimit))
+}
+
+// EvalBool evaluates the program compiled by CompileBool.
+func EvalBool(program cel.Program, vars any) (bool, error) {
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.New("expression didn't evaluate to bool")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package celexpr_test
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/pkg/celexpr"
+)
+
+func TestCompileBool(t *testing.T) {
+	t.Parallel()
+
+	env, err := cel.NewEnv(cel.Variable("items", cel.ListType(cel.IntType)))
+	require.NoError(t, err)
+
+	program, err := celexpr.CompileBool(env, "items.exists(i, i > 2)")
+	require.NoError(t, err)
+
+	result, err := celexpr.EvalBool(program, map[string]any{"items": []int64{1, 3}})
+	require.NoError(t, err)
+	assert.True(t, result)
+
+	_, err = celexpr.CompileBool(env, "items.size()")
+	assert.ErrorContains(t, err, "expression should evaluate to bool")
+
+	_, err = celexpr.CompileBool(env, "unknown > 1")
+	assert.Error(t, err)
+
+	// the evaluation is aborted once the cost limit is reached
+	program, err = celexpr.CompileBool(env, "items.all(a, items.all(b, items.all(c, a + b + c >= 0)))")
+	require.NoError(t, err)
+
+	items := make([]int64, 100)
+
+	_, err = celexpr.EvalBool(program, map[string]any{"items": items})
+	assert.ErrorContains(t, err, "cost limit exceeded")
+}
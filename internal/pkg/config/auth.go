@@ -15,6 +15,12 @@ type AuthParams struct {
 	WebAuthn WebAuthnParams `yaml:"webauthn"`
 	SAML     SAMLParams     `yaml:"saml"`
 
+	// UntrackedServiceAccountTokensCutoff is the RFC 3339 time after which the service account tokens without the token ID are rejected.
+	//
+	// Such tokens were issued before the service account kubeconfigs were tracked, so they can't be revoked.
+	// If empty, they are accepted until they expire.
+	UntrackedServiceAccountTokensCutoff string `yaml:"untrackedServiceAccountTokensCutoff"`
+
 	Suspended bool `yaml:"suspended"`
 }
 
@@ -69,6 +69,8 @@ type Params struct {
 	ImageFactoryBaseURL    string `yaml:"imageFactoryAddress"`
 	ImageFactoryPXEBaseURL string `yaml:"imageFactoryProxyAddress"`
 
+	ImageFactoryCache ImageFactoryCacheParams `yaml:"imageFactoryCache"`
+
 	Storage StorageParams `yaml:"storage"`
 
 	SecondaryStorage BoltDBParams `yaml:"secondaryStorage"`
@@ -98,6 +100,24 @@ type Params struct {
 
 	AuditLogDir string `yaml:"auditLogDir"`
 
+	SessionRecording SessionRecordingParams `yaml:"sessionRecording"`
+
+	Tracing TracingParams `yaml:"tracing"`
+
+	InventorySync InventorySyncParams `yaml:"inventorySync"`
+
+	// MachineResourceUsageInterval is the interval of the machine CPU, memory, filesystem and network usage collection, it is disabled if zero.
+	MachineResourceUsageInterval time.Duration `yaml:"machineResourceUsageInterval"`
+
+	SupportBundle SupportBundleParams `yaml:"supportBundle"`
+
+	SelfBackup SelfBackupParams `yaml:"selfBackup"`
+
+	// ConfigRevisionLimit is the number of revisions kept for each config patch and generated machine config, the history is disabled if zero.
+	ConfigRevisionLimit int `yaml:"configRevisionLimit"`
+	// ConfigRevisionRetention is the retention period of the revisions of the removed config patches and machine configs.
+	ConfigRevisionRetention time.Duration `yaml:"configRevisionRetention"`
+
 	InitialServiceAccount InitialServiceAccount `yaml:"initialServiceAccount"`
 }
 
@@ -147,10 +167,96 @@ func (ebp EtcdBackupParams) GetStorageType() (EtcdBackupStorage, error) {
 	}
 }
 
+// SessionRecordingParams defines the recording of the Talos and Kubernetes API sessions.
+type SessionRecordingParams struct {
+	// Dir is the directory for the encrypted recordings, the recording is disabled if it's empty.
+	Dir string `yaml:"dir"`
+	// Recipient is the age X25519 public key the recordings are encrypted for.
+	//
+	// The matching identity is not stored in Omni, it is required only to replay the recordings with omnictl.
+	Recipient string `yaml:"recipient"`
+	// Clusters is the list of the cluster name patterns to record, all clusters are recorded if it's empty.
+	Clusters  []string      `yaml:"clusters"`
+	Retention time.Duration `yaml:"retention"`
+}
+
+// InventorySyncParams defines the periodic push of the machine hardware inventory changes to an external HTTP endpoint.
+type InventorySyncParams struct {
+	// Headers are added to the push requests, e.g. to authenticate to the endpoint.
+	Headers map[string]string `yaml:"headers"`
+	// Endpoint is the URL the inventory changes are POSTed to, the sync is disabled if it's empty.
+	Endpoint string        `yaml:"endpoint"`
+	Interval time.Duration `yaml:"interval"`
+}
+
+// ImageFactoryCacheParams defines the cache of the installation media and installer images generated by the image factory.
+type ImageFactoryCacheParams struct {
+	// Dir is the local directory of the cache.
+	Dir string `yaml:"dir"`
+	// PrewarmImages are the image factory file names cached for each schematic in use, e.g. metal-amd64.iso or installer-amd64.tar.
+	PrewarmImages   []string      `yaml:"prewarmImages"`
+	PrewarmInterval time.Duration `yaml:"prewarmInterval"`
+	// MaxAge is the time after which the unused images are evicted from the cache, the eviction is disabled if it's zero.
+	MaxAge time.Duration `yaml:"maxAge"`
+	// UseBackupStore keeps the cache in the etcd backup store instead of the local directory.
+	UseBackupStore bool `yaml:"useBackupStore"`
+	// ServeInstallerRegistry makes the machines pull the installer images from the registry served on the Omni API endpoint,
+	// which caches the installer images of the image factory.
+	ServeInstallerRegistry bool `yaml:"serveInstallerRegistry"`
+}
+
+// Enabled returns true if the cache is configured.
+func (p ImageFactoryCacheParams) Enabled() bool {
+	return p.Dir != "" || p.UseBackupStore
+}
+
+// InstallerRegistryEnabled returns true if the installer images are served from the Omni API endpoint.
+func (p ImageFactoryCacheParams) InstallerRegistryEnabled() bool {
+	return p.Enabled() && p.ServeInstallerRegistry
+}
+
+// SupportBundleParams defines the scheduled support bundles which are stored in the etcd backup store.
+type SupportBundleParams struct {
+	// Recipient is the age X25519 public key the stored bundles are encrypted for, it is required to store the bundles.
+	Recipient string `yaml:"recipient"`
+	// RedactPatterns are the regular expressions redacted from all support bundles in addition to the default ones.
+	// If a pattern has a capture group, only the first group is redacted.
+	RedactPatterns []string `yaml:"redactPatterns"`
+	// Interval is the interval of the fleet-wide bundles, they are disabled if it's zero.
+	Interval time.Duration `yaml:"interval"`
+	// UnhealthyCooldown is the minimum interval between the bundles of the same unhealthy cluster.
+	UnhealthyCooldown time.Duration `yaml:"unhealthyCooldown"`
+	Retention         time.Duration `yaml:"retention"`
+	// OnUnhealthy enables the bundles of the clusters which become unhealthy.
+	OnUnhealthy bool `yaml:"onUnhealthy"`
+}
+
+// SelfBackupParams defines the scheduled encrypted snapshots of the Omni state which are stored in the etcd backup store.
+type SelfBackupParams struct {
+	// KeyFile is the path to the age identity used to encrypt the snapshots, it is generated if it doesn't exist.
+	// The key is required to restore the snapshots, so it should be kept outside of the Omni instance.
+	KeyFile string `yaml:"keyFile"`
+	// Interval is the interval of the snapshots, they are disabled if it's zero.
+	Interval  time.Duration `yaml:"interval"`
+	Retention time.Duration `yaml:"retention"`
+}
+
+// TracingParams defines the OpenTelemetry tracing configs.
+type TracingParams struct {
+	// Endpoint is the address of the OTLP gRPC trace collector.
+	Endpoint    string `yaml:"endpoint"`
+	ServiceName string `yaml:"serviceName"`
+	// SamplingRatio is the ratio of the root spans which are sampled, the child spans follow the decision of the parent.
+	SamplingRatio float64 `yaml:"samplingRatio"`
+	Insecure      bool    `yaml:"insecure"`
+	Enabled       bool    `yaml:"enabled"`
+}
+
 // WorkloadProxyingParams defines workload proxying configs.
 type WorkloadProxyingParams struct {
-	Subdomain string `yaml:"subdomain"`
-	Enabled   bool   `yaml:"enabled"`
+	Subdomain  string `yaml:"subdomain"`
+	Enabled    bool   `yaml:"enabled"`
+	AccessLogs bool   `yaml:"accessLogs"`
 }
 
 // ConfigDataCompressionParams defines config data compression configs.
@@ -171,6 +277,20 @@ type LoadBalancerParams struct {
 
 	HealthCheckInterval time.Duration `yaml:"healthCheckInterval"`
 	HealthCheckTimeout  time.Duration `yaml:"healthCheckTimeout"`
+
+	// HealthCheck is the type of the upstream health check: either 'tcp' or 'readyz'.
+	HealthCheck string `yaml:"healthCheck"`
+
+	// SNIDomain enables the SNI multiplexing of the load balancers on the SNIPort.
+	//
+	// Each cluster which opts in to it is reachable as <cluster>.<SNIDomain>, the domain should resolve to the SideroLink address of Omni.
+	SNIDomain string `yaml:"sniDomain"`
+	SNIPort   int    `yaml:"sniPort"`
+}
+
+// SNIEnabled returns true if the load balancers are multiplexed on a single port by the TLS server name.
+func (lbp LoadBalancerParams) SNIEnabled() bool {
+	return lbp.SNIDomain != "" && lbp.SNIPort != 0
 }
 
 // StorageParams defines storage configs.
@@ -249,6 +369,7 @@ var (
 
 			HealthCheckInterval: 20 * time.Second,
 			HealthCheckTimeout:  15 * time.Second,
+			HealthCheck:         "tcp",
 		},
 		KeyPruner: KeyPrunerParams{
 			Interval: 10 * time.Minute,
@@ -308,6 +429,38 @@ var (
 
 		LocalResourceServerPort: 8081,
 
+		Tracing: TracingParams{
+			Endpoint:      "localhost:4317",
+			ServiceName:   "omni",
+			SamplingRatio: 0.1,
+		},
+
+		InventorySync: InventorySyncParams{
+			Interval: 10 * time.Minute,
+		},
+
+		ImageFactoryCache: ImageFactoryCacheParams{
+			PrewarmInterval: time.Hour,
+			MaxAge:          30 * 24 * time.Hour,
+		},
+
+		SupportBundle: SupportBundleParams{
+			UnhealthyCooldown: 6 * time.Hour,
+			Retention:         14 * 24 * time.Hour,
+		},
+
+		SelfBackup: SelfBackupParams{
+			KeyFile:   "_out/self-backup.key",
+			Retention: 7 * 24 * time.Hour,
+		},
+
+		ConfigRevisionLimit:     10,
+		ConfigRevisionRetention: 7 * 24 * time.Hour,
+
+		SessionRecording: SessionRecordingParams{
+			Retention: 30 * 24 * time.Hour,
+		},
+
 		EtcdBackup: EtcdBackupParams{
 			TickInterval: time.Minute,
 			MinInterval:  time.Hour,
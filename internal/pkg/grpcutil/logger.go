@@ -16,6 +16,7 @@ import (
 	"github.com/cosi-project/runtime/api/v1alpha1"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/siderolabs/omni/client/api/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/internal/pkg/tracing"
 )
 
 const (
@@ -110,6 +112,15 @@ func SetUserAgent() grpc.UnaryServerInterceptor {
 	}
 }
 
+// SetTraceID returns a new unary server interceptor that adds the trace ID of the request to the list of ctxtags.
+func SetTraceID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		setTraceID(ctx)
+
+		return handler(ctx, req)
+	}
+}
+
 // InterceptBodyToTags returns a new unary server interceptor that adds request and response body to the list ctxtags.
 func InterceptBodyToTags(hook Hook, bodyLimit int) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
@@ -176,6 +187,12 @@ func setUserAgent(ctx context.Context) {
 	}
 }
 
+func setTraceID(ctx context.Context) {
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		grpc_ctxtags.Extract(ctx).Set(tracing.TraceIDKey, spanContext.TraceID().String())
+	}
+}
+
 // SetShouldLog marks the context to log the request.
 func SetShouldLog(ctx context.Context, system string) {
 	grpc_ctxtags.Extract(ctx).Set("request_log_initiator", system)
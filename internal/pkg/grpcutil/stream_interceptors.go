@@ -32,6 +32,15 @@ func StreamSetUserAgent() grpc.StreamServerInterceptor {
 	}
 }
 
+// StreamSetTraceID returns a new stream server interceptor that adds the trace ID of the request to the list of ctxtags.
+func StreamSetTraceID() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		setTraceID(ss.Context())
+
+		return handler(srv, ss)
+	}
+}
+
 // StreamIntercept returns a new stream server interceptor that calls the given hook functions.
 func StreamIntercept(hooks StreamHooks) grpc.StreamServerInterceptor {
 	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
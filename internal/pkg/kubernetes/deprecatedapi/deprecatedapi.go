@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package deprecatedapi detects the usage of the Kubernetes API versions which are removed in the upgrade target version.
+package deprecatedapi
+
+import (
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Removal describes the API version of the resource removed in the Kubernetes version.
+type Removal struct {
+	// GroupVersionResource is the removed API version of the resource.
+	schema.GroupVersionResource
+
+	// Kind is the kind of the resource.
+	Kind string
+
+	// RemovedIn is the Kubernetes minor version which no longer serves the API version, e.g. "1.25".
+	RemovedIn string
+
+	// Replacement is the API version to migrate to, empty if the resource is removed without a replacement.
+	Replacement string
+}
+
+// APIVersion returns the removed API version in the "group/version" form.
+func (r Removal) APIVersion() string {
+	return r.GroupVersion().String()
+}
+
+// https://kubernetes.io/docs/reference/using-api/deprecation-guide/
+var removals = []Removal{
+	removal("admissionregistration.k8s.io/v1beta1", "mutatingwebhookconfigurations", "MutatingWebhookConfiguration", "1.22", "admissionregistration.k8s.io/v1"),
+	removal("admissionregistration.k8s.io/v1beta1", "validatingwebhookconfigurations", "ValidatingWebhookConfiguration", "1.22", "admissionregistration.k8s.io/v1"),
+	removal("apiextensions.k8s.io/v1beta1", "customresourcedefinitions", "CustomResourceDefinition", "1.22", "apiextensions.k8s.io/v1"),
+	removal("apiregistration.k8s.io/v1beta1", "apiservices", "APIService", "1.22", "apiregistration.k8s.io/v1"),
+	removal("certificates.k8s.io/v1beta1", "certificatesigningrequests", "CertificateSigningRequest", "1.22", "certificates.k8s.io/v1"),
+	removal("coordination.k8s.io/v1beta1", "leases", "Lease", "1.22", "coordination.k8s.io/v1"),
+	removal("extensions/v1beta1", "ingresses", "Ingress", "1.22", "networking.k8s.io/v1"),
+	removal("networking.k8s.io/v1beta1", "ingresses", "Ingress", "1.22", "networking.k8s.io/v1"),
+	removal("networking.k8s.io/v1beta1", "ingressclasses", "IngressClass", "1.22", "networking.k8s.io/v1"),
+	removal("rbac.authorization.k8s.io/v1beta1", "clusterroles", "ClusterRole", "1.22", "rbac.authorization.k8s.io/v1"),
+	removal("rbac.authorization.k8s.io/v1beta1", "clusterrolebindings", "ClusterRoleBinding", "1.22", "rbac.authorization.k8s.io/v1"),
+	removal("rbac.authorization.k8s.io/v1beta1", "roles", "Role", "1.22", "rbac.authorization.k8s.io/v1"),
+	removal("rbac.authorization.k8s.io/v1beta1", "rolebindings", "RoleBinding", "1.22", "rbac.authorization.k8s.io/v1"),
+	removal("scheduling.k8s.io/v1beta1", "priorityclasses", "PriorityClass", "1.22", "scheduling.k8s.io/v1"),
+	removal("storage.k8s.io/v1beta1", "csidrivers", "CSIDriver", "1.22", "storage.k8s.io/v1"),
+	removal("storage.k8s.io/v1beta1", "csinodes", "CSINode", "1.22", "storage.k8s.io/v1"),
+	removal("storage.k8s.io/v1beta1", "storageclasses", "StorageClass", "1.22", "storage.k8s.io/v1"),
+	removal("storage.k8s.io/v1beta1", "volumeattachments", "VolumeAttachment", "1.22", "storage.k8s.io/v1"),
+	removal("batch/v1beta1", "cronjobs", "CronJob", "1.25", "batch/v1"),
+	removal("discovery.k8s.io/v1beta1", "endpointslices", "EndpointSlice", "1.25", "discovery.k8s.io/v1"),
+	removal("events.k8s.io/v1beta1", "events", "Event", "1.25", "events.k8s.io/v1"),
+	removal("autoscaling/v2beta1", "horizontalpodautoscalers", "HorizontalPodAutoscaler", "1.25", "autoscaling/v2"),
+	removal("policy/v1beta1", "poddisruptionbudgets", "PodDisruptionBudget", "1.25", "policy/v1"),
+	removal("policy/v1beta1", "podsecuritypolicies", "PodSecurityPolicy", "1.25", ""),
+	removal("node.k8s.io/v1beta1", "runtimeclasses", "RuntimeClass", "1.25", "node.k8s.io/v1"),
+	removal("flowcontrol.apiserver.k8s.io/v1beta1", "flowschemas", "FlowSchema", "1.26", "flowcontrol.apiserver.k8s.io/v1beta2"),
+	removal("flowcontrol.apiserver.k8s.io/v1beta1", "prioritylevelconfigurations", "PriorityLevelConfiguration", "1.26", "flowcontrol.apiserver.k8s.io/v1beta2"),
+	removal("autoscaling/v2beta2", "horizontalpodautoscalers", "HorizontalPodAutoscaler", "1.26", "autoscaling/v2"),
+	removal("storage.k8s.io/v1beta1", "csistoragecapacities", "CSIStorageCapacity", "1.27", "storage.k8s.io/v1"),
+	removal("flowcontrol.apiserver.k8s.io/v1beta2", "flowschemas", "FlowSchema", "1.29", "flowcontrol.apiserver.k8s.io/v1beta3"),
+	removal("flowcontrol.apiserver.k8s.io/v1beta2", "prioritylevelconfigurations", "PriorityLevelConfiguration", "1.29", "flowcontrol.apiserver.k8s.io/v1beta3"),
+	removal("flowcontrol.apiserver.k8s.io/v1beta3", "flowschemas", "FlowSchema", "1.32", "flowcontrol.apiserver.k8s.io/v1"),
+	removal("flowcontrol.apiserver.k8s.io/v1beta3", "prioritylevelconfigurations", "PriorityLevelConfiguration", "1.32", "flowcontrol.apiserver.k8s.io/v1"),
+}
+
+func removal(apiVersion, resource, kind, removedIn, replacement string) Removal {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	return Removal{
+		GroupVersionResource: gv.WithResource(resource),
+		Kind:                 kind,
+		RemovedIn:            removedIn,
+		Replacement:          replacement,
+	}
+}
+
+// ForUpgrade returns the API versions which are served by the fromVersion, but are removed in the toVersion.
+func ForUpgrade(fromVersion, toVersion string) ([]Removal, error) {
+	from, err := semver.ParseTolerant(fromVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing from version: %w", err)
+	}
+
+	to, err := semver.ParseTolerant(toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing to version: %w", err)
+	}
+
+	var result []Removal
+
+	for _, r := range removals {
+		removedIn := semver.MustParse(r.RemovedIn + ".0")
+
+		if minorVersion(from).LT(removedIn) && !minorVersion(to).LT(removedIn) {
+			result = append(result, r)
+		}
+	}
+
+	return result, nil
+}
+
+func minorVersion(v semver.Version) semver.Version {
+	return semver.Version{Major: v.Major, Minor: v.Minor}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package deprecatedapi_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/siderolabs/omni/internal/pkg/kubernetes/deprecatedapi"
+)
+
+func TestForUpgrade(t *testing.T) {
+	removals, err := deprecatedapi.ForUpgrade("1.24.3", "1.25.0")
+	require.NoError(t, err)
+
+	kinds := map[string]struct{}{}
+
+	for _, removal := range removals {
+		assert.Equal(t, "1.25", removal.RemovedIn)
+
+		kinds[removal.Kind] = struct{}{}
+	}
+
+	assert.Contains(t, kinds, "PodSecurityPolicy")
+	assert.Contains(t, kinds, "CronJob")
+
+	removals, err = deprecatedapi.ForUpgrade("1.30.1", "1.30.5")
+	require.NoError(t, err)
+	assert.Empty(t, removals)
+
+	removals, err = deprecatedapi.ForUpgrade("v1.31.2", "v1.32.0")
+	require.NoError(t, err)
+	require.Len(t, removals, 2)
+	assert.Equal(t, "flowcontrol.apiserver.k8s.io/v1beta3", removals[0].APIVersion())
+}
+
+func flowSchema(name, apiVersion, managedFieldsVersion string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind("FlowSchema")
+	obj.SetName(name)
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "kube-apiserver", APIVersion: apiVersion},
+		{Manager: "custom-controller", APIVersion: managedFieldsVersion},
+	})
+
+	return obj
+}
+
+func helmRelease(t *testing.T, manifest string) *corev1.Secret {
+	t.Helper()
+
+	release, err := json.Marshal(map[string]string{
+		"name":      "legacy",
+		"namespace": "apps",
+		"manifest":  manifest,
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+
+	_, err = gz.Write(release)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sh.helm.release.v1.legacy.v2",
+			Namespace: "apps",
+			Labels:    map[string]string{"owner": "helm", "status": "deployed", "name": "legacy"},
+		},
+		Type: "helm.sh/release.v1",
+		Data: map[string][]byte{
+			"release": []byte(base64.StdEncoding.EncodeToString(buf.Bytes())),
+		},
+	}
+}
+
+func TestScan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	clientset := fake.NewSimpleClientset(helmRelease(t, `---
+apiVersion: flowcontrol.apiserver.k8s.io/v1beta3
+kind: FlowSchema
+metadata:
+  name: legacy
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: legacy
+  namespace: apps
+`))
+
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{ //nolint:forcetypeassert
+		{
+			GroupVersion: "flowcontrol.apiserver.k8s.io/v1",
+			APIResources: []metav1.APIResource{{Name: "flowschemas", Kind: "FlowSchema"}, {Name: "prioritylevelconfigurations", Kind: "PriorityLevelConfiguration"}},
+		},
+		{
+			GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta3",
+			APIResources: []metav1.APIResource{{Name: "flowschemas", Kind: "FlowSchema"}, {Name: "prioritylevelconfigurations", Kind: "PriorityLevelConfiguration"}},
+		},
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		{Group: "flowcontrol.apiserver.k8s.io", Version: "v1", Resource: "flowschemas"}:                 "FlowSchemaList",
+		{Group: "flowcontrol.apiserver.k8s.io", Version: "v1", Resource: "prioritylevelconfigurations"}: "PriorityLevelConfigurationList",
+	},
+		flowSchema("current", "flowcontrol.apiserver.k8s.io/v1", "flowcontrol.apiserver.k8s.io/v1"),
+		flowSchema("legacy", "flowcontrol.apiserver.k8s.io/v1", "flowcontrol.apiserver.k8s.io/v1beta3"),
+	)
+
+	removals, err := deprecatedapi.ForUpgrade("1.31.0", "1.32.0")
+	require.NoError(t, err)
+
+	usages, err := deprecatedapi.NewScannerForClients(clientset, dynamicClient).Scan(ctx, removals)
+	require.NoError(t, err)
+	require.Len(t, usages, 2)
+
+	assert.Equal(t, "legacy", usages[0].Name)
+	assert.Empty(t, usages[0].HelmRelease)
+	assert.Equal(t,
+		"FlowSchema legacy uses flowcontrol.apiserver.k8s.io/v1beta3, which is removed in 1.32, migrate to flowcontrol.apiserver.k8s.io/v1",
+		usages[0].String(),
+	)
+
+	assert.Equal(t, "apps/legacy", usages[1].HelmRelease)
+	assert.Equal(t,
+		"Helm release apps/legacy manifest has FlowSchema legacy in flowcontrol.apiserver.k8s.io/v1beta3, which is removed in 1.32, migrate to flowcontrol.apiserver.k8s.io/v1",
+		usages[1].String(),
+	)
+
+	// nothing is reported for the upgrade which doesn't remove anything
+	removals, err = deprecatedapi.ForUpgrade("1.30.0", "1.31.0")
+	require.NoError(t, err)
+
+	usages, err = deprecatedapi.NewScannerForClients(clientset, dynamicClient).Scan(ctx, removals)
+	require.NoError(t, err)
+	assert.Empty(t, usages)
+}
+
+func TestScanReplacementNotServed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	clientset := fake.NewSimpleClientset()
+
+	// Kubernetes 1.28 serves flowcontrol.apiserver.k8s.io v1beta2 and v1beta3, but not v1
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{ //nolint:forcetypeassert
+		{
+			GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta3",
+			APIResources: []metav1.APIResource{{Name: "flowschemas", Kind: "FlowSchema"}},
+		},
+		{
+			GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta2",
+			APIResources: []metav1.APIResource{{Name: "flowschemas", Kind: "FlowSchema"}},
+		},
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Resource: "flowschemas"}: "FlowSchemaList",
+	},
+		flowSchema("exempt", "flowcontrol.apiserver.k8s.io/v1beta3", "flowcontrol.apiserver.k8s.io/v1beta3"),
+		flowSchema("legacy", "flowcontrol.apiserver.k8s.io/v1beta3", "flowcontrol.apiserver.k8s.io/v1beta2"),
+	)
+
+	removals := []deprecatedapi.Removal{
+		{
+			GroupVersionResource: schema.GroupVersionResource{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2", Resource: "flowschemas"},
+			Kind:                 "FlowSchema",
+			RemovedIn:            "1.29",
+			Replacement:          "flowcontrol.apiserver.k8s.io/v1",
+		},
+	}
+
+	// the objects are checked in the other served version, so that only the ones written in the removed version are reported
+	usages, err := deprecatedapi.NewScannerForClients(clientset, dynamicClient).Scan(ctx, removals)
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+
+	assert.Equal(t, "legacy", usages[0].Name)
+}
@@ -0,0 +1,361 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package deprecatedapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+	// helmReleaseSelector selects the deployed Helm releases stored by the default Helm storage driver.
+	helmReleaseSelector = "owner=helm,status=deployed"
+
+	// maxReleaseSize is the maximum size of the decompressed Helm release.
+	maxReleaseSize = 16 * 1024 * 1024
+)
+
+// Usage is an object which uses the removed API version.
+type Usage struct {
+	Namespace string
+	Name      string
+
+	// HelmRelease is set to "namespace/name" of the Helm release if the object with the removed API version is in the release manifest.
+	HelmRelease string
+
+	Removal Removal
+}
+
+// String implements fmt.Stringer.
+func (u Usage) String() string {
+	name := u.Name
+	if u.Namespace != "" {
+		name = u.Namespace + "/" + name
+	}
+
+	var description string
+
+	if u.HelmRelease != "" {
+		description = fmt.Sprintf("Helm release %s manifest has %s %s in %s, which is removed in %s", u.HelmRelease, u.Removal.Kind, name, u.Removal.APIVersion(), u.Removal.RemovedIn)
+	} else {
+		description = fmt.Sprintf("%s %s uses %s, which is removed in %s", u.Removal.Kind, name, u.Removal.APIVersion(), u.Removal.RemovedIn)
+	}
+
+	if u.Removal.Replacement == "" {
+		return description + ", no replacement is available"
+	}
+
+	return description + ", migrate to " + u.Removal.Replacement
+}
+
+// Scanner finds the objects and the Helm releases which use the removed API versions.
+type Scanner struct {
+	clientset kubernetes.Interface
+	dynamic   dynamic.Interface
+}
+
+// NewScanner creates a new scanner for the cluster.
+func NewScanner(config *rest.Config) (*Scanner, error) {
+	config = rest.CopyConfig(config)
+
+	// listing the objects in the deprecated API versions produces a warning per request
+	config.WarningHandler = rest.NoWarnings{}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	return NewScannerForClients(clientset, dynamicClient), nil
+}
+
+// NewScannerForClients creates a new scanner using the given clients.
+func NewScannerForClients(clientset kubernetes.Interface, dynamicClient dynamic.Interface) *Scanner {
+	return &Scanner{
+		clientset: clientset,
+		dynamic:   dynamicClient,
+	}
+}
+
+// Scan returns the usages of the removed API versions.
+//
+// The object uses the removed API version if it is still written in that version, as recorded in the managed fields and in the last applied configuration,
+// or if the cluster serves no other version of the resource.
+func (s *Scanner) Scan(ctx context.Context, removals []Removal) ([]Usage, error) {
+	usages, err := s.scanObjects(ctx, removals)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseUsages, err := s.scanHelmReleases(ctx, removals)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(usages, releaseUsages...), nil
+}
+
+func (s *Scanner) scanObjects(ctx context.Context, removals []Removal) ([]Usage, error) {
+	if len(removals) == 0 {
+		return nil, nil
+	}
+
+	groups, err := s.clientset.Discovery().ServerGroups()
+	if err != nil {
+		return nil, fmt.Errorf("error discovering API groups: %w", err)
+	}
+
+	served := map[schema.GroupVersionResource]bool{}
+
+	isServed := func(gvr schema.GroupVersionResource) (bool, error) {
+		if result, ok := served[gvr]; ok {
+			return result, nil
+		}
+
+		list, err := s.clientset.Discovery().ServerResourcesForGroupVersion(gvr.GroupVersion().String())
+		if err != nil && !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("error discovering %s: %w", gvr.GroupVersion(), err)
+		}
+
+		served[gvr] = false
+
+		if list != nil {
+			for _, res := range list.APIResources {
+				if res.Name == gvr.Resource {
+					served[gvr] = true
+
+					break
+				}
+			}
+		}
+
+		return served[gvr], nil
+	}
+
+	var usages []Usage
+
+	for _, removal := range removals {
+		removedServed, err := isServed(removal.GroupVersionResource)
+		if err != nil {
+			return nil, err
+		}
+
+		listGVR := removal.GroupVersionResource
+		usesRemoved := func(*unstructured.Unstructured) bool { return true }
+
+		// the objects are listed in any other served version, the API server converts them, so that the managed fields show
+		// the versions the objects were written in, the replacement might be served only by the newer Kubernetes versions
+		candidates, err := otherVersions(groups, removal)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, candidate := range candidates {
+			candidateServed, err := isServed(candidate)
+			if err != nil {
+				return nil, err
+			}
+
+			if candidateServed {
+				listGVR = candidate
+				usesRemoved = func(obj *unstructured.Unstructured) bool { return usesAPIVersion(obj, removal.APIVersion()) }
+
+				break
+			}
+		}
+
+		if listGVR == removal.GroupVersionResource && !removedServed {
+			continue
+		}
+
+		list, err := s.dynamic.Resource(listGVR).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("error listing %s: %w", listGVR, err)
+		}
+
+		for _, obj := range list.Items {
+			if usesRemoved(&obj) {
+				usages = append(usages, Usage{
+					Namespace: obj.GetNamespace(),
+					Name:      obj.GetName(),
+					Removal:   removal,
+				})
+			}
+		}
+	}
+
+	return usages, nil
+}
+
+// otherVersions returns the resource in the other versions than the removed one, the replacement first,
+// followed by the other versions of the group served by the cluster, the preferred version first.
+func otherVersions(groups *metav1.APIGroupList, removal Removal) ([]schema.GroupVersionResource, error) {
+	var versions []schema.GroupVersionResource
+
+	if removal.Replacement != "" {
+		replacement, err := schema.ParseGroupVersion(removal.Replacement)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, replacement.WithResource(removal.Resource))
+	}
+
+	for _, group := range groups.Groups {
+		if group.Name != removal.Group {
+			continue
+		}
+
+		groupVersions := append([]metav1.GroupVersionForDiscovery{group.PreferredVersion}, group.Versions...)
+
+		for _, version := range groupVersions {
+			gvr := schema.GroupVersionResource{Group: removal.Group, Version: version.Version, Resource: removal.Resource}
+
+			if version.Version == "" || gvr == removal.GroupVersionResource || slices.Contains(versions, gvr) {
+				continue
+			}
+
+			versions = append(versions, gvr)
+		}
+	}
+
+	return versions, nil
+}
+
+// usesAPIVersion checks whether the object was written in the API version by any manager.
+func usesAPIVersion(obj *unstructured.Unstructured, apiVersion string) bool {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.APIVersion == apiVersion {
+			return true
+		}
+	}
+
+	lastApplied, ok := obj.GetAnnotations()[lastAppliedAnnotation]
+	if !ok {
+		return false
+	}
+
+	var typeMeta metav1.TypeMeta
+
+	if err := json.Unmarshal([]byte(lastApplied), &typeMeta); err != nil {
+		return false
+	}
+
+	return typeMeta.APIVersion == apiVersion
+}
+
+type helmRelease struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Manifest  string `json:"manifest"`
+}
+
+func (s *Scanner) scanHelmReleases(ctx context.Context, removals []Removal) ([]Usage, error) {
+	if len(removals) == 0 {
+		return nil, nil
+	}
+
+	secrets, err := s.clientset.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: helmReleaseSelector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Helm releases: %w", err)
+	}
+
+	var usages []Usage
+
+	for _, secret := range secrets.Items {
+		release, err := decodeHelmRelease(secret.Data["release"])
+		if err != nil {
+			return nil, fmt.Errorf("error decoding Helm release %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+
+		decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader([]byte(release.Manifest)), 4096)
+
+		for {
+			var obj metav1.PartialObjectMetadata
+
+			if err = decoder.Decode(&obj); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+
+				return nil, fmt.Errorf("error parsing Helm release %s/%s manifest: %w", release.Namespace, release.Name, err)
+			}
+
+			for _, removal := range removals {
+				if obj.APIVersion == removal.APIVersion() && obj.Kind == removal.Kind {
+					usages = append(usages, Usage{
+						Namespace:   obj.Namespace,
+						Name:        obj.Name,
+						HelmRelease: release.Namespace + "/" + release.Name,
+						Removal:     removal,
+					})
+				}
+			}
+		}
+	}
+
+	return usages, nil
+}
+
+// decodeHelmRelease decodes the release stored by Helm as the base64 encoded gzipped JSON.
+func decodeHelmRelease(data []byte) (*helmRelease, error) {
+	decoded, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(decoded, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, err
+		}
+
+		defer gz.Close() //nolint:errcheck
+
+		if decoded, err = io.ReadAll(io.LimitReader(gz, maxReleaseSize+1)); err != nil {
+			return nil, err
+		}
+
+		if len(decoded) > maxReleaseSize {
+			return nil, fmt.Errorf("release is larger than %d bytes", maxReleaseSize)
+		}
+	}
+
+	var release helmRelease
+
+	if err = json.Unmarshal(decoded, &release); err != nil {
+		return nil, err
+	}
+
+	return &release, nil
+}
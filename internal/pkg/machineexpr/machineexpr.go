@@ -0,0 +1,116 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package machineexpr implements the CEL expressions matching the machines by their status.
+//
+// The expression has access to the following variables:
+//
+//   - machine: the MachineStatusSpec of the machine, with the proto field names, e.g. machine.hardware.blockdevices;
+//   - labels: the labels of the machine status;
+//   - memory_bytes: the total size of the memory modules in bytes.
+//
+// The size constants KB, MB, GB, TB (powers of 1000) and KiB, MiB, GiB, TiB (powers of 1024) are defined.
+//
+// Examples:
+//
+//	machine.hardware.blockdevices.filter(d, d.type == "nvme" && d.size >= 1 * TB).size() >= 2
+//	machine.network.network_links.exists(l, l.speed_mbps >= 25000)
+//	memory_bytes >= 128 * GiB && machine.hardware.arch == "amd64"
+package machineexpr
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/celexpr"
+)
+
+var sizeConstants = map[string]int64{
+	"KB":  1000,
+	"MB":  1000 * 1000,
+	"GB":  1000 * 1000 * 1000,
+	"TB":  1000 * 1000 * 1000 * 1000,
+	"KiB": 1 << 10,
+	"MiB": 1 << 20,
+	"GiB": 1 << 30,
+	"TiB": 1 << 40,
+}
+
+var getEnv = sync.OnceValues(func() (*cel.Env, error) {
+	opts := []cel.EnvOption{
+		cel.Types(&specs.MachineStatusSpec{}),
+		cel.Variable("machine", cel.ObjectType("specs.MachineStatusSpec")),
+		cel.Variable("labels", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("memory_bytes", cel.UintType),
+		cel.CrossTypeNumericComparisons(true),
+	}
+
+	for name, value := range sizeConstants {
+		opts = append(opts, cel.Constant(name, cel.IntType, types.Int(value)))
+	}
+
+	return cel.NewEnv(opts...)
+})
+
+// Expression is a compiled machine expression.
+type Expression struct {
+	program cel.Program
+	source  string
+}
+
+// Compile parses and checks the expression, the expression should evaluate to a bool.
+func Compile(source string) (*Expression, error) {
+	env, err := getEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	program, err := celexpr.CompileBool(env, source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Expression{
+		program: program,
+		source:  source,
+	}, nil
+}
+
+// String returns the source of the expression.
+func (expr *Expression) String() string {
+	return expr.source
+}
+
+// Match evaluates the expression against the machine status.
+func (expr *Expression) Match(machineStatus *omni.MachineStatus) (bool, error) {
+	spec := machineStatus.TypedSpec().Value
+
+	var memoryBytes uint64
+
+	for _, module := range spec.GetHardware().GetMemoryModules() {
+		memoryBytes += uint64(module.SizeMb) << 20
+	}
+
+	machineLabels := machineStatus.Metadata().Labels().Raw()
+	if machineLabels == nil {
+		machineLabels = map[string]string{}
+	}
+
+	result, err := celexpr.EvalBool(expr.program, map[string]any{
+		"machine":      spec,
+		"labels":       machineLabels,
+		"memory_bytes": memoryBytes,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate expression %q: %w", expr.source, err)
+	}
+
+	return result, nil
+}
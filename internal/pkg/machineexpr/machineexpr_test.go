@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machineexpr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/machineexpr"
+)
+
+func TestMatch(t *testing.T) {
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine-1")
+	machineStatus.Metadata().Labels().Set("rack", "r1")
+
+	machineStatus.TypedSpec().Value = &specs.MachineStatusSpec{
+		Hardware: &specs.MachineStatusSpec_HardwareStatus{
+			Arch: "amd64",
+			MemoryModules: []*specs.MachineStatusSpec_HardwareStatus_MemoryModule{
+				{SizeMb: 64 * 1024},
+				{SizeMb: 64 * 1024},
+			},
+			Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+				{Type: "nvme", Size: 2 * 1000 * 1000 * 1000 * 1000},
+				{Type: "nvme", Size: 1000 * 1000 * 1000 * 1000},
+				{Type: "hdd", Size: 4 * 1000 * 1000 * 1000 * 1000},
+			},
+		},
+		Network: &specs.MachineStatusSpec_NetworkStatus{
+			NetworkLinks: []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+				{LinuxName: "eth0", SpeedMbps: 25000},
+			},
+		},
+	}
+
+	for _, test := range []struct {
+		expression string
+		expected   bool
+	}{
+		{`machine.hardware.blockdevices.filter(d, d.type == "nvme" && d.size >= 1 * TB).size() >= 2`, true},
+		{`machine.hardware.blockdevices.filter(d, d.type == "nvme" && d.size >= 2 * TB).size() >= 2`, false},
+		{`machine.network.network_links.exists(l, l.speed_mbps >= 25000)`, true},
+		{`memory_bytes >= 128 * GiB && machine.hardware.arch == "amd64"`, true},
+		{`memory_bytes >= 256 * GiB`, false},
+		{`labels["rack"] == "r1"`, true},
+		{`"zone" in labels`, false},
+	} {
+		t.Run(test.expression, func(t *testing.T) {
+			expr, err := machineexpr.Compile(test.expression)
+			require.NoError(t, err)
+
+			matched, err := expr.Match(machineStatus)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, matched)
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, expression := range []string{
+		`machine.hardware.arch`,
+		`machine.unknown == 1`,
+		`memory_bytes >=`,
+	} {
+		_, err := machineexpr.Compile(expression)
+		assert.Error(t, err, expression)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package tracing implements the OpenTelemetry tracing setup and helpers.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/version"
+)
+
+const (
+	// TraceIDKey is the log field name of the trace ID.
+	TraceIDKey = "trace_id"
+	// SpanIDKey is the log field name of the span ID.
+	SpanIDKey = "span_id"
+
+	tracerName = "github.com/siderolabs/omni"
+)
+
+// Setup configures the global tracer provider and propagator.
+//
+// The returned function flushes the pending spans and shuts the exporter down.
+// If the tracing is disabled, the global no-op tracer provider is kept, but the trace context is still propagated.
+func Setup(ctx context.Context, params config.TracingParams, logger *zap.Logger) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !params.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(params.Endpoint),
+	}
+
+	if params.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(params.ServiceName),
+		semconv.ServiceVersion(version.Tag),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(params.SamplingRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		logger.Warn("tracing error", zap.Error(err))
+	}))
+
+	logger.Info("tracing enabled", zap.String("endpoint", params.Endpoint), zap.Float64("sampling_ratio", params.SamplingRatio))
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the Omni tracer.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// LogFields returns the log fields with the IDs of the span in the context.
+//
+// No fields are returned if the context has no valid span.
+func LogFields(ctx context.Context) []zap.Field {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil
+	}
+
+	return []zap.Field{
+		zap.String(TraceIDKey, spanContext.TraceID().String()),
+		zap.String(SpanIDKey, spanContext.SpanID().String()),
+	}
+}
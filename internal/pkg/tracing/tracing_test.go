@@ -0,0 +1,29 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/internal/pkg/tracing"
+)
+
+func TestLogFields(t *testing.T) {
+	assert.Empty(t, tracing.LogFields(context.Background()))
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	assert.Equal(t, []zap.Field{
+		zap.String(tracing.TraceIDKey, span.SpanContext().TraceID().String()),
+		zap.String(tracing.SpanIDKey, span.SpanContext().SpanID().String()),
+	}, tracing.LogFields(ctx))
+}